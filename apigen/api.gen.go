@@ -0,0 +1,2244 @@
+// Package apigen provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package apigen
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for ComponentHealthStatus.
+const (
+	ComponentHealthStatusDown ComponentHealthStatus = "down"
+	ComponentHealthStatusOk   ComponentHealthStatus = "ok"
+)
+
+// Defines values for ExchangeStatus.
+const (
+	ExchangeStatusCompleted ExchangeStatus = "completed"
+	ExchangeStatusDeclined  ExchangeStatus = "declined"
+	ExchangeStatusPending   ExchangeStatus = "pending"
+)
+
+// Defines values for InteractionStatus.
+const (
+	InteractionStatusApproved InteractionStatus = "approved"
+	InteractionStatusExpired  InteractionStatus = "expired"
+	InteractionStatusPending  InteractionStatus = "pending"
+	InteractionStatusRejected InteractionStatus = "rejected"
+)
+
+// Defines values for LineEventType.
+const (
+	Follow   LineEventType = "follow"
+	Message  LineEventType = "message"
+	Postback LineEventType = "postback"
+	Unfollow LineEventType = "unfollow"
+)
+
+// Defines values for LineMessageType.
+const (
+	Audio    LineMessageType = "audio"
+	File     LineMessageType = "file"
+	Image    LineMessageType = "image"
+	Location LineMessageType = "location"
+	Sticker  LineMessageType = "sticker"
+	Text     LineMessageType = "text"
+	Video    LineMessageType = "video"
+)
+
+// Defines values for LineSourceType.
+const (
+	LineSourceTypeGroup LineSourceType = "group"
+	LineSourceTypeRoom  LineSourceType = "room"
+	LineSourceTypeUser  LineSourceType = "user"
+)
+
+// Defines values for ReadinessStatus.
+const (
+	ReadinessStatusDegraded ReadinessStatus = "degraded"
+	ReadinessStatusOk       ReadinessStatus = "ok"
+)
+
+// Defines values for ReportStatus.
+const (
+	Dismissed ReportStatus = "dismissed"
+	Open      ReportStatus = "open"
+	Resolved  ReportStatus = "resolved"
+)
+
+// Defines values for ReportReason.
+const (
+	Harassment           ReportReason = "harassment"
+	Impersonation        ReportReason = "impersonation"
+	InappropriateContent ReportReason = "inappropriate_content"
+	Other                ReportReason = "other"
+	Spam                 ReportReason = "spam"
+)
+
+// Defines values for SessionTokenType.
+const (
+	Bearer SessionTokenType = "Bearer"
+)
+
+// Defines values for UserLocale.
+const (
+	En UserLocale = "en"
+	Ja UserLocale = "ja"
+)
+
+// Defines values for GetInteractionsParamsRole.
+const (
+	Approver  GetInteractionsParamsRole = "approver"
+	Requester GetInteractionsParamsRole = "requester"
+)
+
+// Defines values for GetInteractionsParamsStatus.
+const (
+	Approved GetInteractionsParamsStatus = "approved"
+	Expired  GetInteractionsParamsStatus = "expired"
+	Pending  GetInteractionsParamsStatus = "pending"
+	Rejected GetInteractionsParamsStatus = "rejected"
+)
+
+// Attestation defines model for Attestation.
+type Attestation struct {
+	// ApproverId ID of the user who approved the interaction
+	ApproverId openapi_types.UUID `json:"approverId"`
+
+	// ApproverWallet Approver's EIP-55 wallet address at issue time
+	ApproverWallet *string `json:"approverWallet"`
+
+	// InteractionId ID of the approved interaction
+	InteractionId openapi_types.UUID `json:"interactionId"`
+
+	// IssuedAt When the attestation was signed (second precision)
+	IssuedAt time.Time `json:"issuedAt"`
+
+	// MetAt When the users met (interaction creation time, second precision)
+	MetAt time.Time `json:"metAt"`
+
+	// RequesterId ID of the user who requested the interaction
+	RequesterId openapi_types.UUID `json:"requesterId"`
+
+	// RequesterWallet Requester's EIP-55 wallet address at issue time
+	RequesterWallet *string `json:"requesterWallet"`
+
+	// Signature 65-byte r||s||v EIP-712 signature, 0x-prefixed hex
+	Signature string `json:"signature"`
+
+	// Signer EIP-55 address of the server signing key
+	Signer string `json:"signer"`
+}
+
+// Block defines model for Block.
+type Block struct {
+	// CreatedAt Timestamp when the block was placed
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UserId ID of the blocked user
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// BlockUserRequest defines model for BlockUserRequest.
+type BlockUserRequest struct {
+	// UserId ID of the user to block
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// Circle defines model for Circle.
+type Circle struct {
+	// CreatedAt Timestamp when the circle was founded
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FounderId ID of the user at the centre of the circle
+	FounderId openapi_types.UUID `json:"founderId"`
+
+	// Id Unique identifier for the circle
+	Id openapi_types.UUID `json:"id"`
+
+	// Manifesto What the circle believes in
+	Manifesto string `json:"manifesto"`
+
+	// Name Name of the circle
+	Name string `json:"name"`
+}
+
+// CompleteExchangeRequest defines model for CompleteExchangeRequest.
+type CompleteExchangeRequest struct {
+	// TraceId One of the caller's traces outside circles to give back
+	TraceId openapi_types.UUID `json:"traceId"`
+}
+
+// ComponentHealth defines model for ComponentHealth.
+type ComponentHealth struct {
+	Detail *string               `json:"detail,omitempty"`
+	Status ComponentHealthStatus `json:"status"`
+}
+
+// ComponentHealthStatus defines model for ComponentHealth.Status.
+type ComponentHealthStatus string
+
+// Connection defines model for Connection.
+type Connection struct {
+	// ExchangeCount Number of completed exchanges with the user
+	ExchangeCount int `json:"exchangeCount"`
+
+	// LastExchangedAt Timestamp of the last completed exchange
+	LastExchangedAt *time.Time `json:"lastExchangedAt"`
+
+	// Since Timestamp when the relationship started
+	Since time.Time `json:"since"`
+
+	// Strength Relationship strength, halving every half-life without shared activity
+	Strength float64 `json:"strength"`
+
+	// UserId ID of the connected user
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// ConnectionList defines model for ConnectionList.
+type ConnectionList struct {
+	Connections []Connection `json:"connections"`
+
+	// Limit Maximum number of connections per user, or null if unlimited
+	Limit *int `json:"limit"`
+}
+
+// CreateCircleRequest defines model for CreateCircleRequest.
+type CreateCircleRequest struct {
+	// Manifesto What the circle believes in
+	Manifesto *string `json:"manifesto,omitempty"`
+
+	// Name Name of the circle
+	Name string `json:"name"`
+}
+
+// Exchange defines model for Exchange.
+type Exchange struct {
+	// CreatedAt Timestamp when the trace was offered
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique identifier for the exchange
+	Id openapi_types.UUID `json:"id"`
+
+	// OfferedTraceId Trace offered by the offerer
+	OfferedTraceId openapi_types.UUID `json:"offeredTraceId"`
+
+	// OffererId ID of the user who offered a trace
+	OffererId openapi_types.UUID `json:"offererId"`
+
+	// RecipientId ID of the user the trace was offered to
+	RecipientId openapi_types.UUID `json:"recipientId"`
+
+	// RespondedAt Timestamp when the recipient completed or declined the exchange
+	RespondedAt *time.Time `json:"respondedAt"`
+
+	// ResponseTraceId Trace given back by the recipient, once completed
+	ResponseTraceId *openapi_types.UUID `json:"responseTraceId"`
+
+	// Status Current status of the exchange
+	Status ExchangeStatus `json:"status"`
+}
+
+// ExchangeStatus Current status of the exchange
+type ExchangeStatus string
+
+// Interaction defines model for Interaction.
+type Interaction struct {
+	// ApproverId ID of the user approving interaction
+	ApproverId openapi_types.UUID `json:"approverId"`
+
+	// CreatedAt Timestamp when the interaction was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique interaction identifier
+	Id openapi_types.UUID `json:"id"`
+
+	// Metadata Additional metadata for the interaction
+	Metadata *map[string]interface{} `json:"metadata"`
+
+	// RequesterId ID of the user requesting interaction
+	RequesterId openapi_types.UUID `json:"requesterId"`
+
+	// Status Current status of the interaction
+	Status InteractionStatus `json:"status"`
+}
+
+// InteractionStatus Current status of the interaction
+type InteractionStatus string
+
+// InteractionPage defines model for InteractionPage.
+type InteractionPage struct {
+	Interactions []Interaction `json:"interactions"`
+
+	// NextCursor Opaque cursor of the next page, or null on the last page
+	NextCursor *string `json:"nextCursor"`
+}
+
+// LiffLoginRequest defines model for LiffLoginRequest.
+type LiffLoginRequest struct {
+	// AccessToken LINE access token of the LIFF app
+	AccessToken string `json:"accessToken"`
+}
+
+// LineEvent defines model for LineEvent.
+type LineEvent struct {
+	Message *LineMessage `json:"message,omitempty"`
+
+	// Mode Channel state
+	Mode     string        `json:"mode"`
+	Postback *LinePostback `json:"postback,omitempty"`
+
+	// ReplyToken Token for answering the event. The sender of a `meet_` request is told the
+	// request was sent, and senders of messages that could not be acted on are told why.
+	ReplyToken *string    `json:"replyToken,omitempty"`
+	Source     LineSource `json:"source"`
+
+	// Timestamp Time of the event in milliseconds
+	Timestamp int64 `json:"timestamp"`
+
+	// Type Event type
+	Type LineEventType `json:"type"`
+
+	// WebhookEventId Event ID, unchanged when LINE redelivers the event. Logged with everything the event causes.
+	WebhookEventId *string `json:"webhookEventId,omitempty"`
+}
+
+// LineEventType Event type
+type LineEventType string
+
+// LineLoginRequest defines model for LineLoginRequest.
+type LineLoginRequest struct {
+	// IdToken ID token returned by LINE Login
+	IdToken string `json:"idToken"`
+
+	// Nonce Nonce sent with the authorization request. If given, the ID token must carry it.
+	Nonce *string `json:"nonce,omitempty"`
+}
+
+// LineMessage defines model for LineMessage.
+type LineMessage struct {
+	// Id Message ID
+	Id *string `json:"id,omitempty"`
+
+	// Text Message text (for text messages)
+	Text *string          `json:"text"`
+	Type *LineMessageType `json:"type,omitempty"`
+}
+
+// LineMessageType defines model for LineMessage.Type.
+type LineMessageType string
+
+// LinePostback defines model for LinePostback.
+type LinePostback struct {
+	// Data Postback data
+	Data *string `json:"data,omitempty"`
+}
+
+// LineSource defines model for LineSource.
+type LineSource struct {
+	// GroupId Group ID (if source is group)
+	GroupId *string `json:"groupId"`
+
+	// RoomId Room ID (if source is room)
+	RoomId *string        `json:"roomId"`
+	Type   LineSourceType `json:"type"`
+
+	// UserId User ID of the source user
+	UserId string `json:"userId"`
+}
+
+// LineSourceType defines model for LineSource.Type.
+type LineSourceType string
+
+// LineWebhookRequest defines model for LineWebhookRequest.
+type LineWebhookRequest struct {
+	// Destination User ID of the bot
+	Destination string      `json:"destination"`
+	Events      []LineEvent `json:"events"`
+}
+
+// LinkWalletRequest defines model for LinkWalletRequest.
+type LinkWalletRequest struct {
+	// Signature 65-byte r||s||v personal_sign signature of the challenge message, 0x-prefixed hex
+	Signature string `json:"signature"`
+}
+
+// OfferExchangeRequest defines model for OfferExchangeRequest.
+type OfferExchangeRequest struct {
+	// RecipientId ID of the user to offer the trace to
+	RecipientId openapi_types.UUID `json:"recipientId"`
+
+	// TraceId One of the caller's traces outside circles
+	TraceId openapi_types.UUID `json:"traceId"`
+}
+
+// PostTraceRequest defines model for PostTraceRequest.
+type PostTraceRequest struct {
+	// Body Text of the trace
+	Body *string `json:"body,omitempty"`
+
+	// MediaUrl Attached artwork or media
+	MediaUrl *string `json:"mediaUrl,omitempty"`
+}
+
+// Problem RFC 7807 problem details, returned by every operation that fails.
+// Errors are classified by kind (not found, invalid input, conflict, forbidden,
+// rate limited, unauthenticated) and the kind decides the status.
+type Problem struct {
+	// Detail What went wrong with this request. Internal errors only say "internal server error".
+	Detail *string `json:"detail,omitempty"`
+	Status int     `json:"status"`
+
+	// Title The HTTP status text.
+	Title string `json:"title"`
+
+	// Type Always about:blank; the status says what went wrong.
+	Type string `json:"type"`
+}
+
+// Profile What other users see of a user.
+type Profile struct {
+	AvatarUrl   *string            `json:"avatarUrl"`
+	Bio         string             `json:"bio"`
+	DisplayName string             `json:"displayName"`
+	Id          openapi_types.UUID `json:"id"`
+}
+
+// Readiness defines model for Readiness.
+type Readiness struct {
+	// Components Keyed by component (database, schema, line_credentials).
+	Components map[string]ComponentHealth `json:"components"`
+	Status     ReadinessStatus            `json:"status"`
+}
+
+// ReadinessStatus defines model for Readiness.Status.
+type ReadinessStatus string
+
+// RefreshSessionRequest defines model for RefreshSessionRequest.
+type RefreshSessionRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// Report defines model for Report.
+type Report struct {
+	// CreatedAt Timestamp when the report was filed
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Details Free-form description from the reporter
+	Details string `json:"details"`
+
+	// Id Unique identifier for the report
+	Id openapi_types.UUID `json:"id"`
+
+	// Reason Category of the report
+	Reason ReportReason `json:"reason"`
+
+	// ReportedId ID of the reported user
+	ReportedId openapi_types.UUID `json:"reportedId"`
+
+	// Status Moderation status of the report
+	Status ReportStatus `json:"status"`
+}
+
+// ReportStatus Moderation status of the report
+type ReportStatus string
+
+// ReportReason Category of the report
+type ReportReason string
+
+// ReportUserRequest defines model for ReportUserRequest.
+type ReportUserRequest struct {
+	// Details What happened, in the reporter's words
+	Details *string `json:"details,omitempty"`
+
+	// Reason Category of the report
+	Reason ReportReason `json:"reason"`
+
+	// UserId ID of the user to report
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// RequestInteractionRequest defines model for RequestInteractionRequest.
+type RequestInteractionRequest struct {
+	ApproverId openapi_types.UUID `json:"approverId"`
+}
+
+// Session Tokens of a signed-in client. `user` is only included on sign-in.
+type Session struct {
+	// AccessToken Send as `Authorization: Bearer {accessToken}`
+	AccessToken string `json:"accessToken"`
+
+	// ExpiresAt When the access token stops working
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// RefreshToken Single-use token for POST /auth/refresh
+	RefreshToken          string           `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time        `json:"refreshTokenExpiresAt"`
+	TokenType             SessionTokenType `json:"tokenType"`
+	User                  *User            `json:"user,omitempty"`
+}
+
+// SessionTokenType defines model for Session.TokenType.
+type SessionTokenType string
+
+// Trace defines model for Trace.
+type Trace struct {
+	// AuthorId ID of the user who posted the trace
+	AuthorId openapi_types.UUID `json:"authorId"`
+
+	// Body Text of the trace
+	Body string `json:"body"`
+
+	// CircleId Circle the trace is scoped to, if any
+	CircleId *openapi_types.UUID `json:"circleId"`
+
+	// CreatedAt Timestamp when the trace was posted
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique identifier for the trace
+	Id openapi_types.UUID `json:"id"`
+
+	// MediaUrl Attached artwork or media
+	MediaUrl *string `json:"mediaUrl"`
+}
+
+// UpdateProfileRequest defines model for UpdateProfileRequest.
+type UpdateProfileRequest struct {
+	// AvatarUrl http or https URL of the profile image, or empty to remove it
+	AvatarUrl   *string `json:"avatarUrl,omitempty"`
+	Bio         *string `json:"bio,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+// User defines model for User.
+type User struct {
+	// AvatarUrl Profile image
+	AvatarUrl *string `json:"avatarUrl"`
+
+	// Bio Self-introduction, at most 500 characters
+	Bio string `json:"bio"`
+
+	// DisplayName User's display name
+	DisplayName string `json:"displayName"`
+
+	// Id Unique user identifier
+	Id openapi_types.UUID `json:"id"`
+
+	// LineUserId LINE user ID
+	LineUserId string `json:"lineUserId"`
+
+	// Locale Language of the LINE messages sent to the user
+	Locale UserLocale `json:"locale"`
+
+	// WalletAddress EIP-55 address of the wallet linked with POST /users/me/wallet
+	WalletAddress *string `json:"walletAddress"`
+}
+
+// UserLocale Language of the LINE messages sent to the user
+type UserLocale string
+
+// WalletChallenge defines model for WalletChallenge.
+type WalletChallenge struct {
+	// ExpiresAt When the challenge can no longer be answered
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Message Text to sign with personal_sign, exactly as given
+	Message string `json:"message"`
+
+	// WalletAddress The wallet address in EIP-55 form
+	WalletAddress string `json:"walletAddress"`
+}
+
+// WalletChallengeRequest defines model for WalletChallengeRequest.
+type WalletChallengeRequest struct {
+	// WalletAddress 0x-prefixed address of the wallet to link; mixed case must be a valid EIP-55 checksum
+	WalletAddress string `json:"walletAddress"`
+}
+
+// GetInteractionsParams defines parameters for GetInteractions.
+type GetInteractionsParams struct {
+	Role   GetInteractionsParamsRole    `form:"role" json:"role"`
+	Status *GetInteractionsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Cursor *string                      `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int                         `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetInteractionsParamsRole defines parameters for GetInteractions.
+type GetInteractionsParamsRole string
+
+// GetInteractionsParamsStatus defines parameters for GetInteractions.
+type GetInteractionsParamsStatus string
+
+// PostWebhookLineParams defines parameters for PostWebhookLine.
+type PostWebhookLineParams struct {
+	// XLineSignature Base64 HMAC-SHA256 of the request body, keyed with the channel secret.
+	XLineSignature *string `json:"X-Line-Signature,omitempty"`
+}
+
+// PostAuthLiffJSONRequestBody defines body for PostAuthLiff for application/json ContentType.
+type PostAuthLiffJSONRequestBody = LiffLoginRequest
+
+// PostAuthLineJSONRequestBody defines body for PostAuthLine for application/json ContentType.
+type PostAuthLineJSONRequestBody = LineLoginRequest
+
+// PostAuthRefreshJSONRequestBody defines body for PostAuthRefresh for application/json ContentType.
+type PostAuthRefreshJSONRequestBody = RefreshSessionRequest
+
+// PostBlocksJSONRequestBody defines body for PostBlocks for application/json ContentType.
+type PostBlocksJSONRequestBody = BlockUserRequest
+
+// PostCirclesJSONRequestBody defines body for PostCircles for application/json ContentType.
+type PostCirclesJSONRequestBody = CreateCircleRequest
+
+// PostCircleTracesJSONRequestBody defines body for PostCircleTraces for application/json ContentType.
+type PostCircleTracesJSONRequestBody = PostTraceRequest
+
+// PostExchangesJSONRequestBody defines body for PostExchanges for application/json ContentType.
+type PostExchangesJSONRequestBody = OfferExchangeRequest
+
+// PostExchangeCompleteJSONRequestBody defines body for PostExchangeComplete for application/json ContentType.
+type PostExchangeCompleteJSONRequestBody = CompleteExchangeRequest
+
+// PostInteractionsJSONRequestBody defines body for PostInteractions for application/json ContentType.
+type PostInteractionsJSONRequestBody = RequestInteractionRequest
+
+// PostReportsJSONRequestBody defines body for PostReports for application/json ContentType.
+type PostReportsJSONRequestBody = ReportUserRequest
+
+// PostTracesJSONRequestBody defines body for PostTraces for application/json ContentType.
+type PostTracesJSONRequestBody = PostTraceRequest
+
+// PatchUsersMeJSONRequestBody defines body for PatchUsersMe for application/json ContentType.
+type PatchUsersMeJSONRequestBody = UpdateProfileRequest
+
+// PostUsersMeWalletJSONRequestBody defines body for PostUsersMeWallet for application/json ContentType.
+type PostUsersMeWalletJSONRequestBody = LinkWalletRequest
+
+// PostUsersMeWalletChallengeJSONRequestBody defines body for PostUsersMeWalletChallenge for application/json ContentType.
+type PostUsersMeWalletChallengeJSONRequestBody = WalletChallengeRequest
+
+// PostWebhookLineJSONRequestBody defines body for PostWebhookLine for application/json ContentType.
+type PostWebhookLineJSONRequestBody = LineWebhookRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Sign in from the LIFF app
+	// (POST /auth/liff)
+	PostAuthLiff(w http.ResponseWriter, r *http.Request)
+	// Sign in with LINE Login
+	// (POST /auth/line)
+	PostAuthLine(w http.ResponseWriter, r *http.Request)
+	// Refresh a session
+	// (POST /auth/refresh)
+	PostAuthRefresh(w http.ResponseWriter, r *http.Request)
+	// List the users the caller blocked
+	// (GET /blocks)
+	GetBlocks(w http.ResponseWriter, r *http.Request)
+	// Block a user
+	// (POST /blocks)
+	PostBlocks(w http.ResponseWriter, r *http.Request)
+	// Unblock a user
+	// (DELETE /blocks/{userId})
+	DeleteBlock(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
+	// Found a circle
+	// (POST /circles)
+	PostCircles(w http.ResponseWriter, r *http.Request)
+	// Join a circle
+	// (POST /circles/{id}/members)
+	PostCircleMembers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Leave a circle
+	// (DELETE /circles/{id}/members/me)
+	DeleteCircleMembersMe(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List the traces posted to a circle
+	// (GET /circles/{id}/traces)
+	GetCircleTraces(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Post a trace to a circle
+	// (POST /circles/{id}/traces)
+	PostCircleTraces(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List the caller's connections
+	// (GET /connections)
+	GetConnections(w http.ResponseWriter, r *http.Request)
+	// Release a connection
+	// (DELETE /connections/{userId})
+	DeleteConnection(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
+	// Offer a trace to another user
+	// (POST /exchanges)
+	PostExchanges(w http.ResponseWriter, r *http.Request)
+	// Answer an exchange with a trace
+	// (POST /exchanges/{id}/complete)
+	PostExchangeComplete(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Decline an exchange
+	// (POST /exchanges/{id}/decline)
+	PostExchangeDecline(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Health check endpoint
+	// (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Liveness probe
+	// (GET /health/live)
+	GetHealthLive(w http.ResponseWriter, r *http.Request)
+	// Readiness probe
+	// (GET /health/ready)
+	GetHealthReady(w http.ResponseWriter, r *http.Request)
+	// List the caller's interactions
+	// (GET /interactions)
+	GetInteractions(w http.ResponseWriter, r *http.Request, params GetInteractionsParams)
+	// Request an interaction
+	// (POST /interactions)
+	PostInteractions(w http.ResponseWriter, r *http.Request)
+	// Approve an interaction
+	// (POST /interactions/{id}/approve)
+	PostInteractionApprove(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get the signed attestation of an approved interaction
+	// (GET /interactions/{id}/attestation)
+	GetInteractionAttestation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Reject an interaction
+	// (POST /interactions/{id}/reject)
+	PostInteractionReject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Prometheus metrics
+	// (GET /metrics)
+	GetMetrics(w http.ResponseWriter, r *http.Request)
+	// Report a user
+	// (POST /reports)
+	PostReports(w http.ResponseWriter, r *http.Request)
+	// Post a trace
+	// (POST /traces)
+	PostTraces(w http.ResponseWriter, r *http.Request)
+	// View a trace
+	// (GET /traces/{id})
+	GetTrace(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get the caller's account
+	// (GET /users/me)
+	GetUsersMe(w http.ResponseWriter, r *http.Request)
+	// Edit the caller's profile
+	// (PATCH /users/me)
+	PatchUsersMe(w http.ResponseWriter, r *http.Request)
+	// Link a wallet
+	// (POST /users/me/wallet)
+	PostUsersMeWallet(w http.ResponseWriter, r *http.Request)
+	// Start linking a wallet
+	// (POST /users/me/wallet/challenge)
+	PostUsersMeWalletChallenge(w http.ResponseWriter, r *http.Request)
+	// Get a user's profile
+	// (GET /users/{id})
+	GetUser(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// LINE Webhook endpoint
+	// (POST /webhook/line)
+	PostWebhookLine(w http.ResponseWriter, r *http.Request, params PostWebhookLineParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Sign in from the LIFF app
+// (POST /auth/liff)
+func (_ Unimplemented) PostAuthLiff(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Sign in with LINE Login
+// (POST /auth/line)
+func (_ Unimplemented) PostAuthLine(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Refresh a session
+// (POST /auth/refresh)
+func (_ Unimplemented) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the users the caller blocked
+// (GET /blocks)
+func (_ Unimplemented) GetBlocks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Block a user
+// (POST /blocks)
+func (_ Unimplemented) PostBlocks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unblock a user
+// (DELETE /blocks/{userId})
+func (_ Unimplemented) DeleteBlock(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Found a circle
+// (POST /circles)
+func (_ Unimplemented) PostCircles(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Join a circle
+// (POST /circles/{id}/members)
+func (_ Unimplemented) PostCircleMembers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Leave a circle
+// (DELETE /circles/{id}/members/me)
+func (_ Unimplemented) DeleteCircleMembersMe(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the traces posted to a circle
+// (GET /circles/{id}/traces)
+func (_ Unimplemented) GetCircleTraces(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Post a trace to a circle
+// (POST /circles/{id}/traces)
+func (_ Unimplemented) PostCircleTraces(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the caller's connections
+// (GET /connections)
+func (_ Unimplemented) GetConnections(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Release a connection
+// (DELETE /connections/{userId})
+func (_ Unimplemented) DeleteConnection(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Offer a trace to another user
+// (POST /exchanges)
+func (_ Unimplemented) PostExchanges(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Answer an exchange with a trace
+// (POST /exchanges/{id}/complete)
+func (_ Unimplemented) PostExchangeComplete(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Decline an exchange
+// (POST /exchanges/{id}/decline)
+func (_ Unimplemented) PostExchangeDecline(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Health check endpoint
+// (GET /health)
+func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Liveness probe
+// (GET /health/live)
+func (_ Unimplemented) GetHealthLive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Readiness probe
+// (GET /health/ready)
+func (_ Unimplemented) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the caller's interactions
+// (GET /interactions)
+func (_ Unimplemented) GetInteractions(w http.ResponseWriter, r *http.Request, params GetInteractionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Request an interaction
+// (POST /interactions)
+func (_ Unimplemented) PostInteractions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve an interaction
+// (POST /interactions/{id}/approve)
+func (_ Unimplemented) PostInteractionApprove(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the signed attestation of an approved interaction
+// (GET /interactions/{id}/attestation)
+func (_ Unimplemented) GetInteractionAttestation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reject an interaction
+// (POST /interactions/{id}/reject)
+func (_ Unimplemented) PostInteractionReject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Prometheus metrics
+// (GET /metrics)
+func (_ Unimplemented) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report a user
+// (POST /reports)
+func (_ Unimplemented) PostReports(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Post a trace
+// (POST /traces)
+func (_ Unimplemented) PostTraces(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// View a trace
+// (GET /traces/{id})
+func (_ Unimplemented) GetTrace(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the caller's account
+// (GET /users/me)
+func (_ Unimplemented) GetUsersMe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Edit the caller's profile
+// (PATCH /users/me)
+func (_ Unimplemented) PatchUsersMe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Link a wallet
+// (POST /users/me/wallet)
+func (_ Unimplemented) PostUsersMeWallet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start linking a wallet
+// (POST /users/me/wallet/challenge)
+func (_ Unimplemented) PostUsersMeWalletChallenge(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a user's profile
+// (GET /users/{id})
+func (_ Unimplemented) GetUser(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// LINE Webhook endpoint
+// (POST /webhook/line)
+func (_ Unimplemented) PostWebhookLine(w http.ResponseWriter, r *http.Request, params PostWebhookLineParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// PostAuthLiff operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLiff(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLiff(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthLine operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLine(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLine(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBlocks operation middleware
+func (siw *ServerInterfaceWrapper) GetBlocks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBlocks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostBlocks operation middleware
+func (siw *ServerInterfaceWrapper) PostBlocks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBlocks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteBlock operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBlock(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBlock(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostCircles operation middleware
+func (siw *ServerInterfaceWrapper) PostCircles(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCircles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostCircleMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostCircleMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCircleMembers(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCircleMembersMe operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCircleMembersMe(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCircleMembersMe(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCircleTraces operation middleware
+func (siw *ServerInterfaceWrapper) GetCircleTraces(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCircleTraces(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostCircleTraces operation middleware
+func (siw *ServerInterfaceWrapper) PostCircleTraces(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCircleTraces(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConnections operation middleware
+func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConnections(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteConnection operation middleware
+func (siw *ServerInterfaceWrapper) DeleteConnection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteConnection(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostExchanges operation middleware
+func (siw *ServerInterfaceWrapper) PostExchanges(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostExchanges(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostExchangeComplete operation middleware
+func (siw *ServerInterfaceWrapper) PostExchangeComplete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostExchangeComplete(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostExchangeDecline operation middleware
+func (siw *ServerInterfaceWrapper) PostExchangeDecline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostExchangeDecline(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealthLive operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthLive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealthLive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealthReady operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealthReady(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInteractions operation middleware
+func (siw *ServerInterfaceWrapper) GetInteractions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInteractionsParams
+
+	// ------------- Required query parameter "role" -------------
+
+	if paramValue := r.URL.Query().Get("role"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "role"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInteractions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostInteractions operation middleware
+func (siw *ServerInterfaceWrapper) PostInteractions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostInteractions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostInteractionApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostInteractionApprove(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostInteractionApprove(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInteractionAttestation operation middleware
+func (siw *ServerInterfaceWrapper) GetInteractionAttestation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInteractionAttestation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostInteractionReject operation middleware
+func (siw *ServerInterfaceWrapper) PostInteractionReject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostInteractionReject(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetMetrics(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMetrics(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReports operation middleware
+func (siw *ServerInterfaceWrapper) PostReports(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReports(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTraces operation middleware
+func (siw *ServerInterfaceWrapper) PostTraces(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTraces(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTrace operation middleware
+func (siw *ServerInterfaceWrapper) GetTrace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrace(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersMe operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchUsersMe operation middleware
+func (siw *ServerInterfaceWrapper) PatchUsersMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchUsersMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersMeWallet operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMeWallet(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersMeWallet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersMeWalletChallenge operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMeWalletChallenge(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersMeWalletChallenge(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhookLine operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhookLine(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWebhookLineParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Line-Signature" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Line-Signature")]; found {
+		var XLineSignature string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Line-Signature", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Line-Signature", valueList[0], &XLineSignature, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Line-Signature", Err: err})
+			return
+		}
+
+		params.XLineSignature = &XLineSignature
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhookLine(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/liff", wrapper.PostAuthLiff)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/line", wrapper.PostAuthLine)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/refresh", wrapper.PostAuthRefresh)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/blocks", wrapper.GetBlocks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/blocks", wrapper.PostBlocks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/blocks/{userId}", wrapper.DeleteBlock)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/circles", wrapper.PostCircles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/circles/{id}/members", wrapper.PostCircleMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/circles/{id}/members/me", wrapper.DeleteCircleMembersMe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/circles/{id}/traces", wrapper.GetCircleTraces)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/circles/{id}/traces", wrapper.PostCircleTraces)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections", wrapper.GetConnections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/connections/{userId}", wrapper.DeleteConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/exchanges", wrapper.PostExchanges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/exchanges/{id}/complete", wrapper.PostExchangeComplete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/exchanges/{id}/decline", wrapper.PostExchangeDecline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/live", wrapper.GetHealthLive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/ready", wrapper.GetHealthReady)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/interactions", wrapper.GetInteractions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/interactions", wrapper.PostInteractions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/interactions/{id}/approve", wrapper.PostInteractionApprove)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/interactions/{id}/attestation", wrapper.GetInteractionAttestation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/interactions/{id}/reject", wrapper.PostInteractionReject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/metrics", wrapper.GetMetrics)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reports", wrapper.PostReports)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/traces", wrapper.PostTraces)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/traces/{id}", wrapper.GetTrace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/me", wrapper.GetUsersMe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/users/me", wrapper.PatchUsersMe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/me/wallet", wrapper.PostUsersMeWallet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/me/wallet/challenge", wrapper.PostUsersMeWalletChallenge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}", wrapper.GetUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook/line", wrapper.PostWebhookLine)
+	})
+
+	return r
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+x9e3PbtvbgV8FwdybJLC3Lju007uwfbh63vpu0nsTZ7uxVp4bIIws1BegCoB390nz3",
+	"35wDgAQlUqLzcJRb/5VYJPE87+eHJFOzuZIgrUmOPyQmm8KM039PrAVjuRVK4p/wns/mBeB/+Xyu1TXo",
+	"0zw5Tg4Ph/DDwXC4A/tPxzsHe/nBDn+yd7RzcHB0dHh4cDAcDveTtPrmN14UYJNjWRZFmghpQfMM56DB",
+	"jo42DraXpIkwpoT8xCbHyf5w/2BnuLezd3i+Nzx+fHg8HP7/JE1mYNseD/1jDf8uwdj+WxjGHzX3YMSl",
+	"5LbUkBwnw/dH8Phg/MN4fzAY7GWJewqaHu1nTw4fH714fDQ8fP702d4Rf8IfP38y3vvh6Q9wuP/08dMj",
+	"fnSY7T9OPqbJXKs5aCvALB/4hyQHk2kxdxeTnD5nasLsFFhpQLObqWL+9Zx+jY44SZOJ0jOOB1OWIk/S",
+	"xC7muG5jtZCXOPHyPS1Pd+KfPzDsxenZzuEhu6E3Gc9zDcYwbhldD7NiBkma4CHxMQKO1SW0zLgEA937",
+	"q7Z1yy3V0LI89m9TkG7oGtbZDTeMbi1nDw1kSuZsriETRij5KJ4v5xZ2/C5XJvUA2DkjXpZhM7DsYbQd",
+	"lmlwq8BxU/bp8zcgvAfMhPc/CWhWMGN5wjfhhS8HNhHaLc92dLgzXlhg+q+/zF9/XdOMT/b2WfVJyobv",
+	"d+YaJuI95GwK75OOCUCvju7XHxbuD9KAvgZNUwh5ya5gsTqmPymhIU+O/7UE+M0rS2OcD9AUgXK1vPgg",
+	"fq9mVOM/IbO4i58KlV3hJpoUheCsHSnOxQxxYTZnNwFYxzgIIca84BnkvaGwNJsAkIaGnABxM6gtnaAf",
+	"Po2203kG7wxoD4arx7F5nYQoVrn1fupC2xb3TOisgNUl3e6GMhqFrmiiSpnf4o7c+322z62bC6TVEJ64",
+	"mXvR4ZYZ3knx7xKYyEFaMRGg2UTpW44741JMwFjVRm65jUZjYygEXINhQraNJPmshZz8wmeru92A2nkS",
+	"H6wfOV7qJph9plDasvDifTbl8hI6QddqnkHb5f0q61UjrUXaSy8bpkprRB52YxCuL8U1sDH/BNgOC+ja",
+	"BQmXPwMv7HR19TlYLoqGfJlcg0Zex/YOUwbv55AhV9o7bKXRltuSBgJZznA1CjeQqxuZ/L7y/tLC/cft",
+	"65YSsiD6NpcM/kaeqVK2IOcv5WwMGk8+81eYs/CJYTfCTiuEqnckpIVL0Dh1wY0Nl74B+/3t4hctk3UR",
+	"gB6cVWbQi+poKEhaMVMxZ8ZybW9Bd4zVIC/tdHWmN81h3Wspm/LiGnkrXINe4F+TnUJMgM5UlZaZKdeQ",
+	"M+So18IuGgtR5ThGW0mX1I9DZQ4WPptHVftNl0AonPh6QHwl2rA/q57Tn8LCjP7zPzVMkuPkf+zW6t2u",
+	"1+126zGTj9WUXGu+IPgTM9ECda/5ezErZ0xG4F1Nzeag6XRSpjRDAGNiwkpJYxFIdABdBfdLhxZvq/VY",
+	"iHY61tlJGbeQL9BAbRsKGP+5cgARYxID1GQC+hboeDvu3EZkuvizX8l5F6OiB2G9bLygCdyfuv/4fRWd",
+	"MA93Z9VPvcnEXIC0PaZovQRmVb95zFzJvPdlV+uKqL/SLIesEBLyzou6FTdwizKw4fpQfpAkQIQbrFaX",
+	"MiUzqNfYchSbeVLF6JuTPyu1xgNwz8NNRJsOcsEcZI5jpUm8jnBUm4UFWmYNaU2gWIHxasGbxLzTSNH+",
+	"fCNbPVen5Uvcwsw2A8tzbjkup1AZDxeurhaqht8pl7mZ8itYMTv0NayFy60u6XMMYO5VlBNuacS4HaGN",
+	"BidM919/EXIbDV2T3l6aUHRhPM8FDsGLs+goHXotmfSqN1kYoKLzzTPsQNManG9jdfLvfsJN3Y4YNAdf",
+	"pQfBrEg4/SdJeiSizQn1+xGGNaab2xOCM94mB0Tb6C/qRaO2yXoS3ttnpTaqxcz165wjMGb0OJwlfsDm",
+	"/BJqQU/JWgnBJ5upebcZDI8pWlPbOb0Sk8krdSlkp9jHswyMOVdXIFd39er0lxfMvcEsvhJ29ur05Uuk",
+	"HRtFuHj49gVKeHENsk0gBWP83a67NBzhtX8VkVrlLcLnsymXEgqC9VYCM1fGkj7fY7az8C7tdV4sOk6P",
+	"fibawKW5AZzK8Vvc74Cdkx1U5k4/4OxiBmD/uAiYzgSeeUFyyUiGH8ncTlICl7n/nHDXn5ZhFoX1TJVF",
+	"zqSybAyo4qGoIxnX4Ia8mS4Go1bZ3ahSZ73O/K17E/Ek0Pt2VlCJGbhtJiSbiaIQzlZvYgImpD06aNXy",
+	"3S8rpmUakJ7VlCpADY5bFOomSZNSVv+trvn3lr3fwHiq1BUN20aR3Xynz1NWSm9xcByO0ERDDoVAa0x8",
+	"ya/UJb2GhgzSxO20AQYs46UBM9hsN3LbrM+6uisP813YtR79Rd4BvKfPPcZrsKWWTtWgjdKArXqfarWE",
+	"/II/E9DW9hxe2qnS4r+c98YD94CdTpxknNJL1RJmpcGD0nrBhB30MCeupzeva8KyfBgtmrx7mZ0+b9uy",
+	"hfe2+xt8yh6ScID/Cyj6qI8IH0A+wDXNlCZi5oD7WuSgkHmWucB/J4L06UruRE4qsivQ7Sy59VzOIhq4",
+	"ZHf0UlJzm+F9Ro97T/O2IjHNSS61KudtePcPfIDQ8FBMmAN6JI70fq+z1ErN2gZ+o9RsdVx8+5OuyBu8",
+	"aF2Jm7WVznQZ0dDnwmqxz6+oafpcTx3WOE7w5H9zBK6TGOQkYFYxDGtXN1a2DSGIqPWXuGr2vyJvLe0w",
+	"Xls1TcdGr5xjtXOft3CGzkEblPX/wG9ql2hlyJriTPISAnL38JUubWy9Q/JX1JQ3ejVuZXLxRp3I9tLP",
+	"3vIFXCe3Ngc3zQbrfCdIjMie0HlIY5UvWiQUpMx+9cHE1aIp5oK/08Xq5yfW8myKBjJtb5S+QkGf3m5s",
+	"VYt+5PFMq3EBsxZK9fIZe/LD8AmbuzeY8wSZtMGenakf9+yjIlAUnOB7g5F8obXShmTArODGoJpMX10J",
+	"mbOHUlnnC02ZkNe8EBg4Mi9tyjIlJ4XIbIqi7FjkOch0JDW3wLzFGgUi5OggrchQa3tE0ikeKQ2eQyZy",
+	"cHKRU/Cc9Nnl3GqxPt+Q+KCVvAxCBFLqSm6QFjRq5OA2qWSxYIYv2CgR4ZEPOaA3RskgSWvzUeLt2tUZ",
+	"HLPD8TAbDAYbvGhhgIPhQavYKmzR5hqaAvv5/PwsaN7I2pvr+UVZ9hIXkqxhO0uAWNzwhWF8rEp7PC64",
+	"vPoxOm88DMNumifZnDP6sr8waknqWOMaPNOKRJP2W1V26l0hhhkApwfhn4MV6ODX3HLtcXAJszZy6rEg",
+	"r8bK77kw84IvfvEOiw6z0+1IFr0SD+ymbzucN8BzIcGYNk9VHGfYbp3a5LdqOpM/Lhuy/g8sHAGoPmYP",
+	"UZIbcwMpc8OkrBAS/sg0kGWNF+bRIGnZSZdnGS41z/vYhWq7T73x9iObaDDTt2CMUHINQ6TXKt1mE5eJ",
+	"3m6fda60/Vxvk6ZRXNSJKG5h/fTEfnWSlxpgB8dg0e9sotUsmrBNfLytA8uN1M8rw42Sm4DTHegb9y59",
+	"hX9Dvl5+CW/1dC13mz5fqzzwyKb1s9pnBcZzkGSxNKpwVs9cmJkw5hbGzmpr1enUV9rb4tk4sVX7Frdw",
+	"qfSicx9mzmdJmky55sbMQDpd0ku3XqgWkkyxcy24hT8yJa17j6h0qybjFrU2VqwTdokBTPl8DhJI6GiA",
+	"7APDbpQm+9CMv3/lQx/2h8PhF4O5/lFsfaG/K5bBr6/9WuncIstzt6W24dW53VKib9tW4clphwnTOL7s",
+	"onx3hGRZIci4dYE7vGDCC1xCZkWZO1MjvrwjZAsfX2dvfgsyZ9ywi5PYPnTMfgKuQbMP0ccfL1rVT/JD",
+	"mPXRy7E921g1J1C7cu6NvuHCTfaytAshLwvYKQ34SZCKnv369pztopS867/eNPCLeC/91kXTnS+ZJdzh",
+	"dVoiNqENYvdas348bXwDS8fUtbk2eCRFrgUDCCp6Ri7MVRWf3Tt04TNURKdCtK3Nxd3UXyO+mEzNcXEq",
+	"xdAfLhef4uX/1FgXdzJfKdSl91l/EZ36lr6zPElrIPLXvYnxvpvj6Xg9pps4x+pJcztTa+e4BfzXsHdv",
+	"XgVYmrsxmZgFFyHM5nbhOM5MXQMTrTY2r81EfPGwlS0uaTfR+3v4/kzI6u9e1ol3nlrEYRf1tmmf5nh3",
+	"1z8eZGq2654P5kRbad3Jc81v6rjEmdKYATBY0pmOk3+qqWTPFYQgjJ7BEaiuvPOMPXm3t//44PDoyQ9P",
+	"h3yc5TDxFnJce0IkyaVVnLjkBEo7OuQnMD4aHj5++fjF04NnT8dPT4ZPJ48fHx09PXh8+OLJi8neT3AC",
+	"eUvURTcInMU3fWsorm58mVkWkx0hrVZ5SZJDyrhlM2UsOxwO0TSJEgVok2yGjVVT7wPD/DvMR2PfhjAQ",
+	"Fb5dNEZ8c61+6NIZoFu/9Ze68h2XlyV6Yiq39S8vak8puaSsigOMA8/8k9Mf7W7CJsz0S3hxH6E2fRXc",
+	"gU4iwInN7gx23RufRtOis2uzPVQH1EbfnK38WTBkt0Vwb5apajt4xiWTihVKXoIm7zP5vm/BbyK/fwsf",
+	"topkS3eEDes8hr/zzBYLlCDJjZjc/vbO67sKNyhkSMLC9W8UtZsTpJFDGtbKPEv30MlpNmwgdj+0w6BV",
+	"BIY/shm9lHEDzsOKl8Wc6ddvOJtCdmXKWcNQeAsaebuzWj0VVOEhK7Wwi7cojnpDPgm0qCLUf70MoPXP",
+	"386TlZCtWOIn60gkjSPupM0fJhPWKrEPmL8VM5IEf1xWxnKlmQ+C8vNwDSxESDloPRjukdMf9aPKSO8D",
+	"NjgGKIwkl0ouZqo03pPiDeUki0Ny7Ddbnyvy3OTjR8oLnai2sCSQJ2enO7mmgNNnBXDJTnQ2FRYycmSd",
+	"nJ2S7OZ8J+Laje4M10n9I76XpCHZBKFgsDcYItyqOUg+F8lx8ngwHCAPnnM7pYuqzxP/mivTQkJeVMke",
+	"FYFuKGi8ijVil2CNu74LHHNwCfak1kMePrqgjfCRNE6ddSE2bhhhHDCHu8CJHhh2DVpMFgxkPldCWnJb",
+	"eFxACua4gzA4pL4WGTwwUQwEy1xU0YD9iuqvM2NTRnGee82DXh4rS2SR6JaQ7korACBJBb1XCNAYr1UH",
+	"yP3k1ZFginFGgEI4H//un97o4TS1za7WpVCwj01sRGYTBTDTBe4Ph19s/mBjoGmXFWbKHxZkljlYO6f3",
+	"f/2v280d/Gotc596/PVHzlAnIIVDGINiagyMbnl732B5aUVcFKlagKAUL43UB85qQQnhcMoN+bRqeBwr",
+	"4jWHd33GLT64BnVPjv/1e5qYcjbjeuEhAhlvZcoORIC+qgl3H7rCY5StYoqIVLAWShFYoU9qJ2qhJIyk",
+	"56JXsPBEYF6OC2GmYFKXjJ17JRj1+zXkgoiMVHYk/Z32oR9Tfg3IbniWqVLWYpCQzKr1FEXCV6MoS9Fl",
+	"9xSlD0UJIPjtqEm1hJRRzB4ubcZtNv3PJCIVw3d4GJGQYIntpCLnmudEQvybDeIh4WZJVpF588UBe8Gz",
+	"afhtJN1raNMylOUzYGcaDEgK61cSnCyINjpeaOA5EYUcxRPj7SWeYDE1GUlhjXOWr0H/N5Wt+WtQgHZv",
+	"7BaRAX9a/gIg30Jy0ACYb0AT3skrqW5kQ8JoQN/KArce8T1Y1vzdoTwVo3Chpu1VV2yppWnGsblvUsR1",
+	"vLyJ0BhvRCUyQnkPxi+5kMZGHzKuYSSRahbCWMjbMPQfYN0wyWfiRq9AS5qqJchy5bT9mu4eDl97fFCa",
+	"lQ4k/WFuF8hVQPZK+Ct3clt0+b5IS0grWYU0Dz51Ep7MvcXfoC+oWSggxMzPBuzMZWHFOVpVZohhY7A3",
+	"EJw9N8rjMw3u07eiygrRyzMyVYTcznQkb6YimyK78YajWShW4JdxvlSIBpVrhHVMLRmwl15klwwt0kKi",
+	"uDBludA+Qz1dTnRxMYm5VvM55Gm9xpHMuPQpLD5hNA1xpfjF1IUi0gYliCqUjJGEbNAKyNlUzSnzhe3v",
+	"TNV8JNE2QSGwutYtKL7BIzWpfLIigG3b5NLBQxfbjbD6y3PcleI8vZjt3pedv5WNmCbkbwmXbRBzpCmn",
+	"z7eNth0MD+6U3+NFVZGuW0pcCcx8LGjMvHc/uKCaj46oFmDbvD5iYtvYeEi+JJmZnfgfKwpAvweebiwG",
+	"zAoS9TNI0TKbrxZxcUS7qsLgBvRkQoOxirT7d3JckZZar0KrIlE3T2J6UJfntOGffE2tOdd8Bha0SY7/",
+	"9SERuHUkb6F+0nEcgBTThzS6w01hRL+v0JKDDn7GCjGxkG8bdm0hcHt4aIB3SJDo1ERdDRdkaaF4Wcil",
+	"CyKnYagQ+jJaxBVJWmUzmI27lcRnVWbG12BXbZVn7phjucnb7so9qWoRfHOmdY86G1GHkiIqHGigzu4H",
+	"kX/cdcC+Bo9O8rwhrHv/vxukaYkVdiok20cZsvKhevRKnZAp5OVIxhyBKnOiAj3G8B38YCYkVYIK9awQ",
+	"TyG/BJOyGV+wP5WQA/ZPJagOJmmSxBk4k2pHzQe1Mc44HxLInF3gV398CCFnHy+YkMYCz9cj+Wt/Nn1Y",
+	"h/jSbGN4FwjtrlQglXSAsH2y3uO7XEt9IDOlybpYwTO/4YtaBfJg/Q3E0WdLqVdbSngQQ3vQnd0QTtUu",
+	"mb7xSn5Ef6orCBTovL4O5hXgAvg1tJKCkbygh32JgZMgG+TgNXwbgnDQlsUQwLWOINpeTP7maHIwfHqX",
+	"S+gCzG21zwG5TLtR1pmS+pmD6bsqrXnZHExCg6cAHjXB2cra7b7uMs/d/N8BN+5lYabt9LEwe1D2x3/P",
+	"oCPLYoPW3bPhNUZ3bwcOSR4qQvQuqzsKwiYUsmTXwohxAS5zqaEEPDABl1swG4deL2XfMVp/eZ19pZLC",
+	"HSvsnpC0MCC6OHfl99r6PeX6rigXYlVFfKxaFkyahan7+6dvZ3xI8X8oVxs7kiS8pLUdcba2ZrW3XKPN",
+	"Ykp+vtcAaP8waGkcycpr50NYqP6c/z58O+eeeFPZEB8gPaEYA7SlFPiMnJ/ADaAJpJ6/S5CKju1rWhya",
+	"ZcXbgDBayL0VrzcXr8A4hv9lhOjl84k164aLJvaKB0u5d+W4IswhyXvGr4Bqf4UocgzvUhJjtBBu47qb",
+	"NEIN8QjIVzC3axTuajdb5beplxWwLv+bq9lYeKfuYBDnhm0nJr1x18ZiWukQqALPblP4W8s1SsSygmV0",
+	"ZFKoRYhH7FfWi4xWI1kV63KZGj6Q9GUB71moiFgxm6rUO/FDj5FCk4veT2LVSFZ9Vrpk7irM+ys5sFrr",
+	"r92xQBymbwOS8Cwc6BaEXijtb1UqW0EWJTT+vWnLmwo9tl1WJaBvCKuyjpBYIi/Olha6BKyJpG50YEC8",
+	"NoTYEZlpoD/SFGGqNgim0anAyZwcXXn4uVXNpy5FQk3WSwMYI1eaKseum7aE5lLfsU7f1R/rjkO1e1Gy",
+	"uuPEPS37PrT/Rv+Sb0BZK9D5lu6RahEhdrTqT8Os2lJCf0K5+Q0J0OU1O6hvJfU+ULgvpXc2i5wIe5D7",
+	"nJOT/lsDkVRUKyLfRI2f+/m/8yiGXqSwarhzT23uqc13T2085sbkxlGYadXu0hs+Vwx9vobpZ+LjUhXw",
+	"1Tq+rmrpxlJIbYlmlO+LIOo2s1iboOS24yoSVMUH4rPYxU4SnZZgR7Ypw4GqFHAT6klRMiJdQVUd2QzY",
+	"c5iDzEFmwlurEHJ9OYRBm13Vre8VrmFrj/zM71ZgsiSudN2B41Ykvo2A34C6XUKfzqN+hqfkjdp4xqFA",
+	"ri9rY1L3SFjjK+ay0I9VuEtxWZ0Y0jcuRZH7Fq0m9TYPbuuaF/NQry4kikdld6s6biMZeme7nJwOy7i7",
+	"wTe0t6/IxeoKxm00ijJWq68YVezGBRF5enw3izihKBVj6SIaa0GZZEMaoR83hprlxlIbXTVVllNkgTZU",
+	"bPlCqwL+t38O+uIRMlsNGYhryEfSPw+lOy8eLYednHFj2EXdAerCBZNxaixFZTRdO6oLTDxGm1zdlEpJ",
+	"+JEJOobWtlQdUHXabD7VJoD9uwS9qCUw3MJaGSxU3KqOIeoI1lpht32eqoru6sif07usfTJ3rI3Jen7p",
+	"2sXGH+Yw4WVhsYZfmngXXF2gz//V0gH2a8qmy93VWtAKez7MfWW1Bkrcvc4eJFUEtbQSVh1AUFmUUKIp",
+	"NGfTzgF576j7BEfd8l13BNmbK9MwGjKrqI+E0DOKFSDXMRaXv3I90PBQXFpocPZdMKQAxBwHo1BaoM5b",
+	"rYulhPwpwEoLbsYon7T2OBg+AxZQJh1Jgz74pYyta+BFtwa6RP2+TkGFrnLQvSx1+22siL4nlnOfl7nF",
+	"7oETz/SW1Mr9O4/xjWUUq9AxLhfh/kwaLi/waKoPU712w4V1vHYKPPeJOG/A6sXOycSCbqtiSn0AkUDg",
+	"x2wME6UhbndKSTGpE4DpjtoYb80YP26rp9YhAJUMjNqMLsuUzsjmT3dNOqAjpk6qrOipEZfSpQFyawFZ",
+	"IGoiqGl497BZ8nywU0vpRiEO52YqCmA+mT5k/6OGZePm/jgosdAelNKD9fdurmu0hu1E3rx5t39ro11E",
+	"SiLDXSXa3z2Bja7wm5ruTlosdr583zJ+USkoDVQNfVtdCO5C+9K1miz1Up6xDu2TvX1q8prvUK/tmLJV",
+	"ZplKeyRiV/GmGViU9FwxOJ+k7/fS2Zrcf5wTbcy4ZGNwZUIF5OizKIQEX/71grwkmuqjxsui1xcXdKdy",
+	"UW2iEGPNNaaXhhawuZpxIUfy4gNSwGM2iiqujpI02JLwwd4o+XhRha7NtcDzp3MZyQsfi/nQkcJ4S6d5",
+	"6n+Mmm6Hn+quIGkoFVy95coRV7+HV/3PpZB2//AID/ik/stVQTyxjy6oigxoYKUMJa/pQ2d+xB38F2hV",
+	"FSimbYmZt06+k+I98/2BByN54msSR80XMSqf1uNUAHfBx96eSkBxQb+55iT1rT8wIxmqNlblkVMylay9",
+	"SrYThssVmM3GkZN6hO+d8cVb6S60GOP1llB2xD78I+DzltLPf3iznFk5SOq6I6v19yGvzqq1xjXbdMZW",
+	"/RPPGyS0p0O2oaTSvP/ZMt6bUMz7Xsa7l/E+Qcbb2hDiPylHo0V+m4HVIut2c/h+0q6TvSEBazFHG6wB",
+	"6iqgSpsp5OrjhZvadZ5NncsLK89THF/lU0NQYgW3zlPpXGSxeOYLu40XlXVXeJ/YmVYzsFPw3VTRy6YM",
+	"tcxkjqJ08OzXfocbqQqOujsvuFi6mGX6tBq7UGoN0jJ3lijKlGDWup2ivYQLoNtwXejWxHO/FIWvVYsv",
+	"4sbZzDU6VNq4rkLXAm6wiwG+gEQkV+Dwtq6Fta6i7Bu/hK9l/FzuJnjHgdZuAe203x0pte3cDitq7epw",
+	"7QXvrarfbbU7D11xPbC6OEGfZOaQj4HaZlRHxFR50l6PDVkXoo7A6Yx+rvKY73OM73OMt9dFGefVxqhD",
+	"CtFGG1NAoCg2AzNcDcCxR6Q6GynUAUi9l7F+QLb0kWwtGObaDQ7Yz1StNq5fW3Uz5qamUAP2fwVQfzwn",
+	"7GDoxg3XLbUnK8emm+FHFxOpFTXgZ8jojctpFDZk/eoOKejc92z8rrW39Sj9N2eD51USQ8M24otgbClq",
+	"IyY0UTs0y1sXKvrOhKJWXw3UfEvcNerwAxM6tdyT8N4msLajm3ObTdvCMuueYRMBRW7Y3HXSCI3EKdAA",
+	"FUiqUWXqMr/Gh8sukAijeVn6tqsXVQPPi6oa+0qb1lZpCdcYg92Xl5dam9DecfLWOqgvaX15E+i3QEVy",
+	"N6s0RShQrHTc1DStfx4LFYeKOVDAZr33+LsRf1/kYgmBPco0iXbocNqp07wS0ndFcG+uZJ+jmmO5ixar",
+	"O45SB6NmE1Lfc5NCG+I+Xr69Dg7+wGAnLywD3uxdGnUsdS16OtQjj++/ha6tX6nB1pWbYAtR3qN6LYc2",
+	"WtxuUahZ5Tf0TvbqbzQkhiBFD5t/9+oXMVrVyEYHJ5qNqQKSfKNClPU6ffh2FZjmwRDX6l3PSwnsbAZc",
+	"UqDT1kbdSiwOH0CyhYbuZo12za3U9NSYkszBSrpeyxVVnDRPqtFR+aLRUvmCPcTghb2ne49SDBLD5giu",
+	"Q8oiuoFIeZ7y3Hcx8CR0AXbAfJqpcMRiJFs7X4d623tDNhOytN2WqQbprRtXfx0a3NGW+Y4J8fJeO7Ai",
+	"3LC/0i0iws2e2ui4yYqSGDl3fah3yGPkn4cs5DFfbUW9dST6zmmfP0vRReC2lKxRuSFasrv2VfrWy2QX",
+	"q2QYIeE7qrTZ3twtDRgRDDYpNR0RVj5PR1LpRvoA/gm6bmw1XkRDpGsMdu0mNZzye7eonVVSfLvOZ5qy",
+	"/r2fqWFfUyUpMBS9ucWGF75yj4iPN86zv6HH8s9c5gV4l3umZjMuc8MeLiXypKv9KlK2Wrb+kQsGIPRW",
+	"xlJtoHFprZIVPselxOqwPpEB5RCZYx954mHCBUNRi45mcENc3aXqzCRsFPyY+ph5KkTjh3O1GJz0U9e7",
+	"HKx2s7ttipJzHCC4WCiKEBparQVV1IuJKgp1c+EiLZiGS4GPTKNZr7OFaSztSeKes3HlVc/eqNQn0p6q",
+	"84BwWVYBANzeaZU3wlBZN9c8tW5Y+MCwQmW8qMdgBZeXpc8HXB3zxyCZYGcTDSwY6v7J51yCATzfF/IS",
+	"4zIHIxmle1Ez7oxrvWDch4Fe/L8dbDy98zaocRd4QiGq158kr3KoDWQabNVL0MdzuraHrl1vaSB01w0B",
+	"cdEKvE1AQ6Z07lt8kyC8wJtaCQN2jy66RFcfMfOqtYDJUlMtbuDogP38+uTZztufTzC+tqpoFZu4rmAR",
+	"p7k1943Z/cR0XDpOzXaWD3FtMuvvX69/uD+QryhWf70KBH7toe4C5MyU1A56UhbFYhstIN+ASf+ilkCS",
+	"UVU3ORGXJantRjGpqiUvBdx/F+2GidIFYIiqeTQ/+ZCMgWvQ2JUbR0CsclO0Yf8rpK8sh2so1HwG0vrl",
+	"JGlS6gIR2tr58e4u0eGpMvb4h+EPw+Tj7x//ewAOTCmUpMIAAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
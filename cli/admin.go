@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/usecase"
+)
+
+const adminUsage = `usage: traceriver admin [-o table|json] <group> <command> [flags]
+
+users:
+  users list [-limit N] [-offset N]
+  users find (-id ID | -line LINE_USER_ID | -wallet ADDRESS)
+
+interactions:
+  interactions list -user USER_ID [-role requester|approver]
+  interactions approve ID
+  interactions reject ID
+  interactions expire ID
+  interactions notify ID      re-send the request notification to the approver
+
+reports:
+  reports list [-status open|resolved|dismissed] [-limit N] [-offset N]
+  reports resolve [-note TEXT] ID
+  reports dismiss [-note TEXT] ID`
+
+// AdminCommand implements the "admin" subcommand tree used by operators.
+type AdminCommand struct {
+	listUsersUsecase                     *usecase.ListUsersUsecase
+	findUserUsecase                      *usecase.FindUserUsecase
+	listUserInteractionsUsecase          *usecase.ListUserInteractionsUsecase
+	overrideInteractionStatusUsecase     *usecase.OverrideInteractionStatusUsecase
+	resendInteractionNotificationUsecase *usecase.ResendInteractionNotificationUsecase
+	listReportsUsecase                   *usecase.ListReportsUsecase
+	closeReportUsecase                   *usecase.CloseReportUsecase
+}
+
+// NewAdminCommand creates a new AdminCommand.
+func NewAdminCommand(
+	listUsersUsecase *usecase.ListUsersUsecase,
+	findUserUsecase *usecase.FindUserUsecase,
+	listUserInteractionsUsecase *usecase.ListUserInteractionsUsecase,
+	overrideInteractionStatusUsecase *usecase.OverrideInteractionStatusUsecase,
+	resendInteractionNotificationUsecase *usecase.ResendInteractionNotificationUsecase,
+	listReportsUsecase *usecase.ListReportsUsecase,
+	closeReportUsecase *usecase.CloseReportUsecase,
+) *AdminCommand {
+	return &AdminCommand{
+		listUsersUsecase:                     listUsersUsecase,
+		findUserUsecase:                      findUserUsecase,
+		listUserInteractionsUsecase:          listUserInteractionsUsecase,
+		overrideInteractionStatusUsecase:     overrideInteractionStatusUsecase,
+		resendInteractionNotificationUsecase: resendInteractionNotificationUsecase,
+		listReportsUsecase:                   listReportsUsecase,
+		closeReportUsecase:                   closeReportUsecase,
+	}
+}
+
+// Run executes the admin command described by args and writes the result to out.
+func (c *AdminCommand) Run(ctx context.Context, args []string, out io.Writer) error {
+	fs := newFlagSet("admin", adminUsage, out)
+	format := fs.String("o", formatTable, "output format: table or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	p, err := newPrinter(out, *format)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() < 2 {
+		fs.Usage()
+		return fmt.Errorf("missing admin command")
+	}
+
+	group, cmd, rest := fs.Arg(0), fs.Arg(1), fs.Args()[2:]
+	switch group + " " + cmd {
+	case "users list":
+		return c.listUsers(ctx, rest, p)
+	case "users find":
+		return c.findUser(ctx, rest, p)
+	case "interactions list":
+		return c.listInteractions(ctx, rest, p)
+	case "interactions approve":
+		return c.overrideStatus(ctx, rest, p, domain.InteractionStatusApproved)
+	case "interactions reject":
+		return c.overrideStatus(ctx, rest, p, domain.InteractionStatusRejected)
+	case "interactions expire":
+		return c.overrideStatus(ctx, rest, p, domain.InteractionStatusExpired)
+	case "interactions notify":
+		return c.resendNotification(ctx, rest, out)
+	case "reports list":
+		return c.listReports(ctx, rest, p)
+	case "reports resolve":
+		return c.closeReport(ctx, rest, p, domain.ReportStatusResolved)
+	case "reports dismiss":
+		return c.closeReport(ctx, rest, p, domain.ReportStatusDismissed)
+	default:
+		fs.Usage()
+		return fmt.Errorf("unknown admin command: %s %s", group, cmd)
+	}
+}
+
+func (c *AdminCommand) listUsers(ctx context.Context, args []string, p *printer) error {
+	fs := newFlagSet("users list", adminUsage, p.out)
+	limit := fs.Int("limit", 50, "maximum number of users")
+	offset := fs.Int("offset", 0, "number of users to skip")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	output, err := c.listUsersUsecase.Execute(ctx, &usecase.ListUsersInput{
+		Limit:  *limit,
+		Offset: *offset,
+	})
+	if err != nil {
+		return err
+	}
+	return p.users(output.Users)
+}
+
+func (c *AdminCommand) findUser(ctx context.Context, args []string, p *printer) error {
+	fs := newFlagSet("users find", adminUsage, p.out)
+	input := &usecase.FindUserInput{}
+	fs.StringVar(&input.ID, "id", "", "user ID")
+	fs.StringVar(&input.LineUserID, "line", "", "LINE user ID")
+	fs.StringVar(&input.WalletAddress, "wallet", "", "wallet address")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	output, err := c.findUserUsecase.Execute(ctx, input)
+	if err != nil {
+		return err
+	}
+	return p.users([]*domain.User{output.User})
+}
+
+func (c *AdminCommand) listInteractions(ctx context.Context, args []string, p *printer) error {
+	fs := newFlagSet("interactions list", adminUsage, p.out)
+	userID := fs.String("user", "", "user ID (required)")
+	role := fs.String("role", "", "requester or approver (default both)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *userID == "" {
+		return fmt.Errorf("-user is required")
+	}
+
+	output, err := c.listUserInteractionsUsecase.Execute(ctx, &usecase.ListUserInteractionsInput{
+		UserID: *userID,
+		Role:   usecase.InteractionRole(*role),
+	})
+	if err != nil {
+		return err
+	}
+	return p.interactions(output.Interactions)
+}
+
+func (c *AdminCommand) overrideStatus(ctx context.Context, args []string, p *printer, status domain.InteractionStatus) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected exactly one interaction ID")
+	}
+
+	output, err := c.overrideInteractionStatusUsecase.Execute(ctx, &usecase.OverrideInteractionStatusInput{
+		InteractionID: args[0],
+		Status:        status,
+	})
+	if err != nil {
+		return err
+	}
+	return p.interactions([]*domain.Interaction{output.Interaction})
+}
+
+func (c *AdminCommand) resendNotification(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected exactly one interaction ID")
+	}
+
+	if err := c.resendInteractionNotificationUsecase.Execute(ctx, &usecase.ResendInteractionNotificationInput{
+		InteractionID: args[0],
+	}); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "notification re-sent for interaction %s\n", args[0])
+	return nil
+}
+
+func (c *AdminCommand) listReports(ctx context.Context, args []string, p *printer) error {
+	fs := newFlagSet("reports list", adminUsage, p.out)
+	status := fs.String("status", string(domain.ReportStatusOpen), "open, resolved or dismissed")
+	limit := fs.Int("limit", 50, "maximum number of reports")
+	offset := fs.Int("offset", 0, "number of reports to skip")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	output, err := c.listReportsUsecase.Execute(ctx, &usecase.ListReportsInput{
+		Status: domain.ReportStatus(*status),
+		Limit:  *limit,
+		Offset: *offset,
+	})
+	if err != nil {
+		return err
+	}
+	return p.reports(output.Reports)
+}
+
+func (c *AdminCommand) closeReport(ctx context.Context, args []string, p *printer, status domain.ReportStatus) error {
+	fs := newFlagSet("reports "+string(status), adminUsage, p.out)
+	note := fs.String("note", "", "note on the decision, kept with the report")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("expected exactly one report ID")
+	}
+
+	output, err := c.closeReportUsecase.Execute(ctx, &usecase.CloseReportInput{
+		ReportID: fs.Arg(0),
+		Status:   status,
+		Note:     *note,
+	})
+	if err != nil {
+		return err
+	}
+	return p.reports([]*domain.Report{output.Report})
+}
+
+// newFlagSet creates a flag set that reports errors instead of exiting,
+// so Run can be driven from tests and other commands.
+func newFlagSet(name, usage string, out io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(out)
+	fs.Usage = func() { fmt.Fprintln(out, usage) }
+	return fs
+}
@@ -0,0 +1,202 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"gorm.io/gorm"
+
+	"github.com/dkpcb/pet/cli"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+	"github.com/dkpcb/pet/usecase"
+)
+
+const (
+	adminRequesterID   = "11111111-1111-4111-8111-111111111111"
+	adminApproverID    = "22222222-2222-4222-8222-222222222222"
+	adminInteractionID = "44444444-4444-4444-8444-444444444444"
+)
+
+// adminTest runs admin commands against an SQLite database holding two users
+// and a pending interaction between them.
+type adminTest struct {
+	db    *gorm.DB
+	admin *cli.AdminCommand
+}
+
+func newAdminTest(t *testing.T) *adminTest {
+	t.Helper()
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	db, err := infrastructure.OpenDatabase(infrastructure.DialectSQLite, ":memory:", logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	if err := infrastructure.Migrate(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+
+	userRepo := infrastructure.NewUserRepository(db)
+	interactionRepo := infrastructure.NewInteractionRepository(db)
+	for _, u := range []*domain.User{
+		domain.NewUser(adminRequesterID, "U0001", "Ada", nil),
+		domain.NewUser(adminApproverID, "U0002", "Grace", nil),
+	} {
+		if err := userRepo.Save(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pending := domain.NewInteraction(adminInteractionID, adminRequesterID, adminApproverID, domain.InteractionStatusPending, nil, time.Now().Add(-time.Hour))
+	if err := interactionRepo.Save(ctx, pending); err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lineService := infrastructure.NewLineService("channel-token", infrastructure.DefaultLineAPIURL, nil, logger)
+	reportRepo := infrastructure.NewReportRepository(db)
+	return &adminTest{
+		db: db,
+		admin: cli.NewAdminCommand(
+			usecase.NewListUsersUsecase(userRepo),
+			usecase.NewFindUserUsecase(userRepo, infrastructure.NewWalletVerifier()),
+			usecase.NewListUserInteractionsUsecase(interactionRepo, userRepo),
+			usecase.NewOverrideInteractionStatusUsecase(
+				interactionRepo,
+				userRepo,
+				infrastructure.NewAttestationSigner(key),
+				infrastructure.NewRelationshipRepository(db),
+				domain.RelationshipPolicy{HalfLife: 30 * 24 * time.Hour, MinStrength: 0.5},
+			),
+			usecase.NewResendInteractionNotificationUsecase(interactionRepo, userRepo, lineService),
+			usecase.NewListReportsUsecase(reportRepo),
+			usecase.NewCloseReportUsecase(reportRepo),
+		),
+	}
+}
+
+// run runs the admin command with JSON output and decodes the result into v.
+func (at *adminTest) run(t *testing.T, v interface{}, args ...string) {
+	t.Helper()
+	var out bytes.Buffer
+	if err := at.admin.Run(context.Background(), append([]string{"-o", "json"}, args...), &out); err != nil {
+		t.Fatalf("admin %v: %v\n%s", args, err, out.String())
+	}
+	if err := json.Unmarshal(out.Bytes(), v); err != nil {
+		t.Fatalf("admin %v output: %v\n%s", args, err, out.String())
+	}
+}
+
+type adminUser struct {
+	ID          string `json:"id"`
+	LineUserID  string `json:"lineUserId"`
+	DisplayName string `json:"displayName"`
+}
+
+type adminInteraction struct {
+	ID          string `json:"id"`
+	RequesterID string `json:"requesterId"`
+	Status      string `json:"status"`
+}
+
+func TestAdminUsers(t *testing.T) {
+	at := newAdminTest(t)
+
+	var users []adminUser
+	at.run(t, &users, "users", "list")
+	if len(users) != 2 {
+		t.Fatalf("users list = %+v, want both users", users)
+	}
+
+	for name, args := range map[string][]string{
+		"by ID":           {"users", "find", "-id", adminApproverID},
+		"by LINE user ID": {"users", "find", "-line", "U0002"},
+	} {
+		var found []adminUser
+		at.run(t, &found, args...)
+		if len(found) != 1 || found[0].ID != adminApproverID || found[0].DisplayName != "Grace" {
+			t.Errorf("users find %s = %+v, want Grace", name, found)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := at.admin.Run(context.Background(), []string{"users", "find", "-line", "U9999"}, &out); err == nil {
+		t.Error("users find for an unknown LINE user succeeded")
+	}
+}
+
+func TestAdminInteractions(t *testing.T) {
+	ctx := context.Background()
+	at := newAdminTest(t)
+	relationships := infrastructure.NewRelationshipRepository(at.db)
+	strength := func() float64 {
+		t.Helper()
+		rel, err := relationships.FindBetween(ctx, adminRequesterID, adminApproverID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rel == nil {
+			return 0
+		}
+		return rel.Strength
+	}
+
+	var listed []adminInteraction
+	at.run(t, &listed, "interactions", "list", "-user", adminRequesterID, "-role", "requester")
+	if len(listed) != 1 || listed[0].ID != adminInteractionID || listed[0].Status != "pending" {
+		t.Fatalf("interactions list = %+v, want the pending interaction", listed)
+	}
+
+	var notified bytes.Buffer
+	if err := at.admin.Run(ctx, []string{"interactions", "notify", adminInteractionID}, &notified); err != nil {
+		t.Fatalf("interactions notify: %v", err)
+	}
+	if !strings.Contains(notified.String(), adminInteractionID) {
+		t.Errorf("interactions notify output = %q", notified.String())
+	}
+
+	// An operator approval connects the two users like any other.
+	var approved []adminInteraction
+	at.run(t, &approved, "interactions", "approve", adminInteractionID)
+	if len(approved) != 1 || approved[0].Status != "approved" {
+		t.Fatalf("interactions approve = %+v", approved)
+	}
+	if got := strength(); got < domain.MeetingWeight*0.99 {
+		t.Fatalf("strength after approve = %v, want about %v", got, domain.MeetingWeight)
+	}
+
+	// Rejecting it afterwards takes the meeting back, up to the decay in the
+	// part of a second the attested approval time is truncated by.
+	var rejected []adminInteraction
+	at.run(t, &rejected, "interactions", "reject", adminInteractionID)
+	if len(rejected) != 1 || rejected[0].Status != "rejected" {
+		t.Fatalf("interactions reject = %+v", rejected)
+	}
+	if got := strength(); got > 1e-6 {
+		t.Errorf("strength after reject = %v, want 0", got)
+	}
+
+	var expired []adminInteraction
+	at.run(t, &expired, "interactions", "expire", adminInteractionID)
+	if len(expired) != 1 || expired[0].Status != "expired" {
+		t.Fatalf("interactions expire = %+v", expired)
+	}
+	if got := strength(); got > 1e-6 {
+		t.Errorf("strength after expire = %v, want 0", got)
+	}
+}
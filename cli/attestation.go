@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/usecase"
+)
+
+const attestationUsage = `usage: traceriver attestation verify -signer ADDRESS FILE
+
+Verifies an attestation as returned by GET /interactions/{id}/attestation.
+FILE may be "-" to read from standard input. -signer is the server's published
+address; anyone can sign an attestation with their own key, so one signed by
+any other address proves nothing.`
+
+// attestationView is the JSON shape of an attestation.
+// Field names mirror the Attestation schema in openapi.yaml.
+type attestationView struct {
+	InteractionID   string    `json:"interactionId"`
+	RequesterID     string    `json:"requesterId"`
+	ApproverID      string    `json:"approverId"`
+	RequesterWallet *string   `json:"requesterWallet"`
+	ApproverWallet  *string   `json:"approverWallet"`
+	MetAt           time.Time `json:"metAt"`
+	IssuedAt        time.Time `json:"issuedAt"`
+	Signer          string    `json:"signer"`
+	Signature       string    `json:"signature"`
+}
+
+func (v attestationView) toDomain() *domain.Attestation {
+	return &domain.Attestation{
+		InteractionID:   v.InteractionID,
+		RequesterID:     v.RequesterID,
+		ApproverID:      v.ApproverID,
+		RequesterWallet: v.RequesterWallet,
+		ApproverWallet:  v.ApproverWallet,
+		MetAt:           v.MetAt,
+		IssuedAt:        v.IssuedAt,
+		Signer:          v.Signer,
+		Signature:       v.Signature,
+	}
+}
+
+// AttestationCommand implements the "attestation" subcommand.
+// It works offline, so it can be handed to third parties who want to check a proof.
+type AttestationCommand struct {
+	verifyAttestationUsecase *usecase.VerifyAttestationUsecase
+	stdin                    io.Reader
+}
+
+// NewAttestationCommand creates a new AttestationCommand.
+func NewAttestationCommand(verifyAttestationUsecase *usecase.VerifyAttestationUsecase, stdin io.Reader) *AttestationCommand {
+	return &AttestationCommand{
+		verifyAttestationUsecase: verifyAttestationUsecase,
+		stdin:                    stdin,
+	}
+}
+
+// Run executes the attestation command described by args and writes the result to out.
+func (c *AttestationCommand) Run(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) == 0 || args[0] != "verify" {
+		fmt.Fprintln(out, attestationUsage)
+		return fmt.Errorf("unknown attestation command")
+	}
+
+	fs := newFlagSet("attestation verify", attestationUsage, out)
+	trusted := fs.String("signer", "", "trusted signer address")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("expected exactly one attestation file")
+	}
+	if *trusted == "" {
+		fs.Usage()
+		return fmt.Errorf("-signer is required")
+	}
+
+	view, err := c.read(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	output, err := c.verifyAttestationUsecase.Execute(ctx, &usecase.VerifyAttestationInput{
+		Attestation:   view.toDomain(),
+		TrustedSigner: *trusted,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "valid: %s and %s met at %s (interaction %s, signed by %s)\n",
+		view.RequesterID, view.ApproverID, view.MetAt.Format(time.RFC3339), view.InteractionID, output.Signer)
+	return nil
+}
+
+func (c *AttestationCommand) read(path string) (*attestationView, error) {
+	r := c.stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open attestation: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var view attestationView
+	if err := json.NewDecoder(r).Decode(&view); err != nil {
+		return nil, fmt.Errorf("failed to decode attestation: %w", err)
+	}
+	return &view, nil
+}
@@ -0,0 +1,90 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/dkpcb/pet/cli"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+	"github.com/dkpcb/pet/usecase"
+)
+
+// signedAttestation returns an attestation signed with a fresh key, as JSON,
+// and the key's address.
+func signedAttestation(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	attestation := &domain.Attestation{
+		InteractionID: "44444444-4444-4444-8444-444444444444",
+		RequesterID:   "11111111-1111-4111-8111-111111111111",
+		ApproverID:    "22222222-2222-4222-8222-222222222222",
+		MetAt:         time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		IssuedAt:      time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC),
+	}
+	attestation.Signer, attestation.Signature, err = infrastructure.NewAttestationSigner(key).Sign(context.Background(), attestation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(map[string]interface{}{
+		"interactionId": attestation.InteractionID,
+		"requesterId":   attestation.RequesterID,
+		"approverId":    attestation.ApproverID,
+		"metAt":         attestation.MetAt,
+		"issuedAt":      attestation.IssuedAt,
+		"signer":        attestation.Signer,
+		"signature":     attestation.Signature,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b), attestation.Signer
+}
+
+func TestAttestationVerifyRequiresTrustedSigner(t *testing.T) {
+	attestation, signer := signedAttestation(t)
+	// Anyone can sign an attestation with a key of their own.
+	_, forger := signedAttestation(t)
+
+	tests := []struct {
+		name  string
+		args  []string
+		valid bool
+	}{
+		{"no signer", []string{"verify", "-"}, false},
+		{"other signer", []string{"verify", "-signer", forger, "-"}, false},
+		{"trusted signer", []string{"verify", "-signer", signer, "-"}, true},
+		{"trusted signer in lower case", []string{"verify", "-signer", strings.ToLower(signer), "-"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verify := usecase.NewVerifyAttestationUsecase(infrastructure.NewAttestationVerifier(), infrastructure.NewWalletVerifier())
+			var out bytes.Buffer
+			err := cli.NewAttestationCommand(verify, strings.NewReader(attestation)).Run(context.Background(), tt.args, &out)
+			if tt.valid {
+				if err != nil {
+					t.Fatalf("Run: %v\n%s", err, out.String())
+				}
+				if !strings.HasPrefix(out.String(), "valid:") {
+					t.Errorf("output = %q", out.String())
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("attestation accepted")
+			}
+			if strings.Contains(out.String(), "valid:") {
+				t.Errorf("output = %q", out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// Output formats accepted by the -o flag.
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// userView is the JSON shape of a user.
+// Field names mirror the User schema in openapi.yaml.
+type userView struct {
+	ID            string  `json:"id"`
+	LineUserID    string  `json:"lineUserId"`
+	DisplayName   string  `json:"displayName"`
+	WalletAddress *string `json:"walletAddress"`
+}
+
+// interactionView is the JSON shape of an interaction.
+// Field names mirror the Interaction schema in openapi.yaml.
+type interactionView struct {
+	ID          string                 `json:"id"`
+	RequesterID string                 `json:"requesterId"`
+	ApproverID  string                 `json:"approverId"`
+	Status      string                 `json:"status"`
+	Metadata    map[string]interface{} `json:"metadata"`
+	CreatedAt   time.Time              `json:"createdAt"`
+}
+
+// reportView is the JSON shape of a report as moderators see it.
+type reportView struct {
+	ID             string     `json:"id"`
+	ReporterID     string     `json:"reporterId"`
+	ReportedID     string     `json:"reportedId"`
+	Reason         string     `json:"reason"`
+	Details        string     `json:"details"`
+	Status         string     `json:"status"`
+	ResolutionNote *string    `json:"resolutionNote"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	ClosedAt       *time.Time `json:"closedAt"`
+}
+
+func toUserView(u *domain.User) userView {
+	return userView{
+		ID:            u.ID,
+		LineUserID:    u.LineUserID,
+		DisplayName:   u.DisplayName,
+		WalletAddress: u.WalletAddress,
+	}
+}
+
+func toInteractionView(i *domain.Interaction) interactionView {
+	return interactionView{
+		ID:          i.ID,
+		RequesterID: i.RequesterID,
+		ApproverID:  i.ApproverID,
+		Status:      string(i.Status),
+		Metadata:    i.Metadata,
+		CreatedAt:   i.CreatedAt,
+	}
+}
+
+func toReportView(r *domain.Report) reportView {
+	return reportView{
+		ID:             r.ID,
+		ReporterID:     r.ReporterID,
+		ReportedID:     r.ReportedID,
+		Reason:         string(r.Reason),
+		Details:        r.Details,
+		Status:         string(r.Status),
+		ResolutionNote: r.ResolutionNote,
+		CreatedAt:      r.CreatedAt,
+		ClosedAt:       r.ClosedAt,
+	}
+}
+
+// printer renders usecase outputs in the selected format.
+type printer struct {
+	out    io.Writer
+	format string
+}
+
+func newPrinter(out io.Writer, format string) (*printer, error) {
+	if format != formatTable && format != formatJSON {
+		return nil, fmt.Errorf("unknown output format %q (want %s or %s)", format, formatTable, formatJSON)
+	}
+	return &printer{out: out, format: format}, nil
+}
+
+func (p *printer) users(users []*domain.User) error {
+	views := make([]userView, len(users))
+	for i, u := range users {
+		views[i] = toUserView(u)
+	}
+	if p.format == formatJSON {
+		return p.json(views)
+	}
+
+	tw := tabwriter.NewWriter(p.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tLINE USER ID\tDISPLAY NAME\tWALLET")
+	for _, v := range views {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", v.ID, v.LineUserID, v.DisplayName, deref(v.WalletAddress))
+	}
+	return tw.Flush()
+}
+
+func (p *printer) interactions(interactions []*domain.Interaction) error {
+	views := make([]interactionView, len(interactions))
+	for i, in := range interactions {
+		views[i] = toInteractionView(in)
+	}
+	if p.format == formatJSON {
+		return p.json(views)
+	}
+
+	tw := tabwriter.NewWriter(p.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tREQUESTER\tAPPROVER\tSTATUS\tCREATED AT")
+	for _, v := range views {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", v.ID, v.RequesterID, v.ApproverID, v.Status, v.CreatedAt.Format(time.RFC3339))
+	}
+	return tw.Flush()
+}
+
+func (p *printer) reports(reports []*domain.Report) error {
+	views := make([]reportView, len(reports))
+	for i, r := range reports {
+		views[i] = toReportView(r)
+	}
+	if p.format == formatJSON {
+		return p.json(views)
+	}
+
+	tw := tabwriter.NewWriter(p.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tREPORTER\tREPORTED\tREASON\tSTATUS\tCREATED AT\tDETAILS")
+	for _, v := range views {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", v.ID, v.ReporterID, v.ReportedID, v.Reason, v.Status, v.CreatedAt.Format(time.RFC3339), oneLine(v.Details))
+	}
+	return tw.Flush()
+}
+
+func (p *printer) json(v interface{}) error {
+	enc := json.NewEncoder(p.out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// oneLine keeps free-form text from breaking table rows.
+func oneLine(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return "-"
+	}
+	return *s
+}
@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/usecase"
+)
+
+const replayUsage = `usage: traceriver replay [-url URL] [-secret SECRET] [-delay D] FILE
+
+Re-sends LINE webhook requests recorded with WEBHOOK_RECORD_FILE, in the
+order they were received. Each body is signed again with SECRET (default
+$LINE_CHANNEL_SECRET), which must be the secret of the server replayed to.
+
+Without -url, requests go straight to the webhook handler in this process,
+which uses the configured database: point DATABASE_DSN at a scratch
+database. With -url, they are posted to URL/webhook/line.`
+
+// lineWebhookPath is where LINE posts webhook requests.
+const lineWebhookPath = "/webhook/line"
+
+// ReplayCommand implements the "replay" subcommand, which feeds recorded
+// LINE webhook requests to the API again to reproduce what they did.
+type ReplayCommand struct {
+	listRecords   func(path string) *usecase.ListLineWebhookRecordsUsecase
+	local         func() (http.Handler, error)
+	defaultSecret string
+}
+
+// NewReplayCommand creates a new ReplayCommand. listRecords reads the
+// recording at path; local builds the API handler for in-process replays
+// and is only called when no URL is given.
+func NewReplayCommand(
+	listRecords func(path string) *usecase.ListLineWebhookRecordsUsecase,
+	local func() (http.Handler, error),
+	defaultSecret string,
+) *ReplayCommand {
+	return &ReplayCommand{
+		listRecords:   listRecords,
+		local:         local,
+		defaultSecret: defaultSecret,
+	}
+}
+
+// Run executes the replay command described by args and writes each
+// request's result to out. It fails if any request was not accepted.
+func (c *ReplayCommand) Run(ctx context.Context, args []string, out io.Writer) error {
+	fs := newFlagSet("replay", replayUsage, out)
+	target := fs.String("url", "", "base URL of a running server; empty replays in-process")
+	secret := fs.String("secret", c.defaultSecret, "channel secret to sign requests with")
+	delay := fs.Duration("delay", 0, "pause between requests")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("expected exactly one recording file")
+	}
+	if *secret == "" {
+		return fmt.Errorf("no channel secret: set -secret or LINE_CHANNEL_SECRET, since the server refuses unsigned requests")
+	}
+
+	output, err := c.listRecords(fs.Arg(0)).Execute(ctx)
+	if err != nil {
+		return err
+	}
+	if len(output.Records) == 0 {
+		return fmt.Errorf("no webhook requests recorded in %s", fs.Arg(0))
+	}
+
+	send, err := c.sender(*target)
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for i, record := range output.Records {
+		if i > 0 && *delay > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(*delay):
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(*target, "/")+lineWebhookPath, bytes.NewReader(record.Body))
+		if err != nil {
+			return fmt.Errorf("failed to build request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Line-Signature", domain.SignLineWebhook(*secret, record.Body))
+
+		status, err := send(req)
+		if err != nil {
+			return fmt.Errorf("request %d: %w", i+1, err)
+		}
+		fmt.Fprintf(out, "%d\t%s\t%d %s\n", i+1, record.ReceivedAt.Format(time.RFC3339), status, http.StatusText(status))
+		if status/100 != 2 {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d requests were not accepted", failed, len(output.Records))
+	}
+	return nil
+}
+
+// sender returns how requests are delivered: over HTTP to target, or to
+// the in-process handler when target is empty.
+func (c *ReplayCommand) sender(target string) (func(*http.Request) (int, error), error) {
+	if target != "" {
+		client := &http.Client{Timeout: 30 * time.Second}
+		return func(req *http.Request) (int, error) {
+			resp, err := client.Do(req)
+			if err != nil {
+				return 0, err
+			}
+			defer resp.Body.Close()
+			io.Copy(io.Discard, resp.Body)
+			return resp.StatusCode, nil
+		}, nil
+	}
+
+	handler, err := c.local()
+	if err != nil {
+		return nil, err
+	}
+	return func(req *http.Request) (int, error) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code, nil
+	}, nil
+}
@@ -0,0 +1,171 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dkpcb/pet/cli"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+	"github.com/dkpcb/pet/usecase"
+)
+
+const (
+	recordedUserID     = "U4af4980629aaaaaaaaaaaaaaaaaaaaaa"
+	recordedText       = "meet_7f1c7a46-3b0e-4f43-9d1e-4a8d2b0c9e11"
+	recordedReplyToken = "nHuyWiB7yP5Zw52FIkcQobQuGDXCTA"
+	replaySecret       = "replay-secret"
+)
+
+// recordedBody is a webhook request with two events from the same user.
+var recordedBody = `{"destination":"Ubot","events":[` +
+	`{"type":"message","replyToken":"` + recordedReplyToken + `","source":{"type":"user","userId":"` + recordedUserID + `"},"message":{"type":"text","id":"1","text":"` + recordedText + `"}},` +
+	`{"type":"follow","replyToken":"` + recordedReplyToken + `","source":{"type":"user","userId":"` + recordedUserID + `"}}]}`
+
+// replayedEvent is the part of a replayed event the test checks.
+type replayedEvent struct {
+	ReplyToken string `json:"replyToken"`
+	Source     struct {
+		UserID string `json:"userId"`
+	} `json:"source"`
+	Message *struct {
+		Text string `json:"text"`
+	} `json:"message"`
+}
+
+var pseudonym = regexp.MustCompile(`^U[0-9a-f]{32}$`)
+
+func TestReplayRecordedWebhooks(t *testing.T) {
+	tests := []struct {
+		name      string
+		redaction usecase.WebhookRedaction
+	}{
+		{"none", usecase.WebhookRedaction{}},
+		{"user_ids", usecase.WebhookRedaction{UserIDs: true}},
+		{"text", usecase.WebhookRedaction{Text: true}},
+		{"reply_tokens", usecase.WebhookRedaction{ReplyTokens: true}},
+		{"all", usecase.WebhookRedaction{UserIDs: true, Text: true, ReplyTokens: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			path := filepath.Join(t.TempDir(), "webhooks.jsonl")
+			records := infrastructure.NewLineWebhookRecordRepository(path)
+
+			// Record the request twice, as if LINE had sent it twice.
+			record := usecase.NewRecordLineWebhookUsecase(records, tt.redaction)
+			for i := 0; i < 2; i++ {
+				err := record.Execute(ctx, &usecase.RecordLineWebhookInput{
+					Body:      []byte(recordedBody),
+					Signature: domain.SignLineWebhook("original-secret", []byte(recordedBody)),
+				})
+				if err != nil {
+					t.Fatalf("record: %v", err)
+				}
+			}
+
+			var bodies [][]byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.URL.Path != "/webhook/line" {
+					t.Errorf("path = %s, want /webhook/line", r.URL.Path)
+				}
+				if !domain.VerifyLineWebhookSignature(replaySecret, body, r.Header.Get("X-Line-Signature")) {
+					t.Error("replayed request is not signed with the replay secret")
+				}
+				bodies = append(bodies, body)
+			}))
+			defer server.Close()
+
+			replay := cli.NewReplayCommand(
+				func(path string) *usecase.ListLineWebhookRecordsUsecase {
+					return usecase.NewListLineWebhookRecordsUsecase(infrastructure.NewLineWebhookRecordRepository(path))
+				},
+				func() (http.Handler, error) {
+					t.Fatal("replay to a URL built the local handler")
+					return nil, nil
+				},
+				replaySecret,
+			)
+			var out bytes.Buffer
+			if err := replay.Run(ctx, []string{"-url", server.URL, path}, &out); err != nil {
+				t.Fatalf("replay: %v\n%s", err, out.String())
+			}
+			if len(bodies) != 2 {
+				t.Fatalf("replayed %d requests, want 2", len(bodies))
+			}
+
+			var userIDs []string
+			for _, body := range bodies {
+				var request struct {
+					Events []replayedEvent `json:"events"`
+				}
+				if err := json.Unmarshal(body, &request); err != nil {
+					t.Fatalf("replayed body: %v", err)
+				}
+				if len(request.Events) != 2 {
+					t.Fatalf("replayed %d events, want 2", len(request.Events))
+				}
+				for _, event := range request.Events {
+					userIDs = append(userIDs, event.Source.UserID)
+					if want := tt.redaction.ReplyTokens; (event.ReplyToken != recordedReplyToken) != want {
+						t.Errorf("reply token = %q, redacted %v", event.ReplyToken, want)
+					}
+					if event.Message != nil {
+						if want := tt.redaction.Text; (event.Message.Text != recordedText) != want {
+							t.Errorf("text = %q, redacted %v", event.Message.Text, want)
+						}
+					}
+				}
+				if tt.redaction.UserIDs && strings.Contains(string(body), recordedUserID) {
+					t.Errorf("replayed body still holds the user ID: %s", body)
+				}
+			}
+
+			// One user stays one user, pseudonymized or not, across events
+			// and requests.
+			for _, id := range userIDs {
+				if id != userIDs[0] {
+					t.Errorf("user IDs %v differ", userIDs)
+					break
+				}
+			}
+			if tt.redaction.UserIDs {
+				if !pseudonym.MatchString(userIDs[0]) || userIDs[0] == recordedUserID {
+					t.Errorf("user ID %q is not a pseudonym", userIDs[0])
+				}
+			} else if userIDs[0] != recordedUserID {
+				t.Errorf("user ID = %q, want %q", userIDs[0], recordedUserID)
+			}
+		})
+	}
+}
+
+func TestReplayRequiresSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhooks.jsonl")
+	record := usecase.NewRecordLineWebhookUsecase(infrastructure.NewLineWebhookRecordRepository(path), usecase.WebhookRedaction{})
+	if err := record.Execute(context.Background(), &usecase.RecordLineWebhookInput{Body: []byte(recordedBody)}); err != nil {
+		t.Fatal(err)
+	}
+	replay := cli.NewReplayCommand(
+		func(path string) *usecase.ListLineWebhookRecordsUsecase {
+			return usecase.NewListLineWebhookRecordsUsecase(infrastructure.NewLineWebhookRecordRepository(path))
+		},
+		func() (http.Handler, error) {
+			t.Fatal("replay without a secret built the local handler")
+			return nil, nil
+		},
+		"",
+	)
+	if err := replay.Run(context.Background(), []string{path}, io.Discard); err == nil {
+		t.Error("replay without a secret succeeded")
+	}
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+	"github.com/dkpcb/pet/logging"
+	"github.com/dkpcb/pet/usecase"
+)
+
+// databaseConfig holds the connection settings read from the environment.
+type databaseConfig struct {
+	Dialect infrastructure.Dialect
+	DSN     string
+}
+
+// loadDatabaseConfig reads DATABASE_DIALECT and DATABASE_DSN.
+// It defaults to a local SQLite file so the binary runs without a database server.
+func loadDatabaseConfig() databaseConfig {
+	return databaseConfig{
+		Dialect: infrastructure.Dialect(getenv("DATABASE_DIALECT", string(infrastructure.DialectSQLite))),
+		DSN:     getenv("DATABASE_DSN", "traceriver.db"),
+	}
+}
+
+// logConfig holds how logs are written, read from the environment.
+type logConfig struct {
+	Format logging.Format
+	Level  string
+}
+
+// loadLogConfig reads LOG_FORMAT (json or text) and LOG_LEVEL (debug, info,
+// warn or error). JSON is the default, for production log collectors; use
+// text when reading logs in a terminal.
+func loadLogConfig() logConfig {
+	return logConfig{
+		Format: logging.Format(getenv("LOG_FORMAT", string(logging.FormatJSON))),
+		Level:  getenv("LOG_LEVEL", "info"),
+	}
+}
+
+// tracingConfig holds where spans are exported, read from the standard
+// OpenTelemetry environment variables.
+type tracingConfig struct {
+	// Exporter is "otlp" or "none".
+	Exporter string
+	// Endpoint is the collector's traces URL.
+	Endpoint    string
+	Headers     map[string]string
+	ServiceName string
+}
+
+// loadTracingConfig reads OTEL_TRACES_EXPORTER (otlp or none, the default),
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT, or OTEL_EXPORTER_OTLP_ENDPOINT with
+// /v1/traces appended, OTEL_EXPORTER_OTLP_HEADERS (comma-separated
+// key=value pairs) and OTEL_SERVICE_NAME.
+func loadTracingConfig() tracingConfig {
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+	if endpoint == "" {
+		endpoint = strings.TrimSuffix(getenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4318"), "/") + "/v1/traces"
+	}
+	headers := map[string]string{}
+	for _, pair := range getenvList("OTEL_EXPORTER_OTLP_HEADERS") {
+		if k, v, ok := strings.Cut(pair, "="); ok {
+			headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+	return tracingConfig{
+		Exporter:    getenv("OTEL_TRACES_EXPORTER", "none"),
+		Endpoint:    endpoint,
+		Headers:     headers,
+		ServiceName: getenv("OTEL_SERVICE_NAME", "traceriver"),
+	}
+}
+
+// lineConfig holds the LINE Messaging API credentials read from the environment.
+type lineConfig struct {
+	ChannelAccessToken string
+	// ChannelSecret verifies that webhook requests come from LINE.
+	ChannelSecret string
+	// APIURL is the base URL of the Messaging API.
+	APIURL string
+}
+
+// loadLineConfig reads LINE_CHANNEL_ACCESS_TOKEN, LINE_CHANNEL_SECRET and
+// LINE_API_URL.
+func loadLineConfig() lineConfig {
+	return lineConfig{
+		ChannelAccessToken: os.Getenv("LINE_CHANNEL_ACCESS_TOKEN"),
+		ChannelSecret:      os.Getenv("LINE_CHANNEL_SECRET"),
+		APIURL:             getenv("LINE_API_URL", infrastructure.DefaultLineAPIURL),
+	}
+}
+
+// webhookRecordConfig holds whether LINE webhook requests are recorded for
+// replaying, read from the environment.
+type webhookRecordConfig struct {
+	// File is the JSON Lines file requests are appended to; empty records nothing.
+	File string
+	// Redact names what is removed before recording: user_ids, text and
+	// reply_tokens.
+	Redact []string
+}
+
+// loadWebhookRecordConfig reads WEBHOOK_RECORD_FILE and WEBHOOK_RECORD_REDACT
+// (comma-separated, or "none"). By default everything is removed, since
+// recordings hold real users' traffic; name fewer options, or "none", to
+// keep more of it.
+func loadWebhookRecordConfig() webhookRecordConfig {
+	redact := getenvList("WEBHOOK_RECORD_REDACT")
+	if redact == nil {
+		redact = []string{"user_ids", "text", "reply_tokens"}
+	}
+	return webhookRecordConfig{
+		File:   os.Getenv("WEBHOOK_RECORD_FILE"),
+		Redact: redact,
+	}
+}
+
+// serverConfig holds the HTTP server settings read from the environment.
+type serverConfig struct {
+	Addr string
+	// AttestationSigningKey is the hex secp256k1 key attestations are signed with.
+	AttestationSigningKey string
+	// CORSAllowedOrigins are the browser origins, such as the LIFF app's, allowed to call the API.
+	CORSAllowedOrigins []string
+	// StaticDir is a front-end bundle to serve next to the API; empty serves none.
+	StaticDir string
+}
+
+// loadServerConfig reads HTTP_ADDR, ATTESTATION_SIGNING_KEY,
+// CORS_ALLOWED_ORIGINS (comma-separated) and STATIC_DIR.
+func loadServerConfig() serverConfig {
+	return serverConfig{
+		Addr:                  getenv("HTTP_ADDR", ":8080"),
+		AttestationSigningKey: os.Getenv("ATTESTATION_SIGNING_KEY"),
+		CORSAllowedOrigins:    getenvList("CORS_ALLOWED_ORIGINS"),
+		StaticDir:             os.Getenv("STATIC_DIR"),
+	}
+}
+
+// authConfig holds how REST API callers sign in, read from the environment.
+type authConfig struct {
+	LineLogin infrastructure.LineLoginConfig
+	// SessionSecret is the HMAC key access tokens are signed with.
+	SessionSecret string
+	Session       usecase.SessionPolicy
+}
+
+// loadAuthConfig reads LINE_LOGIN_CHANNEL_ID, LINE_LOGIN_JWKS_URL,
+// LINE_LOGIN_JWKS_CACHE_TTL, LINE_API_URL, SESSION_SECRET, ACCESS_TOKEN_TTL and
+// REFRESH_TOKEN_TTL. With the defaults a client refreshes every 15 minutes
+// and stays signed in for 30 days without using the app.
+func loadAuthConfig() (authConfig, error) {
+	jwksCacheTTL, err1 := getenvDuration("LINE_LOGIN_JWKS_CACHE_TTL", time.Hour)
+	accessTokenTTL, err2 := getenvDuration("ACCESS_TOKEN_TTL", 15*time.Minute)
+	refreshTokenTTL, err3 := getenvDuration("REFRESH_TOKEN_TTL", 30*24*time.Hour)
+	if err := errors.Join(err1, err2, err3); err != nil {
+		return authConfig{}, err
+	}
+	return authConfig{
+		LineLogin: infrastructure.LineLoginConfig{
+			ChannelID:    os.Getenv("LINE_LOGIN_CHANNEL_ID"),
+			JWKSURL:      getenv("LINE_LOGIN_JWKS_URL", infrastructure.DefaultLineLoginJWKSURL),
+			JWKSCacheTTL: jwksCacheTTL,
+			APIURL:       getenv("LINE_API_URL", infrastructure.DefaultLineAPIURL),
+		},
+		SessionSecret: os.Getenv("SESSION_SECRET"),
+		Session: usecase.SessionPolicy{
+			AccessTokenTTL:  accessTokenTTL,
+			RefreshTokenTTL: refreshTokenTTL,
+		},
+	}, nil
+}
+
+// relationshipConfig holds how relationships fade, read from the environment.
+type relationshipConfig struct {
+	Policy domain.RelationshipPolicy
+	// DecayInterval is how often stored strengths are decayed; 0 disables the job.
+	DecayInterval time.Duration
+}
+
+// loadRelationshipConfig reads RELATIONSHIP_HALF_LIFE, RELATIONSHIP_MIN_STRENGTH,
+// RELATIONSHIP_MAX_DEGREE and RELATIONSHIP_DECAY_INTERVAL. With the defaults a
+// single meeting stops connecting two people after about two months without
+// further activity, and nobody holds more than 150 connections at once.
+func loadRelationshipConfig() (relationshipConfig, error) {
+	halfLife, err1 := getenvDuration("RELATIONSHIP_HALF_LIFE", 30*24*time.Hour)
+	minStrength, err2 := getenvFloat("RELATIONSHIP_MIN_STRENGTH", 0.25)
+	maxDegree, err3 := getenvInt("RELATIONSHIP_MAX_DEGREE", 150)
+	decayInterval, err4 := getenvDuration("RELATIONSHIP_DECAY_INTERVAL", time.Hour)
+	if err := errors.Join(err1, err2, err3, err4); err != nil {
+		return relationshipConfig{}, err
+	}
+	return relationshipConfig{
+		Policy: domain.RelationshipPolicy{
+			HalfLife:    halfLife,
+			MinStrength: minStrength,
+			MaxDegree:   maxDegree,
+		},
+		DecayInterval: decayInterval,
+	}, nil
+}
+
+// rateLimitConfig holds the rate limits read from the environment.
+type rateLimitConfig struct {
+	// Store is where token buckets live: "memory" or "sql".
+	Store    string
+	Requests usecase.RequestLimits
+	// LineEvents limits the webhook events each LINE user can trigger.
+	LineEvents domain.RateLimit
+}
+
+// loadRateLimitConfig reads RATE_LIMIT_STORE, INTERACTION_REQUEST_BURST,
+// INTERACTION_REQUEST_REFILL, MAX_PENDING_REQUESTS_PER_APPROVER,
+// LINE_EVENT_BURST and LINE_EVENT_REFILL. A burst of 0 disables a limit.
+// With the defaults a user can send 5 requests at once and one more every
+// 10 minutes, and nobody has more than 20 requests waiting on them.
+func loadRateLimitConfig() (rateLimitConfig, error) {
+	requestBurst, err1 := getenvInt("INTERACTION_REQUEST_BURST", 5)
+	requestRefill, err2 := getenvDuration("INTERACTION_REQUEST_REFILL", 10*time.Minute)
+	maxPending, err3 := getenvInt("MAX_PENDING_REQUESTS_PER_APPROVER", 20)
+	lineEventBurst, err4 := getenvInt("LINE_EVENT_BURST", 30)
+	lineEventRefill, err5 := getenvDuration("LINE_EVENT_REFILL", 2*time.Second)
+	if err := errors.Join(err1, err2, err3, err4, err5); err != nil {
+		return rateLimitConfig{}, err
+	}
+	return rateLimitConfig{
+		Store: getenv("RATE_LIMIT_STORE", "memory"),
+		Requests: usecase.RequestLimits{
+			PerRequester: domain.RateLimit{
+				Burst:  requestBurst,
+				Refill: requestRefill,
+			},
+			MaxPendingPerApprover: maxPending,
+		},
+		LineEvents: domain.RateLimit{
+			Burst:  lineEventBurst,
+			Refill: lineEventRefill,
+		},
+	}, nil
+}
+
+func getenv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
+
+// getenvList splits key on commas, dropping blank entries.
+func getenvList(key string) []string {
+	var list []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// getenvDuration parses key as a time.Duration, falling back if it is unset
+// or empty. A value that does not parse is an error rather than the
+// fallback, so a typo fails startup instead of going unnoticed.
+func getenvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: want a duration such as 90s or 1h", key, v)
+	}
+	return d, nil
+}
+
+// getenvFloat parses key as a float64, falling back if it is unset or empty.
+func getenvFloat(key string, fallback float64) (float64, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: want a number", key, v)
+	}
+	return f, nil
+}
+
+// getenvInt parses key as an int, falling back if it is unset or empty.
+func getenvInt(key string, fallback int) (int, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: want an integer", key, v)
+	}
+	return n, nil
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadRateLimitConfigRejectsInvalidValues(t *testing.T) {
+	t.Setenv("INTERACTION_REQUEST_BURST", "five")
+	t.Setenv("LINE_EVENT_REFILL", "2")
+
+	_, err := loadRateLimitConfig()
+	if err == nil {
+		t.Fatal("loadRateLimitConfig accepted invalid values")
+	}
+	// Every invalid variable is reported, not just the first.
+	for _, key := range []string{"INTERACTION_REQUEST_BURST", "LINE_EVENT_REFILL"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("error %q does not name %s", err, key)
+		}
+	}
+}
+
+func TestLoadRelationshipConfig(t *testing.T) {
+	t.Setenv("RELATIONSHIP_HALF_LIFE", "")
+	t.Setenv("RELATIONSHIP_MIN_STRENGTH", "0.5")
+	t.Setenv("RELATIONSHIP_DECAY_INTERVAL", "30m")
+
+	cfg, err := loadRelationshipConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// An empty variable falls back like an unset one.
+	if cfg.Policy.HalfLife != 30*24*time.Hour || cfg.Policy.MinStrength != 0.5 || cfg.DecayInterval != 30*time.Minute {
+		t.Errorf("config = %+v", cfg)
+	}
+
+	t.Setenv("RELATIONSHIP_MAX_DEGREE", "1.5")
+	if _, err := loadRelationshipConfig(); err == nil || !strings.Contains(err.Error(), "RELATIONSHIP_MAX_DEGREE") {
+		t.Errorf("err = %v, want RELATIONSHIP_MAX_DEGREE rejected", err)
+	}
+}
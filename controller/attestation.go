@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/usecase"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// AttestationController handles interaction attestation requests.
+type AttestationController struct {
+	getInteractionAttestationUsecase *usecase.GetInteractionAttestationUsecase
+}
+
+// NewAttestationController creates a new AttestationController.
+func NewAttestationController(
+	getInteractionAttestationUsecase *usecase.GetInteractionAttestationUsecase,
+) *AttestationController {
+	return &AttestationController{
+		getInteractionAttestationUsecase: getInteractionAttestationUsecase,
+	}
+}
+
+// GetInteractionAttestation handles GET /interactions/{id}/attestation requests.
+// This implements the operationId: getInteractionAttestation from the OpenAPI spec.
+func (c *AttestationController) GetInteractionAttestation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	output, err := c.getInteractionAttestationUsecase.Execute(r.Context(), &usecase.GetInteractionAttestationInput{
+		InteractionID: id.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toAttestation(output.Attestation))
+}
+
+func toAttestation(a *domain.Attestation) apigen.Attestation {
+	return apigen.Attestation{
+		InteractionId:   apiID(a.InteractionID),
+		RequesterId:     apiID(a.RequesterID),
+		ApproverId:      apiID(a.ApproverID),
+		RequesterWallet: a.RequesterWallet,
+		ApproverWallet:  a.ApproverWallet,
+		MetAt:           a.MetAt,
+		IssuedAt:        a.IssuedAt,
+		Signer:          a.Signer,
+		Signature:       a.Signature,
+	}
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/usecase"
+)
+
+// AuthController handles sign-in and session refresh.
+type AuthController struct {
+	loginWithLineUsecase  *usecase.LoginWithLineUsecase
+	loginWithLiffUsecase  *usecase.LoginWithLiffUsecase
+	refreshSessionUsecase *usecase.RefreshSessionUsecase
+}
+
+// NewAuthController creates a new AuthController.
+func NewAuthController(
+	loginWithLineUsecase *usecase.LoginWithLineUsecase,
+	loginWithLiffUsecase *usecase.LoginWithLiffUsecase,
+	refreshSessionUsecase *usecase.RefreshSessionUsecase,
+) *AuthController {
+	return &AuthController{
+		loginWithLineUsecase:  loginWithLineUsecase,
+		loginWithLiffUsecase:  loginWithLiffUsecase,
+		refreshSessionUsecase: refreshSessionUsecase,
+	}
+}
+
+// PostAuthLine handles POST /auth/line requests.
+// This implements the operationId: postAuthLine from the OpenAPI spec.
+func (c *AuthController) PostAuthLine(w http.ResponseWriter, r *http.Request) {
+	var req apigen.LineLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.IdToken == "" {
+		writeError(w, http.StatusBadRequest, "idToken is required")
+		return
+	}
+
+	output, err := c.loginWithLineUsecase.Execute(r.Context(), &usecase.LoginWithLineInput{
+		IDToken: req.IdToken,
+		Nonce:   stringValue(req.Nonce),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	session := toSession(output.Session)
+	user := toUser(output.User)
+	session.User = &user
+	writeJSON(w, http.StatusOK, session)
+}
+
+// PostAuthLiff handles POST /auth/liff requests.
+// This implements the operationId: postAuthLiff from the OpenAPI spec.
+func (c *AuthController) PostAuthLiff(w http.ResponseWriter, r *http.Request) {
+	var req apigen.LiffLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.AccessToken == "" {
+		writeError(w, http.StatusBadRequest, "accessToken is required")
+		return
+	}
+
+	output, err := c.loginWithLiffUsecase.Execute(r.Context(), &usecase.LoginWithLiffInput{
+		AccessToken: req.AccessToken,
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	session := toSession(output.Session)
+	user := toUser(output.User)
+	session.User = &user
+	writeJSON(w, http.StatusOK, session)
+}
+
+// PostAuthRefresh handles POST /auth/refresh requests.
+// This implements the operationId: postAuthRefresh from the OpenAPI spec.
+func (c *AuthController) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	var req apigen.RefreshSessionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.RefreshToken == "" {
+		writeError(w, http.StatusBadRequest, "refreshToken is required")
+		return
+	}
+
+	output, err := c.refreshSessionUsecase.Execute(r.Context(), &usecase.RefreshSessionInput{
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toSession(output.Session))
+}
+
+func toSession(s *usecase.Session) apigen.Session {
+	return apigen.Session{
+		AccessToken:           s.AccessToken,
+		TokenType:             apigen.Bearer,
+		ExpiresAt:             s.AccessTokenExpiresAt,
+		RefreshToken:          s.RefreshToken,
+		RefreshTokenExpiresAt: s.RefreshTokenExpiresAt,
+	}
+}
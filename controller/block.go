@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/usecase"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// BlockController handles requests about the users the caller blocked.
+type BlockController struct {
+	blockUserUsecase   *usecase.BlockUserUsecase
+	unblockUserUsecase *usecase.UnblockUserUsecase
+	listBlocksUsecase  *usecase.ListBlocksUsecase
+}
+
+// NewBlockController creates a new BlockController.
+func NewBlockController(
+	blockUserUsecase *usecase.BlockUserUsecase,
+	unblockUserUsecase *usecase.UnblockUserUsecase,
+	listBlocksUsecase *usecase.ListBlocksUsecase,
+) *BlockController {
+	return &BlockController{
+		blockUserUsecase:   blockUserUsecase,
+		unblockUserUsecase: unblockUserUsecase,
+		listBlocksUsecase:  listBlocksUsecase,
+	}
+}
+
+// GetBlocks handles GET /blocks requests.
+// This implements the operationId: getBlocks from the OpenAPI spec.
+func (c *BlockController) GetBlocks(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := c.listBlocksUsecase.Execute(r.Context(), &usecase.ListBlocksInput{Blocker: actor})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	blocks := make([]apigen.Block, len(output.Blocks))
+	for i, b := range output.Blocks {
+		blocks[i] = toBlock(b)
+	}
+	writeJSON(w, http.StatusOK, blocks)
+}
+
+// PostBlocks handles POST /blocks requests.
+// This implements the operationId: postBlocks from the OpenAPI spec.
+func (c *BlockController) PostBlocks(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req apigen.BlockUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.UserId == uuid.Nil {
+		writeError(w, http.StatusBadRequest, "userId is required")
+		return
+	}
+
+	output, err := c.blockUserUsecase.Execute(r.Context(), &usecase.BlockUserInput{
+		Blocker:   actor,
+		BlockedID: req.UserId.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toBlock(output.Block))
+}
+
+// DeleteBlock handles DELETE /blocks/{userId} requests.
+// This implements the operationId: deleteBlock from the OpenAPI spec.
+func (c *BlockController) DeleteBlock(w http.ResponseWriter, r *http.Request, userID openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	err := c.unblockUserUsecase.Execute(r.Context(), &usecase.UnblockUserInput{
+		Blocker:   actor,
+		BlockedID: userID.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toBlock(b *domain.Block) apigen.Block {
+	return apigen.Block{
+		UserId:    apiID(b.BlockedID),
+		CreatedAt: b.CreatedAt,
+	}
+}
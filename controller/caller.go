@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/dkpcb/pet/logging"
+	"github.com/dkpcb/pet/usecase"
+)
+
+type callerKey struct{}
+
+// WithCaller returns a context carrying the ID of the authenticated user.
+// Records logged with the context name the user.
+func WithCaller(ctx context.Context, userID string) context.Context {
+	ctx = logging.With(ctx, slog.String(logging.KeyUserID, userID))
+	return context.WithValue(ctx, callerKey{}, userID)
+}
+
+// callerID returns the authenticated user's ID, or "" for anonymous requests.
+func callerID(ctx context.Context) string {
+	id, _ := ctx.Value(callerKey{}).(string)
+	return id
+}
+
+// requireCaller returns the authenticated caller, or responds 401.
+func requireCaller(w http.ResponseWriter, r *http.Request) (usecase.Actor, bool) {
+	id := callerID(r.Context())
+	if id == "" {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return usecase.Actor{}, false
+	}
+	return usecase.Actor{UserID: id}, true
+}
+
+// SessionAuth identifies the caller by the access token in the
+// Authorization: Bearer header. Requests without one pass through as
+// anonymous; a token that is present but invalid is rejected, so clients
+// learn they have to refresh instead of being treated as signed out.
+func SessionAuth(authenticate *usecase.AuthenticateSessionUsecase) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			if header == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			scheme, token, ok := strings.Cut(header, " ")
+			if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_request"`)
+				writeError(w, http.StatusUnauthorized, "Authorization header must be a bearer token")
+				return
+			}
+
+			output, err := authenticate.Execute(r.Context(), &usecase.AuthenticateSessionInput{AccessToken: token})
+			if err != nil {
+				if !errors.Is(err, usecase.ErrInvalidAccessToken) {
+					logging.FromContext(r.Context()).ErrorContext(r.Context(), "failed to authenticate request", "error", err)
+					writeError(w, http.StatusInternalServerError, "internal server error")
+					return
+				}
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				writeError(w, http.StatusUnauthorized, err.Error())
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(WithCaller(r.Context(), output.Actor.UserID)))
+		})
+	}
+}
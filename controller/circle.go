@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/usecase"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// CircleController handles circle and circle-scoped trace requests.
+type CircleController struct {
+	createCircleUsecase     *usecase.CreateCircleUsecase
+	joinCircleUsecase       *usecase.JoinCircleUsecase
+	leaveCircleUsecase      *usecase.LeaveCircleUsecase
+	postTraceUsecase        *usecase.PostTraceUsecase
+	listCircleTracesUsecase *usecase.ListCircleTracesUsecase
+}
+
+// NewCircleController creates a new CircleController.
+func NewCircleController(
+	createCircleUsecase *usecase.CreateCircleUsecase,
+	joinCircleUsecase *usecase.JoinCircleUsecase,
+	leaveCircleUsecase *usecase.LeaveCircleUsecase,
+	postTraceUsecase *usecase.PostTraceUsecase,
+	listCircleTracesUsecase *usecase.ListCircleTracesUsecase,
+) *CircleController {
+	return &CircleController{
+		createCircleUsecase:     createCircleUsecase,
+		joinCircleUsecase:       joinCircleUsecase,
+		leaveCircleUsecase:      leaveCircleUsecase,
+		postTraceUsecase:        postTraceUsecase,
+		listCircleTracesUsecase: listCircleTracesUsecase,
+	}
+}
+
+// PostCircles handles POST /circles requests.
+// This implements the operationId: postCircles from the OpenAPI spec.
+func (c *CircleController) PostCircles(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req apigen.CreateCircleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	output, err := c.createCircleUsecase.Execute(r.Context(), &usecase.CreateCircleInput{
+		Founder:   actor,
+		Name:      req.Name,
+		Manifesto: stringValue(req.Manifesto),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toCircle(output.Circle))
+}
+
+// PostCircleMembers handles POST /circles/{id}/members requests.
+// This implements the operationId: postCircleMembers from the OpenAPI spec.
+func (c *CircleController) PostCircleMembers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := c.joinCircleUsecase.Execute(r.Context(), &usecase.JoinCircleInput{
+		Member:   actor,
+		CircleID: id.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCircle(output.Circle))
+}
+
+// DeleteCircleMembersMe handles DELETE /circles/{id}/members/me requests.
+// This implements the operationId: deleteCircleMembersMe from the OpenAPI spec.
+func (c *CircleController) DeleteCircleMembersMe(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	err := c.leaveCircleUsecase.Execute(r.Context(), &usecase.LeaveCircleInput{
+		Member:   actor,
+		CircleID: id.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetCircleTraces handles GET /circles/{id}/traces requests.
+// This implements the operationId: getCircleTraces from the OpenAPI spec.
+func (c *CircleController) GetCircleTraces(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := c.listCircleTracesUsecase.Execute(r.Context(), &usecase.ListCircleTracesInput{
+		Viewer:   actor,
+		CircleID: id.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	traces := make([]apigen.Trace, 0, len(output.Traces))
+	for _, t := range output.Traces {
+		traces = append(traces, toTrace(t))
+	}
+	writeJSON(w, http.StatusOK, traces)
+}
+
+// PostCircleTraces handles POST /circles/{id}/traces requests.
+// This implements the operationId: postCircleTraces from the OpenAPI spec.
+func (c *CircleController) PostCircleTraces(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req apigen.PostTraceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if stringValue(req.Body) == "" && req.MediaUrl == nil {
+		writeError(w, http.StatusBadRequest, "body or mediaUrl is required")
+		return
+	}
+
+	circleID := id.String()
+	output, err := c.postTraceUsecase.Execute(r.Context(), &usecase.PostTraceInput{
+		Author:   actor,
+		CircleID: &circleID,
+		Body:     stringValue(req.Body),
+		MediaURL: req.MediaUrl,
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toTrace(output.Trace))
+}
+
+func toCircle(c *domain.Circle) apigen.Circle {
+	return apigen.Circle{
+		Id:        apiID(c.ID),
+		FounderId: apiID(c.FounderID),
+		Name:      c.Name,
+		Manifesto: c.Manifesto,
+		CreatedAt: c.CreatedAt,
+	}
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/usecase"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ConnectionController handles requests about the caller's own connections.
+type ConnectionController struct {
+	listConnectionsUsecase   *usecase.ListConnectionsUsecase
+	releaseConnectionUsecase *usecase.ReleaseConnectionUsecase
+}
+
+// NewConnectionController creates a new ConnectionController.
+func NewConnectionController(
+	listConnectionsUsecase *usecase.ListConnectionsUsecase,
+	releaseConnectionUsecase *usecase.ReleaseConnectionUsecase,
+) *ConnectionController {
+	return &ConnectionController{
+		listConnectionsUsecase:   listConnectionsUsecase,
+		releaseConnectionUsecase: releaseConnectionUsecase,
+	}
+}
+
+// GetConnections handles GET /connections requests.
+// This implements the operationId: getConnections from the OpenAPI spec.
+func (c *ConnectionController) GetConnections(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := c.listConnectionsUsecase.Execute(r.Context(), &usecase.ListConnectionsInput{User: actor})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	list := apigen.ConnectionList{Connections: make([]apigen.Connection, 0, len(output.Relationships))}
+	for _, rel := range output.Relationships {
+		list.Connections = append(list.Connections, apigen.Connection{
+			UserId:          apiID(rel.Other(output.UserID)),
+			Strength:        rel.Strength,
+			ExchangeCount:   rel.ExchangeCount,
+			LastExchangedAt: rel.LastExchangedAt,
+			Since:           rel.CreatedAt,
+		})
+	}
+	if output.Limit > 0 {
+		list.Limit = &output.Limit
+	}
+	writeJSON(w, http.StatusOK, list)
+}
+
+// DeleteConnection handles DELETE /connections/{userId} requests.
+// This implements the operationId: deleteConnection from the OpenAPI spec.
+func (c *ConnectionController) DeleteConnection(w http.ResponseWriter, r *http.Request, userID openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	err := c.releaseConnectionUsecase.Execute(r.Context(), &usecase.ReleaseConnectionInput{
+		User:    actor,
+		OtherID: userID.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/usecase"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ExchangeController handles trace exchange requests.
+type ExchangeController struct {
+	offerExchangeUsecase    *usecase.OfferExchangeUsecase
+	completeExchangeUsecase *usecase.CompleteExchangeUsecase
+	declineExchangeUsecase  *usecase.DeclineExchangeUsecase
+}
+
+// NewExchangeController creates a new ExchangeController.
+func NewExchangeController(
+	offerExchangeUsecase *usecase.OfferExchangeUsecase,
+	completeExchangeUsecase *usecase.CompleteExchangeUsecase,
+	declineExchangeUsecase *usecase.DeclineExchangeUsecase,
+) *ExchangeController {
+	return &ExchangeController{
+		offerExchangeUsecase:    offerExchangeUsecase,
+		completeExchangeUsecase: completeExchangeUsecase,
+		declineExchangeUsecase:  declineExchangeUsecase,
+	}
+}
+
+// PostExchanges handles POST /exchanges requests.
+// This implements the operationId: postExchanges from the OpenAPI spec.
+func (c *ExchangeController) PostExchanges(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req apigen.OfferExchangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.RecipientId == uuid.Nil || req.TraceId == uuid.Nil {
+		writeError(w, http.StatusBadRequest, "recipientId and traceId are required")
+		return
+	}
+
+	output, err := c.offerExchangeUsecase.Execute(r.Context(), &usecase.OfferExchangeInput{
+		Offerer:     actor,
+		RecipientID: req.RecipientId.String(),
+		TraceID:     req.TraceId.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toExchange(output.Exchange))
+}
+
+// PostExchangeComplete handles POST /exchanges/{id}/complete requests.
+// This implements the operationId: postExchangeComplete from the OpenAPI spec.
+func (c *ExchangeController) PostExchangeComplete(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req apigen.CompleteExchangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.TraceId == uuid.Nil {
+		writeError(w, http.StatusBadRequest, "traceId is required")
+		return
+	}
+
+	output, err := c.completeExchangeUsecase.Execute(r.Context(), &usecase.CompleteExchangeInput{
+		Recipient:  actor,
+		ExchangeID: id.String(),
+		TraceID:    req.TraceId.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toExchange(output.Exchange))
+}
+
+// PostExchangeDecline handles POST /exchanges/{id}/decline requests.
+// This implements the operationId: postExchangeDecline from the OpenAPI spec.
+func (c *ExchangeController) PostExchangeDecline(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := c.declineExchangeUsecase.Execute(r.Context(), &usecase.DeclineExchangeInput{
+		Recipient:  actor,
+		ExchangeID: id.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toExchange(output.Exchange))
+}
+
+func toExchange(e *domain.Exchange) apigen.Exchange {
+	return apigen.Exchange{
+		Id:              apiID(e.ID),
+		OffererId:       apiID(e.OffererID),
+		RecipientId:     apiID(e.RecipientID),
+		OfferedTraceId:  apiID(e.OfferedTraceID),
+		ResponseTraceId: apiIDPtr(e.ResponseTraceID),
+		Status:          apigen.ExchangeStatus(e.Status),
+		CreatedAt:       e.CreatedAt,
+		RespondedAt:     e.RespondedAt,
+	}
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/logging"
+	"github.com/dkpcb/pet/usecase"
+)
+
+// HealthController handles health check requests.
+type HealthController struct {
+	checkReadinessUsecase *usecase.CheckReadinessUsecase
+}
+
+// NewHealthController creates a new HealthController.
+func NewHealthController(checkReadinessUsecase *usecase.CheckReadinessUsecase) *HealthController {
+	return &HealthController{checkReadinessUsecase: checkReadinessUsecase}
+}
+
+// GetHealth handles GET /health requests.
+// This implements the operationId: getHealth from the OpenAPI spec.
+func (c *HealthController) GetHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": string(apigen.ReadinessStatusOk)})
+}
+
+// GetHealthLive handles GET /health/live requests. The process answering
+// is all liveness means; dependencies are left to readiness, so an outage
+// elsewhere does not get the server restarted.
+// This implements the operationId: getHealthLive from the OpenAPI spec.
+func (c *HealthController) GetHealthLive(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": string(apigen.ReadinessStatusOk)})
+}
+
+// GetHealthReady handles GET /health/ready requests.
+// This implements the operationId: getHealthReady from the OpenAPI spec.
+func (c *HealthController) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+	output, err := c.checkReadinessUsecase.Execute(r.Context())
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	readiness := apigen.Readiness{
+		Status:     apigen.ReadinessStatusOk,
+		Components: make(map[string]apigen.ComponentHealth, len(output.Components)),
+	}
+	status := http.StatusOK
+	if !output.Ready {
+		readiness.Status = apigen.ReadinessStatusDegraded
+		status = http.StatusServiceUnavailable
+	}
+	for _, component := range output.Components {
+		health := apigen.ComponentHealth{Status: apigen.ComponentHealthStatusOk}
+		if component.Detail != "" {
+			health.Detail = &component.Detail
+		}
+		if !component.OK {
+			health.Status = apigen.ComponentHealthStatusDown
+			logging.FromContext(r.Context()).DebugContext(r.Context(), "component not ready",
+				"component", component.Name, "detail", component.Detail, "error", component.Err)
+		}
+		readiness.Components[component.Name] = health
+	}
+	writeJSON(w, status, readiness)
+}
@@ -0,0 +1,196 @@
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/usecase"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// InteractionController handles requests about the caller's interactions.
+type InteractionController struct {
+	listInteractionsUsecase   *usecase.ListInteractionsUsecase
+	requestInteractionUsecase *usecase.RequestInteractionUsecase
+	approveInteractionUsecase *usecase.ApproveInteractionUsecase
+	rejectInteractionUsecase  *usecase.RejectInteractionUsecase
+}
+
+// NewInteractionController creates a new InteractionController.
+func NewInteractionController(
+	listInteractionsUsecase *usecase.ListInteractionsUsecase,
+	requestInteractionUsecase *usecase.RequestInteractionUsecase,
+	approveInteractionUsecase *usecase.ApproveInteractionUsecase,
+	rejectInteractionUsecase *usecase.RejectInteractionUsecase,
+) *InteractionController {
+	return &InteractionController{
+		listInteractionsUsecase:   listInteractionsUsecase,
+		requestInteractionUsecase: requestInteractionUsecase,
+		approveInteractionUsecase: approveInteractionUsecase,
+		rejectInteractionUsecase:  rejectInteractionUsecase,
+	}
+}
+
+// GetInteractions handles GET /interactions requests.
+// This implements the operationId: getInteractions from the OpenAPI spec.
+func (c *InteractionController) GetInteractions(w http.ResponseWriter, r *http.Request, params apigen.GetInteractionsParams) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	input := &usecase.ListInteractionsInput{
+		User: actor,
+		Role: usecase.InteractionRole(params.Role),
+	}
+	if params.Status != nil {
+		status := domain.InteractionStatus(*params.Status)
+		input.Status = &status
+	}
+	if params.Cursor != nil {
+		cursor, err := decodeInteractionCursor(*params.Cursor)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		input.After = cursor
+	}
+	if params.Limit != nil {
+		input.Limit = *params.Limit
+	}
+
+	output, err := c.listInteractionsUsecase.Execute(r.Context(), input)
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	page := apigen.InteractionPage{Interactions: make([]apigen.Interaction, 0, len(output.Interactions))}
+	for _, i := range output.Interactions {
+		page.Interactions = append(page.Interactions, toInteraction(i))
+	}
+	if output.NextCursor != nil {
+		next := encodeInteractionCursor(*output.NextCursor)
+		page.NextCursor = &next
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+// PostInteractions handles POST /interactions requests.
+// This implements the operationId: postInteractions from the OpenAPI spec.
+func (c *InteractionController) PostInteractions(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req apigen.RequestInteractionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.ApproverId == uuid.Nil {
+		writeError(w, http.StatusBadRequest, "approverId is required")
+		return
+	}
+
+	_, err := c.requestInteractionUsecase.Execute(r.Context(), &usecase.RequestInteractionInput{
+		Requester:  actor,
+		ApproverID: req.ApproverId.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	// Requests dropped because of a block get the same answer, so the
+	// response never reveals one.
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// PostInteractionApprove handles POST /interactions/{id}/approve requests.
+// This implements the operationId: postInteractionApprove from the OpenAPI spec.
+func (c *InteractionController) PostInteractionApprove(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := c.approveInteractionUsecase.Execute(r.Context(), &usecase.ApproveInteractionInput{
+		Approver:      actor,
+		InteractionID: id.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toInteraction(output.Interaction))
+}
+
+// PostInteractionReject handles POST /interactions/{id}/reject requests.
+// This implements the operationId: postInteractionReject from the OpenAPI spec.
+func (c *InteractionController) PostInteractionReject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := c.rejectInteractionUsecase.Execute(r.Context(), &usecase.RejectInteractionInput{
+		Approver:      actor,
+		InteractionID: id.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toInteraction(output.Interaction))
+}
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// encodeInteractionCursor makes a cursor opaque to clients, so the
+// pagination key can change without breaking them.
+func encodeInteractionCursor(c domain.InteractionCursor) string {
+	raw := c.CreatedAt.Format(time.RFC3339Nano) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeInteractionCursor(s string) (*domain.InteractionCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	at, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, errInvalidCursor
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, at)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	return &domain.InteractionCursor{CreatedAt: createdAt, ID: id}, nil
+}
+
+func toInteraction(i *domain.Interaction) apigen.Interaction {
+	interaction := apigen.Interaction{
+		Id:          apiID(i.ID),
+		RequesterId: apiID(i.RequesterID),
+		ApproverId:  apiID(i.ApproverID),
+		Status:      apigen.InteractionStatus(i.Status),
+		CreatedAt:   i.CreatedAt,
+	}
+	if i.Metadata != nil {
+		interaction.Metadata = &i.Metadata
+	}
+	return interaction
+}
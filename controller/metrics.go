@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/logging"
+	"github.com/dkpcb/pet/metrics"
+	"github.com/dkpcb/pet/usecase"
+)
+
+// Outcomes of a usecase as reported in metrics: "ok", or the class of the
+// error it failed with.
+const (
+	outcomeOK              = "ok"
+	outcomeNotFound        = "not_found"
+	outcomeInvalidInput    = "invalid_input"
+	outcomeConflict        = "conflict"
+	outcomeForbidden       = "forbidden"
+	outcomeRateLimited     = "rate_limited"
+	outcomeUnauthenticated = "unauthenticated"
+	outcomeInternal        = "internal"
+)
+
+// lineEventTypes are the webhook event types LINE documents. Anything else
+// is counted as "other", so the sender cannot create label values at will.
+var lineEventTypes = map[string]bool{
+	"message": true, "unsend": true, "follow": true, "unfollow": true,
+	"join": true, "leave": true, "memberJoined": true, "memberLeft": true,
+	"postback": true, "videoPlayComplete": true, "beacon": true,
+	"accountLink": true, "things": true, "membership": true, "module": true,
+	"activated": true, "deactivated": true, "botSuspended": true, "botResumed": true,
+}
+
+// Metrics are the counters kept by the HTTP layer.
+type Metrics struct {
+	webhookEvents   *metrics.CounterVec
+	usecaseOutcomes *metrics.CounterVec
+}
+
+// NewMetrics registers the HTTP layer's counters in registry.
+func NewMetrics(registry *metrics.Registry) *Metrics {
+	return &Metrics{
+		webhookEvents: registry.Counter(
+			"traceriver_line_webhook_events_total",
+			"LINE webhook events received, by event type.",
+			"type",
+		),
+		usecaseOutcomes: registry.Counter(
+			"traceriver_usecase_outcomes_total",
+			"Usecases run for API operations and LINE events, by operation and outcome (ok or the error class).",
+			"operation", "outcome",
+		),
+	}
+}
+
+func (m *Metrics) webhookEvent(eventType string) {
+	if !lineEventTypes[eventType] {
+		eventType = "other"
+	}
+	m.webhookEvents.Inc(eventType)
+}
+
+func (m *Metrics) usecaseOutcome(operation, outcome string) {
+	m.usecaseOutcomes.Inc(operation, outcome)
+}
+
+// instrument records the outcome of every request to an operation that
+// runs usecases. The status tells the outcome, since writeUsecaseError maps
+// each error class to its own status.
+func (m *Metrics) instrument(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		if pattern := routePattern(r); pattern != "" && !uninstrumented[pattern] {
+			m.usecaseOutcome(pattern, outcomeOfStatus(rec.status))
+		}
+	})
+}
+
+// outcomeOf classifies the error a usecase returned.
+func outcomeOf(err error) string {
+	switch {
+	case err == nil:
+		return outcomeOK
+	case errors.Is(err, domain.ErrUnauthenticated):
+		return outcomeUnauthenticated
+	case errors.Is(err, domain.ErrNotFound):
+		return outcomeNotFound
+	case errors.Is(err, domain.ErrInvalidInput):
+		return outcomeInvalidInput
+	case errors.Is(err, domain.ErrForbidden):
+		return outcomeForbidden
+	case errors.Is(err, domain.ErrConflict):
+		return outcomeConflict
+	case errors.Is(err, domain.ErrRateLimited):
+		return outcomeRateLimited
+	default:
+		return outcomeInternal
+	}
+}
+
+// outcomeOfStatus classifies a response the way outcomeOf classifies the
+// error behind it.
+func outcomeOfStatus(status int) string {
+	switch {
+	case status < http.StatusBadRequest:
+		return outcomeOK
+	case status == http.StatusUnauthorized:
+		return outcomeUnauthenticated
+	case status == http.StatusNotFound:
+		return outcomeNotFound
+	case status == http.StatusForbidden:
+		return outcomeForbidden
+	case status == http.StatusConflict:
+		return outcomeConflict
+	case status == http.StatusTooManyRequests:
+		return outcomeRateLimited
+	case status < http.StatusInternalServerError:
+		return outcomeInvalidInput
+	default:
+		return outcomeInternal
+	}
+}
+
+// MetricsController serves the metrics for scraping.
+type MetricsController struct {
+	registry *metrics.Registry
+}
+
+// NewMetricsController creates a new MetricsController serving registry,
+// and registers the gauges computed from the database at scrape time.
+func NewMetricsController(
+	registry *metrics.Registry,
+	countInteractionsUsecase *usecase.CountInteractionsUsecase,
+) *MetricsController {
+	registry.GaugeFunc(
+		"traceriver_interactions",
+		"Interactions stored, by status.",
+		[]string{"status"},
+		func(ctx context.Context) ([]metrics.Sample, error) {
+			output, err := countInteractionsUsecase.Execute(ctx)
+			if err != nil {
+				return nil, err
+			}
+			samples := make([]metrics.Sample, 0, len(output.ByStatus))
+			for status, count := range output.ByStatus {
+				samples = append(samples, metrics.Sample{
+					LabelValues: []string{string(status)},
+					Value:       float64(count),
+				})
+			}
+			return samples, nil
+		},
+	)
+	return &MetricsController{registry: registry}
+}
+
+// GetMetrics handles GET /metrics requests in the Prometheus text format.
+// Metrics that cannot be collected are left out rather than failing the
+// scrape, so the rest stay visible while the database is down.
+func (c *MetricsController) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+	if err := c.registry.WriteText(r.Context(), &buf); err != nil {
+		logging.FromContext(r.Context()).ErrorContext(r.Context(), "failed to collect metrics", "error", err)
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		logging.FromContext(r.Context()).DebugContext(r.Context(), "failed to write response", "error", err)
+	}
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/usecase"
+	"github.com/google/uuid"
+)
+
+// ReportController handles user reports.
+type ReportController struct {
+	reportUserUsecase *usecase.ReportUserUsecase
+}
+
+// NewReportController creates a new ReportController.
+func NewReportController(reportUserUsecase *usecase.ReportUserUsecase) *ReportController {
+	return &ReportController{reportUserUsecase: reportUserUsecase}
+}
+
+// PostReports handles POST /reports requests.
+// This implements the operationId: postReports from the OpenAPI spec.
+func (c *ReportController) PostReports(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req apigen.ReportUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.UserId == uuid.Nil || req.Reason == "" {
+		writeError(w, http.StatusBadRequest, "userId and reason are required")
+		return
+	}
+
+	output, err := c.reportUserUsecase.Execute(r.Context(), &usecase.ReportUserInput{
+		Reporter:   actor,
+		ReportedID: req.UserId.String(),
+		Reason:     domain.ReportReason(req.Reason),
+		Details:    stringValue(req.Details),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toReport(output.Report))
+}
+
+// toReport leaves out the moderator's note, which is not for the reporter.
+func toReport(r *domain.Report) apigen.Report {
+	return apigen.Report{
+		Id:         apiID(r.ID),
+		ReportedId: apiID(r.ReportedID),
+		Reason:     apigen.ReportReason(r.Reason),
+		Details:    r.Details,
+		Status:     apigen.ReportStatus(r.Status),
+		CreatedAt:  r.CreatedAt,
+	}
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/logging"
+	"github.com/dkpcb/pet/usecase"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// writeJSON sends v as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		// Nearly always a client that went away; nothing to answer anymore.
+		slog.Debug("failed to write response", "error", err)
+	}
+}
+
+// writeError sends an application/problem+json response with the given status.
+// Problems carry no type of their own, so the title is the status text.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	problem := apigen.Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+	if message != "" {
+		problem.Detail = &message
+	}
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		slog.Debug("failed to write response", "error", err)
+	}
+}
+
+// writeUsecaseError maps an error returned by a usecase to a problem response
+// by its domain error kind. Errors of no kind are internal: they are logged
+// and their text is not shown to the client.
+func writeUsecaseError(w http.ResponseWriter, r *http.Request, err error) {
+	var rateLimitErr *usecase.RateLimitError
+	switch {
+	case errors.Is(err, domain.ErrUnauthenticated):
+		writeError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, domain.ErrNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidInput):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrForbidden):
+		writeError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, domain.ErrConflict):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrRateLimited):
+		if errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter > 0 {
+			seconds := int(math.Ceil(rateLimitErr.RetryAfter.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		}
+		writeError(w, http.StatusTooManyRequests, err.Error())
+	default:
+		logging.FromContext(r.Context()).ErrorContext(r.Context(), "request failed", "method", r.Method, "path", r.URL.Path, "error", err)
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+// apiID converts an ID this server generated to the UUID the OpenAPI
+// schema declares. Anything that does not parse, which only a hand-edited
+// row could hold, comes out as the nil UUID.
+func apiID(id string) openapi_types.UUID {
+	parsed, _ := uuid.Parse(id)
+	return parsed
+}
+
+// apiIDPtr is apiID for optional IDs.
+func apiIDPtr(id *string) *openapi_types.UUID {
+	if id == nil {
+		return nil
+	}
+	parsed := apiID(*id)
+	return &parsed
+}
+
+// stringValue returns the value of an optional string field, or "".
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
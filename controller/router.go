@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/go-chi/chi/v5"
+)
+
+// Controllers groups the handlers for every operation in openapi.yaml.
+// Embedding them makes Controllers the apigen.ServerInterface the
+// generated router dispatches to.
+type Controllers struct {
+	*HealthController
+	*WebhookController
+	*AttestationController
+	*CircleController
+	*TraceController
+	*ExchangeController
+	*ConnectionController
+	*BlockController
+	*ReportController
+	*UserController
+	*InteractionController
+	*AuthController
+	*MetricsController
+	// Static, if set, serves the front-end bundle for GET requests no
+	// operation claims.
+	Static http.Handler
+}
+
+var _ apigen.ServerInterface = (*Controllers)(nil)
+
+// uninstrumented are the operations that run no usecase of their own, so
+// they have no outcome to record. The webhook records its own, per event.
+var uninstrumented = map[string]bool{
+	"GET /health":        true,
+	"GET /health/live":   true,
+	"GET /health/ready":  true,
+	"GET /metrics":       true,
+	"POST /webhook/line": true,
+	"GET /*":             true,
+}
+
+// NewRouter routes each OpenAPI operation to its handler through the
+// router oapi-codegen generates from openapi.yaml, which also parses and
+// validates path and query parameters. Outcomes of the operations that run
+// usecases are recorded in m.
+func NewRouter(c *Controllers, m *Metrics) http.Handler {
+	r := chi.NewRouter()
+	r.Use(traceRoute, m.instrument)
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "not found")
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	})
+	if c.Static != nil {
+		r.Get("/*", c.Static.ServeHTTP)
+	}
+	return apigen.HandlerWithOptions(c, apigen.ChiServerOptions{
+		BaseRouter: r,
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeError(w, http.StatusBadRequest, err.Error())
+		},
+	})
+}
+
+// routePattern returns the operation the request was routed to, as
+// "METHOD /path/{param}", or "" if it matched no route. It is only known
+// once the router has handled the request.
+func routePattern(r *http.Request) string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || rctx.RoutePattern() == "" {
+		return ""
+	}
+	return r.Method + " " + rctx.RoutePattern()
+}
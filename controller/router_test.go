@@ -0,0 +1,95 @@
+package controller_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/controller"
+	"github.com/dkpcb/pet/metrics"
+)
+
+func newTestRouter(t *testing.T) (http.Handler, *metrics.Registry) {
+	t.Helper()
+	static := t.TempDir()
+	if err := os.WriteFile(filepath.Join(static, "index.html"), []byte("<!doctype html>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	registry := metrics.NewRegistry()
+	m := controller.NewMetrics(registry)
+	router := controller.NewRouter(&controller.Controllers{
+		HealthController: controller.NewHealthController(nil),
+		Static:           controller.NewStaticHandler(static),
+	}, m)
+	return router, registry
+}
+
+func TestRouter(t *testing.T) {
+	router, _ := newTestRouter(t)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		wantStatus  int
+		wantProblem bool
+	}{
+		{"operation", http.MethodGet, "/health", http.StatusOK, false},
+		{"malformed path parameter", http.MethodGet, "/traces/not-a-uuid", http.StatusBadRequest, true},
+		{"missing query parameter", http.MethodGet, "/interactions", http.StatusBadRequest, true},
+		{"method not allowed", http.MethodPut, "/health", http.StatusMethodNotAllowed, true},
+		{"static fallback", http.MethodGet, "/circles/123", http.StatusOK, false},
+		{"unknown path", http.MethodPost, "/nope", http.StatusMethodNotAllowed, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if !tt.wantProblem {
+				return
+			}
+			if got := rec.Header().Get("Content-Type"); got != "application/problem+json" {
+				t.Errorf("Content-Type = %q, want application/problem+json", got)
+			}
+			var problem apigen.Problem
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("body is not a problem: %v", err)
+			}
+			if problem.Status != tt.wantStatus {
+				t.Errorf("problem status = %d, want %d", problem.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRouterRecordsOutcomesByRoute(t *testing.T) {
+	router, registry := newTestRouter(t)
+
+	for _, path := range []string{"/health", "/traces/not-a-uuid", "/traces/also-not-a-uuid"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	var buf bytes.Buffer
+	if err := registry.WriteText(context.Background(), &buf); err != nil {
+		t.Fatal(err)
+	}
+	text := buf.String()
+	want := `traceriver_usecase_outcomes_total{operation="GET /traces/{id}",outcome="invalid_input"} 2`
+	if !strings.Contains(text, want) {
+		t.Errorf("metrics do not contain %q:\n%s", want, text)
+	}
+	if strings.Contains(text, `operation="GET /health"`) {
+		t.Errorf("health checks recorded as usecase outcomes:\n%s", text)
+	}
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+)
+
+// NewStaticHandler serves the front-end bundle in dir, such as the built LIFF
+// app. Paths without a file extension that match no file get index.html,
+// so the app's client-side routes can be opened directly.
+func NewStaticHandler(dir string) http.Handler {
+	root := os.DirFS(dir)
+	files := http.FileServerFS(root)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(path.Clean(r.URL.Path), "/")
+		if name == "" {
+			name = "."
+		}
+		if _, err := fs.Stat(root, name); errors.Is(err, fs.ErrNotExist) {
+			if path.Ext(name) != "" {
+				writeError(w, http.StatusNotFound, "not found")
+				return
+			}
+			name = "index.html"
+		}
+		if name == "." || name == "index.html" {
+			// The bundle's other files have hashed names; index.html must
+			// be fetched again to pick up a new release.
+			w.Header().Set("Cache-Control", "no-cache")
+		}
+		if name == "index.html" {
+			http.ServeFileFS(w, r, root, name)
+			return
+		}
+		files.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/usecase"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// TraceController handles requests for traces outside circles.
+type TraceController struct {
+	postTraceUsecase *usecase.PostTraceUsecase
+	viewTraceUsecase *usecase.ViewTraceUsecase
+}
+
+// NewTraceController creates a new TraceController.
+func NewTraceController(
+	postTraceUsecase *usecase.PostTraceUsecase,
+	viewTraceUsecase *usecase.ViewTraceUsecase,
+) *TraceController {
+	return &TraceController{
+		postTraceUsecase: postTraceUsecase,
+		viewTraceUsecase: viewTraceUsecase,
+	}
+}
+
+// PostTraces handles POST /traces requests.
+// This implements the operationId: postTraces from the OpenAPI spec.
+func (c *TraceController) PostTraces(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req apigen.PostTraceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if stringValue(req.Body) == "" && req.MediaUrl == nil {
+		writeError(w, http.StatusBadRequest, "body or mediaUrl is required")
+		return
+	}
+
+	output, err := c.postTraceUsecase.Execute(r.Context(), &usecase.PostTraceInput{
+		Author:   actor,
+		Body:     stringValue(req.Body),
+		MediaURL: req.MediaUrl,
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toTrace(output.Trace))
+}
+
+// GetTrace handles GET /traces/{id} requests.
+// This implements the operationId: getTrace from the OpenAPI spec.
+func (c *TraceController) GetTrace(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := c.viewTraceUsecase.Execute(r.Context(), &usecase.ViewTraceInput{
+		Viewer:  actor,
+		TraceID: id.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toTrace(output.Trace))
+}
+
+func toTrace(t *domain.Trace) apigen.Trace {
+	return apigen.Trace{
+		Id:        apiID(t.ID),
+		AuthorId:  apiID(t.AuthorID),
+		CircleId:  apiIDPtr(t.CircleID),
+		Body:      t.Body,
+		MediaUrl:  t.MediaURL,
+		CreatedAt: t.CreatedAt,
+	}
+}
@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/dkpcb/pet/logging"
+	"github.com/dkpcb/pet/tracing"
+)
+
+// Tracing starts a server span for every request, continuing the caller's
+// trace if it sent a traceparent header, and adds the trace ID to every log
+// record of the request. The router renames the span after the route it
+// matched.
+func Tracing(tracer *tracing.Tracer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			if parent, ok := tracing.Extract(r.Header); ok {
+				ctx = tracing.ContextWithRemoteParent(ctx, parent)
+			}
+			ctx, span := tracer.Start(ctx, r.Method, tracing.KindServer,
+				tracing.String("http.request.method", r.Method),
+				tracing.String("url.path", r.URL.Path),
+			)
+			defer span.End()
+			ctx = logging.With(ctx, slog.String(logging.KeyTraceID, span.SpanContext().TraceID.String()))
+
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r.WithContext(ctx))
+
+			span.SetAttributes(tracing.String("http.response.status_code", strconv.Itoa(rec.status)))
+			if rec.status >= http.StatusInternalServerError {
+				span.SetError(fmt.Errorf("%d %s", rec.status, http.StatusText(rec.status)))
+			}
+		})
+	}
+}
+
+// traceRoute names the request's span after the route it matched, as
+// OpenTelemetry names HTTP server spans.
+func traceRoute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r)
+		if pattern := routePattern(r); pattern != "" {
+			span := tracing.SpanFromContext(r.Context())
+			span.SetName(pattern)
+			span.SetAttributes(tracing.String("http.route", pattern))
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/usecase"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// UserController handles requests about user profiles.
+type UserController struct {
+	getProfileUsecase    *usecase.GetProfileUsecase
+	updateProfileUsecase *usecase.UpdateProfileUsecase
+	linkWalletUsecase    *usecase.LinkWalletUsecase
+}
+
+// NewUserController creates a new UserController.
+func NewUserController(
+	getProfileUsecase *usecase.GetProfileUsecase,
+	updateProfileUsecase *usecase.UpdateProfileUsecase,
+	linkWalletUsecase *usecase.LinkWalletUsecase,
+) *UserController {
+	return &UserController{
+		getProfileUsecase:    getProfileUsecase,
+		updateProfileUsecase: updateProfileUsecase,
+		linkWalletUsecase:    linkWalletUsecase,
+	}
+}
+
+// GetUsersMe handles GET /users/me requests.
+// This implements the operationId: getUsersMe from the OpenAPI spec.
+func (c *UserController) GetUsersMe(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := c.getProfileUsecase.Execute(r.Context(), &usecase.GetProfileInput{Viewer: actor})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toUser(output.User))
+}
+
+// PatchUsersMe handles PATCH /users/me requests.
+// This implements the operationId: patchUsersMe from the OpenAPI spec.
+func (c *UserController) PatchUsersMe(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req apigen.UpdateProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	output, err := c.updateProfileUsecase.Execute(r.Context(), &usecase.UpdateProfileInput{
+		User:        actor,
+		DisplayName: req.DisplayName,
+		Bio:         req.Bio,
+		AvatarURL:   req.AvatarUrl,
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toUser(output.User))
+}
+
+// PostUsersMeWalletChallenge handles POST /users/me/wallet/challenge requests.
+// This implements the operationId: postUsersMeWalletChallenge from the OpenAPI spec.
+func (c *UserController) PostUsersMeWalletChallenge(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req apigen.WalletChallengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.WalletAddress == "" {
+		writeError(w, http.StatusBadRequest, "walletAddress is required")
+		return
+	}
+
+	output, err := c.linkWalletUsecase.IssueChallenge(r.Context(), &usecase.IssueWalletChallengeInput{
+		UserID:        actor.UserID,
+		WalletAddress: req.WalletAddress,
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, apigen.WalletChallenge{
+		WalletAddress: output.WalletAddress,
+		Message:       output.Message,
+		ExpiresAt:     output.ExpiresAt,
+	})
+}
+
+// PostUsersMeWallet handles POST /users/me/wallet requests.
+// This implements the operationId: postUsersMeWallet from the OpenAPI spec.
+func (c *UserController) PostUsersMeWallet(w http.ResponseWriter, r *http.Request) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req apigen.LinkWalletRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.Signature == "" {
+		writeError(w, http.StatusBadRequest, "signature is required")
+		return
+	}
+
+	output, err := c.linkWalletUsecase.Execute(r.Context(), &usecase.LinkWalletInput{
+		UserID:    actor.UserID,
+		Signature: req.Signature,
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toUser(output.User))
+}
+
+// GetUser handles GET /users/{id} requests.
+// This implements the operationId: getUser from the OpenAPI spec.
+func (c *UserController) GetUser(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	actor, ok := requireCaller(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := c.getProfileUsecase.Execute(r.Context(), &usecase.GetProfileInput{
+		Viewer: actor,
+		UserID: id.String(),
+	})
+	if err != nil {
+		writeUsecaseError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toProfile(output.User))
+}
+
+func toUser(u *domain.User) apigen.User {
+	return apigen.User{
+		Id:            apiID(u.ID),
+		LineUserId:    u.LineUserID,
+		DisplayName:   u.DisplayName,
+		Bio:           u.Bio,
+		AvatarUrl:     u.AvatarURL,
+		WalletAddress: u.WalletAddress,
+		Locale:        apigen.UserLocale(u.Locale),
+	}
+}
+
+func toProfile(u *domain.User) apigen.Profile {
+	return apigen.Profile{
+		Id:          apiID(u.ID),
+		DisplayName: u.DisplayName,
+		Bio:         u.Bio,
+		AvatarUrl:   u.AvatarURL,
+	}
+}
@@ -4,127 +4,348 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/dkpcb/pet/apigen"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/logging"
+	"github.com/dkpcb/pet/tracing"
 	"github.com/dkpcb/pet/usecase"
 )
 
 // WebhookController handles LINE webhook requests.
 type WebhookController struct {
 	requestInteractionUsecase *usecase.RequestInteractionUsecase
+	joinCircleUsecase         *usecase.JoinCircleUsecase
+	leaveCircleUsecase        *usecase.LeaveCircleUsecase
+	completeExchangeUsecase   *usecase.CompleteExchangeUsecase
+	declineExchangeUsecase    *usecase.DeclineExchangeUsecase
+	approveInteractionUsecase *usecase.ApproveInteractionUsecase
+	rejectInteractionUsecase  *usecase.RejectInteractionUsecase
+	blockUserUsecase          *usecase.BlockUserUsecase
+	throttleLineEventUsecase  *usecase.ThrottleLineEventUsecase
+	explainFailureUsecase     *usecase.ExplainFailureUsecase
+	syncLineProfileUsecase    *usecase.SyncLineProfileUsecase
+	// recordLineWebhookUsecase keeps each request for replaying; nil disables recording.
+	recordLineWebhookUsecase *usecase.RecordLineWebhookUsecase
+	// channelSecret verifies X-Line-Signature; empty refuses every request.
+	channelSecret string
+	metrics       *Metrics
 }
 
 // NewWebhookController creates a new WebhookController.
 func NewWebhookController(
 	requestInteractionUsecase *usecase.RequestInteractionUsecase,
+	joinCircleUsecase *usecase.JoinCircleUsecase,
+	leaveCircleUsecase *usecase.LeaveCircleUsecase,
+	completeExchangeUsecase *usecase.CompleteExchangeUsecase,
+	declineExchangeUsecase *usecase.DeclineExchangeUsecase,
+	approveInteractionUsecase *usecase.ApproveInteractionUsecase,
+	rejectInteractionUsecase *usecase.RejectInteractionUsecase,
+	blockUserUsecase *usecase.BlockUserUsecase,
+	throttleLineEventUsecase *usecase.ThrottleLineEventUsecase,
+	explainFailureUsecase *usecase.ExplainFailureUsecase,
+	syncLineProfileUsecase *usecase.SyncLineProfileUsecase,
+	recordLineWebhookUsecase *usecase.RecordLineWebhookUsecase,
+	channelSecret string,
+	metrics *Metrics,
 ) *WebhookController {
 	return &WebhookController{
 		requestInteractionUsecase: requestInteractionUsecase,
+		joinCircleUsecase:         joinCircleUsecase,
+		leaveCircleUsecase:        leaveCircleUsecase,
+		completeExchangeUsecase:   completeExchangeUsecase,
+		declineExchangeUsecase:    declineExchangeUsecase,
+		approveInteractionUsecase: approveInteractionUsecase,
+		rejectInteractionUsecase:  rejectInteractionUsecase,
+		blockUserUsecase:          blockUserUsecase,
+		throttleLineEventUsecase:  throttleLineEventUsecase,
+		explainFailureUsecase:     explainFailureUsecase,
+		syncLineProfileUsecase:    syncLineProfileUsecase,
+		recordLineWebhookUsecase:  recordLineWebhookUsecase,
+		channelSecret:             channelSecret,
+		metrics:                   metrics,
 	}
 }
 
-// LineWebhookRequest represents the LINE webhook request structure.
-// This mirrors the OpenAPI schema definition.
-type LineWebhookRequest struct {
-	Destination string      `json:"destination"`
-	Events      []LineEvent `json:"events"`
-}
-
-// LineEvent represents a LINE event.
-type LineEvent struct {
-	Type      string        `json:"type"`
-	Timestamp int64         `json:"timestamp"`
-	Source    LineSource    `json:"source"`
-	Mode      string        `json:"mode"`
-	Message   *LineMessage  `json:"message,omitempty"`
-	Postback  *LinePostback `json:"postback,omitempty"`
-}
-
-// LineSource represents the source of a LINE event.
-type LineSource struct {
-	Type    string  `json:"type"`
-	UserID  string  `json:"userId"`
-	GroupID *string `json:"groupId,omitempty"`
-	RoomID  *string `json:"roomId,omitempty"`
-}
+// maxLineWebhookBody bounds webhook bodies read into memory. LINE batches
+// events, but even a full batch stays far below this.
+const maxLineWebhookBody = 1 << 20
 
-// LineMessage represents a LINE message.
-type LineMessage struct {
-	ID   string  `json:"id"`
-	Type string  `json:"type"`
-	Text *string `json:"text,omitempty"`
-}
+// LINE text commands for circles. Anything else is treated as a "meet_" request.
+const (
+	joinCircleCommand  = "join_"
+	leaveCircleCommand = "leave_"
+)
 
-// LinePostback represents LINE postback data.
-type LinePostback struct {
-	Data string `json:"data"`
-}
+// Operations a LINE event can ask for, as reported in usecase outcome metrics.
+const (
+	lineOpThrottle           = "line:throttle"
+	lineOpSyncProfile        = "line:sync_profile"
+	lineOpJoinCircle         = "line:join_circle"
+	lineOpLeaveCircle        = "line:leave_circle"
+	lineOpRequestInteraction = "line:request_interaction"
+	lineOpPostback           = "line:postback"
+	lineOpApproveInteraction = "line:approve_interaction"
+	lineOpRejectInteraction  = "line:reject_interaction"
+	lineOpCompleteExchange   = "line:complete_exchange"
+	lineOpDeclineExchange    = "line:decline_exchange"
+	lineOpBlockUser          = "line:block_user"
+)
 
 // PostWebhookLine handles POST /webhook/line requests.
 // This implements the operationId: postWebhookLine from the OpenAPI spec.
-func (c *WebhookController) PostWebhookLine(w http.ResponseWriter, r *http.Request) {
+func (c *WebhookController) PostWebhookLine(w http.ResponseWriter, r *http.Request, params apigen.PostWebhookLineParams) {
 	ctx := r.Context()
 
+	// Read the body whole; the signature covers its exact bytes
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxLineWebhookBody))
+	if err != nil {
+		c.sendError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	// Verify the request came from LINE. Without a secret nothing can be
+	// verified, and unverified events could act for any user.
+	if c.channelSecret == "" {
+		c.sendError(w, http.StatusUnauthorized, "webhook is disabled: no channel secret is configured")
+		return
+	}
+	signature := stringValue(params.XLineSignature)
+	if !domain.VerifyLineWebhookSignature(c.channelSecret, body, signature) {
+		c.sendError(w, http.StatusBadRequest, "invalid signature")
+		return
+	}
+
 	// Parse request body
-	var req LineWebhookRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	var req apigen.LineWebhookRequest
+	if err := json.Unmarshal(body, &req); err != nil {
 		c.sendError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
 		return
 	}
 
+	// Record the request before handling it, so a request that breaks the
+	// server is kept too. Failing to record does not fail the request.
+	if c.recordLineWebhookUsecase != nil {
+		if err := c.recordLineWebhookUsecase.Execute(ctx, &usecase.RecordLineWebhookInput{
+			Body:      body,
+			Signature: signature,
+		}); err != nil {
+			logging.FromContext(ctx).WarnContext(ctx, "failed to record webhook", "error", err)
+		}
+	}
+
 	// Process each event
 	// In a production system, you might want to process these asynchronously
 	for _, event := range req.Events {
-		if err := c.handleEvent(ctx, event); err != nil {
-			// Log the error but continue processing other events
-			fmt.Printf("Error handling event: %v\n", err)
-			// Don't return error to LINE as it might retry the same webhook
-		}
+		c.processEvent(ctx, event)
 	}
 
 	// Respond with success
 	c.sendSuccess(w)
 }
 
-// handleEvent processes a single LINE event.
-func (c *WebhookController) handleEvent(ctx context.Context, event LineEvent) error {
+// processEvent handles one event in a span of its own and records how it
+// went. Errors are logged, not returned, so one bad event does not stop the
+// others.
+func (c *WebhookController) processEvent(ctx context.Context, event apigen.LineEvent) {
+	eventType := string(event.Type)
+	ctx = logging.With(ctx,
+		slog.String(logging.KeyWebhookEventID, stringValue(event.WebhookEventId)),
+		slog.String(logging.KeyLineUserID, event.Source.UserId),
+	)
+	ctx, span := tracing.Start(ctx, "line:event", tracing.KindInternal,
+		tracing.String("line.webhook_event_id", stringValue(event.WebhookEventId)),
+		tracing.String("line.event_type", eventType),
+	)
+	defer span.End()
+
+	c.metrics.webhookEvent(eventType)
+	operation, err := c.handleEvent(ctx, event)
+	if operation != "" {
+		span.SetName(operation)
+		c.metrics.usecaseOutcome(operation, outcomeOf(err))
+	}
+	span.SetAttributes(tracing.String("outcome", outcomeOf(err)))
+	if err == nil {
+		return
+	}
+
+	// Mistakes of the sender are expected; anything else is ours.
+	level := slog.LevelError
+	if isUserError(err) {
+		level = slog.LevelInfo
+	} else {
+		span.SetError(err)
+	}
+	logging.FromContext(ctx).Log(ctx, level, "failed to handle LINE event", "event_type", eventType, "error", err)
+	// Don't return error to LINE as it might retry the same webhook
+	c.explainFailure(ctx, event, err)
+}
+
+// handleEvent processes a single LINE event. It returns the operation the
+// event asked for, or "" if it was ignored.
+func (c *WebhookController) handleEvent(ctx context.Context, event apigen.LineEvent) (string, error) {
+	err := c.throttleLineEventUsecase.Execute(ctx, &usecase.ThrottleLineEventInput{
+		LineUserID: event.Source.UserId,
+	})
+	if err != nil {
+		return lineOpThrottle, fmt.Errorf("failed to admit event: %w", err)
+	}
+
+	if event.Type == apigen.Postback && event.Postback != nil {
+		return c.handlePostback(ctx, event)
+	}
+
+	// Following the account registers a LINE user; following again (or
+	// unblocking) is when they expect it to pick up their profile, such as
+	// the language they use LINE in
+	if event.Type == apigen.Follow {
+		_, err := c.syncLineProfileUsecase.Execute(ctx, &usecase.SyncLineProfileInput{
+			LineUserID: event.Source.UserId,
+		})
+		if err != nil {
+			return lineOpSyncProfile, fmt.Errorf("failed to sync LINE profile: %w", err)
+		}
+		return lineOpSyncProfile, nil
+	}
+
 	// Only process message events with text
-	if event.Type != "message" {
-		return nil
+	if event.Type != apigen.Message {
+		return "", nil
+	}
+
+	if event.Message == nil || event.Message.Type == nil || *event.Message.Type != apigen.Text || event.Message.Text == nil {
+		return "", nil
 	}
 
-	if event.Message == nil || event.Message.Type != "text" || event.Message.Text == nil {
-		return nil
+	text := strings.TrimSpace(*event.Message.Text)
+	actor := usecase.Actor{LineUserID: event.Source.UserId}
+
+	switch {
+	case strings.HasPrefix(text, joinCircleCommand):
+		_, err := c.joinCircleUsecase.Execute(ctx, &usecase.JoinCircleInput{
+			Member:   actor,
+			CircleID: strings.TrimPrefix(text, joinCircleCommand),
+		})
+		if err != nil {
+			return lineOpJoinCircle, fmt.Errorf("failed to join circle: %w", err)
+		}
+		return lineOpJoinCircle, nil
+	case strings.HasPrefix(text, leaveCircleCommand):
+		err := c.leaveCircleUsecase.Execute(ctx, &usecase.LeaveCircleInput{
+			Member:   actor,
+			CircleID: strings.TrimPrefix(text, leaveCircleCommand),
+		})
+		if err != nil {
+			return lineOpLeaveCircle, fmt.Errorf("failed to leave circle: %w", err)
+		}
+		return lineOpLeaveCircle, nil
 	}
 
 	// Execute the request interaction usecase
 	input := &usecase.RequestInteractionInput{
-		RequesterLineUserID: event.Source.UserID,
-		MessageText:         *event.Message.Text,
+		Requester:   actor,
+		MessageText: text,
+		ReplyToken:  stringValue(event.ReplyToken),
 	}
 
-	_, err := c.requestInteractionUsecase.Execute(ctx, input)
+	_, err = c.requestInteractionUsecase.Execute(ctx, input)
 	if err != nil {
-		return fmt.Errorf("failed to request interaction: %w", err)
+		return lineOpRequestInteraction, fmt.Errorf("failed to request interaction: %w", err)
 	}
 
-	return nil
+	return lineOpRequestInteraction, nil
+}
+
+// handlePostback processes the buttons of the interaction request and exchange Flex Messages.
+func (c *WebhookController) handlePostback(ctx context.Context, event apigen.LineEvent) (string, error) {
+	data, err := url.ParseQuery(stringValue(event.Postback.Data))
+	if err != nil {
+		return lineOpPostback, fmt.Errorf("invalid postback data: %w", err)
+	}
+	actor := usecase.Actor{LineUserID: event.Source.UserId}
+
+	switch data.Get("action") {
+	case usecase.PostbackActionApproveInteraction:
+		_, err := c.approveInteractionUsecase.Execute(ctx, &usecase.ApproveInteractionInput{
+			Approver:      actor,
+			InteractionID: data.Get("interactionId"),
+		})
+		if err != nil {
+			return lineOpApproveInteraction, fmt.Errorf("failed to approve interaction: %w", err)
+		}
+		return lineOpApproveInteraction, nil
+	case usecase.PostbackActionRejectInteraction:
+		_, err := c.rejectInteractionUsecase.Execute(ctx, &usecase.RejectInteractionInput{
+			Approver:      actor,
+			InteractionID: data.Get("interactionId"),
+		})
+		if err != nil {
+			return lineOpRejectInteraction, fmt.Errorf("failed to reject interaction: %w", err)
+		}
+		return lineOpRejectInteraction, nil
+	case usecase.PostbackActionCompleteExchange:
+		_, err := c.completeExchangeUsecase.Execute(ctx, &usecase.CompleteExchangeInput{
+			Recipient:  actor,
+			ExchangeID: data.Get("exchangeId"),
+			TraceID:    data.Get("traceId"),
+		})
+		if err != nil {
+			return lineOpCompleteExchange, fmt.Errorf("failed to complete exchange: %w", err)
+		}
+		return lineOpCompleteExchange, nil
+	case usecase.PostbackActionDeclineExchange:
+		_, err := c.declineExchangeUsecase.Execute(ctx, &usecase.DeclineExchangeInput{
+			Recipient:  actor,
+			ExchangeID: data.Get("exchangeId"),
+		})
+		if err != nil {
+			return lineOpDeclineExchange, fmt.Errorf("failed to decline exchange: %w", err)
+		}
+		return lineOpDeclineExchange, nil
+	case usecase.PostbackActionBlockUser:
+		_, err := c.blockUserUsecase.Execute(ctx, &usecase.BlockUserInput{
+			Blocker:   actor,
+			BlockedID: data.Get("userId"),
+		})
+		if err != nil {
+			return lineOpBlockUser, fmt.Errorf("failed to block user: %w", err)
+		}
+		return lineOpBlockUser, nil
+	}
+	return "", nil
+}
+
+// explainFailure tells the sender why their event was not acted on, when
+// the error is one they can do something about.
+func (c *WebhookController) explainFailure(ctx context.Context, event apigen.LineEvent, err error) {
+	_, err = c.explainFailureUsecase.Execute(ctx, &usecase.ExplainFailureInput{
+		LineUserID: event.Source.UserId,
+		ReplyToken: stringValue(event.ReplyToken),
+		Err:        err,
+	})
+	if err != nil {
+		logging.FromContext(ctx).WarnContext(ctx, "failed to explain failure", "error", err)
+	}
 }
 
 // sendSuccess sends a successful response.
 func (c *WebhookController) sendSuccess(w http.ResponseWriter) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(map[string]string{"status": "ok"}); err != nil {
-		fmt.Printf("failed to write response: %v\n", err)
-	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
 }
 
 // sendError sends an error response.
 func (c *WebhookController) sendError(w http.ResponseWriter, status int, message string) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	if err := json.NewEncoder(w).Encode(map[string]string{"error": message}); err != nil {
-		fmt.Printf("failed to write response: %v\n", err)
-	}
+	writeError(w, status, message)
+}
+
+// isUserError reports whether err is of a kind the sender caused, rather
+// than an internal failure.
+func isUserError(err error) bool {
+	return outcomeOf(err) != outcomeInternal
 }
@@ -0,0 +1,48 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dkpcb/pet/controller"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/metrics"
+)
+
+func TestWebhookRefusesUnverifiedRequests(t *testing.T) {
+	const body = `{"destination":"Ubot","events":[{"type":"follow","source":{"type":"user","userId":"U0001"}}]}`
+
+	tests := []struct {
+		name       string
+		secret     string
+		signature  string
+		wantStatus int
+	}{
+		{"no secret configured", "", domain.SignLineWebhook("", []byte(body)), http.StatusUnauthorized},
+		{"no secret configured, unsigned", "", "", http.StatusUnauthorized},
+		{"unsigned", "channel-secret", "", http.StatusBadRequest},
+		{"signed with another secret", "channel-secret", domain.SignLineWebhook("other-secret", []byte(body)), http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := metrics.NewRegistry()
+			m := controller.NewMetrics(registry)
+			webhook := controller.NewWebhookController(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, tt.secret, m)
+			router := controller.NewRouter(&controller.Controllers{WebhookController: webhook}, m)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhook/line", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.signature != "" {
+				req.Header.Set("X-Line-Signature", tt.signature)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// AnchorBatch is the set of approved interactions rolled up into one Merkle
+// root for an epoch. Epochs are numbered consecutively from 1, one per batch.
+type AnchorBatch struct {
+	Epoch int64
+	Root  []byte
+	// InteractionIDs are the leaves in tree order.
+	InteractionIDs []string
+	// TxRef identifies the chain record of the root; empty until anchored.
+	TxRef      string
+	CreatedAt  time.Time
+	AnchoredAt *time.Time
+}
+
+// NewAnchorBatch rolls interactionIDs up into a batch for epoch.
+// interactionIDs must not be empty.
+func NewAnchorBatch(epoch int64, interactionIDs []string, createdAt time.Time) *AnchorBatch {
+	return &AnchorBatch{
+		Epoch:          epoch,
+		Root:           NewMerkleTree(interactionIDs).Root(),
+		InteractionIDs: interactionIDs,
+		CreatedAt:      createdAt,
+	}
+}
+
+// MarkAnchored records that the root was published on-chain.
+func (b *AnchorBatch) MarkAnchored(txRef string, anchoredAt time.Time) {
+	b.TxRef = txRef
+	b.AnchoredAt = &anchoredAt
+}
+
+// IsAnchored returns true if the root has been published on-chain.
+func (b *AnchorBatch) IsAnchored() bool {
+	return b.AnchoredAt != nil
+}
+
+// Proof returns the inclusion proof of an interaction in the batch.
+// ok is false if the interaction is not part of the batch.
+func (b *AnchorBatch) Proof(interactionID string) (index int, proof []MerkleStep, ok bool) {
+	for i, id := range b.InteractionIDs {
+		if id == interactionID {
+			return i, NewMerkleTree(b.InteractionIDs).Proof(i), true
+		}
+	}
+	return 0, nil, false
+}
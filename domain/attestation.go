@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// Attestation is a portable, signed statement that two users met.
+// It is issued when an interaction is approved and can be verified offline
+// by anyone who knows the server's signing address.
+type Attestation struct {
+	InteractionID string
+	RequesterID   string
+	ApproverID    string
+	// Wallets are the participants' linked wallets at issue time, if any,
+	// so the proof can be tied to on-chain identities.
+	RequesterWallet *string
+	ApproverWallet  *string
+	MetAt           time.Time
+	IssuedAt        time.Time
+	Signer          string
+	Signature       string
+}
+
+// NewAttestation creates an unsigned attestation that requester and approver
+// met when the interaction was created.
+// Times are truncated to seconds because that is the precision that is signed.
+func NewAttestation(interaction *Interaction, requester, approver *User, issuedAt time.Time) *Attestation {
+	return &Attestation{
+		InteractionID:   interaction.ID,
+		RequesterID:     requester.ID,
+		ApproverID:      approver.ID,
+		RequesterWallet: requester.WalletAddress,
+		ApproverWallet:  approver.WalletAddress,
+		MetAt:           interaction.CreatedAt.UTC().Truncate(time.Second),
+		IssuedAt:        issuedAt.UTC().Truncate(time.Second),
+	}
+}
+
+// SetSignature records who signed the attestation and the signature itself.
+func (a *Attestation) SetSignature(signer, signature string) {
+	a.Signer = signer
+	a.Signature = signature
+}
+
+// IsSigned returns true if the attestation carries a signature.
+func (a *Attestation) IsSigned() bool {
+	return a.Signature != ""
+}
@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// Block records that one user no longer wants anything to do with another.
+// A block is directed when placed but enforced in both directions: neither
+// user can reach, request or see the other.
+type Block struct {
+	BlockerID string
+	BlockedID string
+	CreatedAt time.Time
+}
+
+// NewBlock creates a new Block placed by blockerID against blockedID.
+func NewBlock(blockerID, blockedID string, createdAt time.Time) *Block {
+	return &Block{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+		CreatedAt: createdAt,
+	}
+}
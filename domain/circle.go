@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// Circle is a small belief system centred on its founder. Only people close
+// to the founder (at most MaxHops away) can join, so a circle never grows
+// beyond the founder's neighbourhood.
+type Circle struct {
+	ID        string
+	FounderID string
+	Name      string
+	Manifesto string
+	CreatedAt time.Time
+}
+
+// NewCircle creates a new Circle founded by founderID.
+func NewCircle(id, founderID, name, manifesto string, createdAt time.Time) *Circle {
+	return &Circle{
+		ID:        id,
+		FounderID: founderID,
+		Name:      name,
+		Manifesto: manifesto,
+		CreatedAt: createdAt,
+	}
+}
+
+// IsFounder returns true if userID founded the circle.
+func (c *Circle) IsFounder(userID string) bool {
+	return c.FounderID == userID
+}
+
+// CircleMember records that a user belongs to a circle.
+type CircleMember struct {
+	CircleID string
+	UserID   string
+	JoinedAt time.Time
+}
+
+// NewCircleMember creates a new CircleMember.
+func NewCircleMember(circleID, userID string, joinedAt time.Time) *CircleMember {
+	return &CircleMember{
+		CircleID: circleID,
+		UserID:   userID,
+		JoinedAt: joinedAt,
+	}
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error kinds. Every error a caller is expected to handle matches exactly one
+// of them with errors.Is, so adapters can map kinds to responses instead of
+// knowing each individual error. Anything else is an internal failure.
+var (
+	// ErrNotFound means the thing asked for does not exist, or is hidden from the caller.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidInput means the request itself is wrong and retrying it unchanged will not help.
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrConflict means the request is valid but clashes with the current state.
+	ErrConflict = errors.New("conflict")
+	// ErrForbidden means the caller is known but not allowed to do this.
+	ErrForbidden = errors.New("forbidden")
+	// ErrRateLimited means the caller has to wait before trying again.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrUnauthenticated means the caller could not be identified.
+	ErrUnauthenticated = errors.New("unauthenticated")
+)
+
+// Error is an error of one of the kinds above.
+type Error struct {
+	Kind error
+	Err  error
+}
+
+// NewError creates an error of kind with the given text.
+// Use it to declare sentinel errors.
+func NewError(kind error, text string) error {
+	return &Error{Kind: kind, Err: errors.New(text)}
+}
+
+// Errorf creates an error of kind with a formatted message.
+// Like fmt.Errorf, it wraps the operand of a %w verb.
+func Errorf(kind error, format string, args ...interface{}) error {
+	return &Error{Kind: kind, Err: fmt.Errorf(format, args...)}
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the error's kind.
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
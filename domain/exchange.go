@@ -0,0 +1,62 @@
+package domain
+
+import "time"
+
+// ExchangeStatus represents the status of an exchange.
+type ExchangeStatus string
+
+const (
+	ExchangeStatusPending   ExchangeStatus = "pending"
+	ExchangeStatusCompleted ExchangeStatus = "completed"
+	ExchangeStatusDeclined  ExchangeStatus = "declined"
+)
+
+// Exchange is one user offering a trace to another, who may answer with one
+// of their own. A completed exchange strengthens the relationship between them.
+type Exchange struct {
+	ID             string
+	OffererID      string
+	RecipientID    string
+	OfferedTraceID string
+	// ResponseTraceID is the trace the recipient gave back, set once completed.
+	ResponseTraceID *string
+	Status          ExchangeStatus
+	CreatedAt       time.Time
+	// RespondedAt is when the recipient completed or declined the exchange.
+	RespondedAt *time.Time
+}
+
+// NewExchange creates a new pending Exchange.
+func NewExchange(id, offererID, recipientID, offeredTraceID string, createdAt time.Time) *Exchange {
+	return &Exchange{
+		ID:             id,
+		OffererID:      offererID,
+		RecipientID:    recipientID,
+		OfferedTraceID: offeredTraceID,
+		Status:         ExchangeStatusPending,
+		CreatedAt:      createdAt,
+	}
+}
+
+// Complete records the recipient's answering trace.
+func (e *Exchange) Complete(responseTraceID string, at time.Time) {
+	e.ResponseTraceID = &responseTraceID
+	e.Status = ExchangeStatusCompleted
+	e.RespondedAt = &at
+}
+
+// Decline marks the exchange as declined by the recipient.
+func (e *Exchange) Decline(at time.Time) {
+	e.Status = ExchangeStatusDeclined
+	e.RespondedAt = &at
+}
+
+// IsPending returns true if the recipient has not responded yet.
+func (e *Exchange) IsPending() bool {
+	return e.Status == ExchangeStatusPending
+}
+
+// IsRecipient returns true if userID is the user the trace was offered to.
+func (e *Exchange) IsRecipient(userID string) bool {
+	return e.RecipientID == userID
+}
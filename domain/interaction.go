@@ -9,8 +9,18 @@ const (
 	InteractionStatusPending  InteractionStatus = "pending"
 	InteractionStatusApproved InteractionStatus = "approved"
 	InteractionStatusRejected InteractionStatus = "rejected"
+	InteractionStatusExpired  InteractionStatus = "expired"
 )
 
+// IsValid reports whether s is one of the known interaction statuses.
+func (s InteractionStatus) IsValid() bool {
+	switch s {
+	case InteractionStatusPending, InteractionStatusApproved, InteractionStatusRejected, InteractionStatusExpired:
+		return true
+	}
+	return false
+}
+
 // Interaction represents an interaction between two users.
 // This is a pure domain model without any infrastructure concerns.
 type Interaction struct {
@@ -20,6 +30,8 @@ type Interaction struct {
 	Status      InteractionStatus
 	Metadata    map[string]interface{}
 	CreatedAt   time.Time
+	// Attestation is the signed proof of the meeting, set once approved.
+	Attestation *Attestation
 }
 
 // NewInteraction creates a new Interaction with required fields.
@@ -50,7 +62,44 @@ func (i *Interaction) Reject() {
 	i.Status = InteractionStatusRejected
 }
 
+// Expire marks the interaction as expired.
+func (i *Interaction) Expire() {
+	i.Status = InteractionStatusExpired
+}
+
+// Attest attaches the signed proof of the meeting to an approved interaction.
+func (i *Interaction) Attest(attestation *Attestation) {
+	i.Attestation = attestation
+}
+
+// IsApproved returns true if the interaction is in approved status.
+func (i *Interaction) IsApproved() bool {
+	return i.Status == InteractionStatusApproved
+}
+
 // IsPending returns true if the interaction is in pending status.
 func (i *Interaction) IsPending() bool {
 	return i.Status == InteractionStatusPending
 }
+
+// InteractionCursor marks where a page of interactions ended. Interactions
+// are listed newest first, with ID breaking ties between equal times.
+type InteractionCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// Cursor returns the position of the interaction in a listing.
+func (i *Interaction) Cursor() InteractionCursor {
+	return InteractionCursor{CreatedAt: i.CreatedAt, ID: i.ID}
+}
+
+// InteractionQuery narrows and pages a user's interactions.
+type InteractionQuery struct {
+	// Status, if set, keeps only interactions in that status.
+	Status *InteractionStatus
+	// After, if set, starts the page after that position.
+	After *InteractionCursor
+	// Limit caps the page size; 0 means no limit.
+	Limit int
+}
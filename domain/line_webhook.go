@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+)
+
+// LineWebhookRecord is a LINE webhook request as it was received, kept so
+// the same events can be replayed while debugging.
+type LineWebhookRecord struct {
+	ReceivedAt time.Time
+	// Signature is the X-Line-Signature LINE sent. It is empty when Body
+	// was redacted, since it would no longer match.
+	Signature string
+	// Body is the raw JSON request body.
+	Body []byte
+}
+
+// SignLineWebhook computes the X-Line-Signature of a webhook body: the
+// base64 HMAC-SHA256 of the body keyed with the channel secret.
+func SignLineWebhook(channelSecret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(channelSecret))
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyLineWebhookSignature reports whether signature is the
+// X-Line-Signature of body for the channel secret.
+func VerifyLineWebhookSignature(channelSecret string, body []byte, signature string) bool {
+	got, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	want, _ := base64.StdEncoding.DecodeString(SignLineWebhook(channelSecret, body))
+	return hmac.Equal(got, want)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "strings"
+
+// Locale is a language user-facing messages are written in.
+type Locale string
+
+// Supported locales.
+const (
+	LocaleJapanese Locale = "ja"
+	LocaleEnglish  Locale = "en"
+)
+
+// DefaultLocale is used for users whose language is unknown or unsupported.
+const DefaultLocale = LocaleJapanese
+
+// SupportedLocales lists every locale messages are available in.
+var SupportedLocales = []Locale{LocaleJapanese, LocaleEnglish}
+
+// ParseLocale picks the supported locale for a BCP 47 language tag such as
+// the "language" of a LINE profile ("ja", "en-US", "zh-Hant"). Only the
+// primary language matters; anything unsupported becomes DefaultLocale.
+func ParseLocale(tag string) Locale {
+	primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	for _, l := range SupportedLocales {
+		if Locale(primary) == l {
+			return l
+		}
+	}
+	return DefaultLocale
+}
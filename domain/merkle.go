@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+)
+
+// Merkle trees roll many interaction IDs up into one root that can be anchored
+// on-chain. Leaves and inner nodes are hashed with different prefixes
+// (as in RFC 6962) so an inner node can never be passed off as a leaf.
+// An unpaired node is promoted to the next level unchanged rather than
+// duplicated, which would let two different leaf sets share a root.
+
+const (
+	merkleLeafPrefix = 0x00
+	merkleNodePrefix = 0x01
+)
+
+// MerkleStep is one sibling on the path from a leaf to the root.
+type MerkleStep struct {
+	Hash []byte
+	// Left is true if the sibling is hashed on the left of the running hash.
+	Left bool
+}
+
+// MerkleTree is a binary hash tree over an ordered list of interaction IDs.
+type MerkleTree struct {
+	// levels[0] holds the leaf hashes and the last level holds the root.
+	levels [][][]byte
+}
+
+// NewMerkleTree builds a tree over ids in the given order.
+// ids must not be empty.
+func NewMerkleTree(ids []string) *MerkleTree {
+	level := make([][]byte, len(ids))
+	for i, id := range ids {
+		level[i] = MerkleLeafHash(id)
+	}
+
+	levels := [][][]byte{level}
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, merkleNodeHash(level[i], level[i+1]))
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return &MerkleTree{levels: levels}
+}
+
+// Root returns the root hash of the tree.
+func (t *MerkleTree) Root() []byte {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// Proof returns the sibling path proving the leaf at index is part of the tree.
+func (t *MerkleTree) Proof(index int) []MerkleStep {
+	var steps []MerkleStep
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			steps = append(steps, MerkleStep{Hash: level[sibling], Left: sibling < index})
+		}
+		index /= 2
+	}
+	return steps
+}
+
+// VerifyMerkleProof reports whether proof links the interaction ID to root.
+func VerifyMerkleProof(root []byte, id string, proof []MerkleStep) bool {
+	hash := MerkleLeafHash(id)
+	for _, step := range proof {
+		if step.Left {
+			hash = merkleNodeHash(step.Hash, hash)
+		} else {
+			hash = merkleNodeHash(hash, step.Hash)
+		}
+	}
+	return bytes.Equal(hash, root)
+}
+
+// MerkleLeafHash returns the leaf hash of an interaction ID.
+func MerkleLeafHash(id string) []byte {
+	h := sha256.New()
+	h.Write([]byte{merkleLeafPrefix})
+	h.Write([]byte(id))
+	return h.Sum(nil)
+}
+
+func merkleNodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{merkleNodePrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
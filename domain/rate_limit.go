@@ -0,0 +1,67 @@
+package domain
+
+import "time"
+
+// RateLimit describes a token bucket: it holds at most Burst tokens and
+// regains one every Refill. A zero Burst means no limit.
+type RateLimit struct {
+	Burst  int
+	Refill time.Duration
+}
+
+// IsUnlimited returns true if the limit never refuses anything.
+func (l RateLimit) IsUnlimited() bool {
+	return l.Burst <= 0
+}
+
+// TokenBucket is the state of one rate-limited subject.
+type TokenBucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// NewTokenBucket creates a full bucket for limit.
+func NewTokenBucket(limit RateLimit, now time.Time) *TokenBucket {
+	return &TokenBucket{Tokens: float64(limit.Burst), UpdatedAt: now}
+}
+
+// Take refills the bucket up to now and removes one token if there is one.
+// When the bucket is empty it returns false and how long until a token is
+// available; with no Refill that is never, reported as zero.
+func (b *TokenBucket) Take(limit RateLimit, now time.Time) (bool, time.Duration) {
+	if limit.IsUnlimited() {
+		return true, 0
+	}
+	if elapsed := now.Sub(b.UpdatedAt); elapsed > 0 && limit.Refill > 0 {
+		b.Tokens += float64(elapsed) / float64(limit.Refill)
+	}
+	if max := float64(limit.Burst); b.Tokens > max {
+		b.Tokens = max
+	}
+	if now.After(b.UpdatedAt) {
+		b.UpdatedAt = now
+	}
+
+	if b.Tokens >= 1 {
+		b.Tokens--
+		return true, 0
+	}
+	if limit.Refill <= 0 {
+		return false, 0
+	}
+	return false, time.Duration((1 - b.Tokens) * float64(limit.Refill))
+}
+
+// FullAt returns when the bucket will have refilled to limit.Burst if nothing
+// more is taken. From then on it is the same as a new bucket, so it can be
+// discarded. Reports false if the bucket never refills.
+func (b *TokenBucket) FullAt(limit RateLimit) (time.Time, bool) {
+	missing := float64(limit.Burst) - b.Tokens
+	if limit.IsUnlimited() || missing <= 0 {
+		return b.UpdatedAt, true
+	}
+	if limit.Refill <= 0 {
+		return time.Time{}, false
+	}
+	return b.UpdatedAt.Add(time.Duration(missing * float64(limit.Refill))), true
+}
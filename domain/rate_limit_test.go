@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+func TestTokenBucketTake(t *testing.T) {
+	limit := domain.RateLimit{Burst: 2, Refill: time.Minute}
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	bucket := domain.NewTokenBucket(limit, start)
+
+	steps := []struct {
+		name       string
+		at         time.Duration
+		allowed    bool
+		retryAfter time.Duration
+	}{
+		{"first of the burst", 0, true, 0},
+		{"second of the burst", 0, true, 0},
+		{"burst used up", 0, false, time.Minute},
+		{"part way to a token", 15 * time.Second, false, 45 * time.Second},
+		{"one token refilled", time.Minute, true, 0},
+		{"refill never exceeds the burst", time.Hour, true, 0},
+		{"second token after the long pause", time.Hour, true, 0},
+		{"empty again", time.Hour, false, time.Minute},
+		{"clock going backwards refills nothing", time.Hour - time.Second, false, time.Minute},
+	}
+	for _, step := range steps {
+		allowed, retryAfter := bucket.Take(limit, start.Add(step.at))
+		if allowed != step.allowed || retryAfter != step.retryAfter {
+			t.Errorf("%s: Take = %v, %v; want %v, %v", step.name, allowed, retryAfter, step.allowed, step.retryAfter)
+		}
+	}
+}
+
+func TestTokenBucketLimits(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	unlimited := domain.RateLimit{}
+	bucket := domain.NewTokenBucket(unlimited, now)
+	for i := 0; i < 100; i++ {
+		if allowed, _ := bucket.Take(unlimited, now); !allowed {
+			t.Fatalf("unlimited Take %d refused", i)
+		}
+	}
+
+	// Without a refill the burst is all there ever is.
+	once := domain.RateLimit{Burst: 1}
+	bucket = domain.NewTokenBucket(once, now)
+	if allowed, _ := bucket.Take(once, now); !allowed {
+		t.Fatal("first Take refused")
+	}
+	if allowed, retryAfter := bucket.Take(once, now.Add(24*time.Hour)); allowed || retryAfter != 0 {
+		t.Errorf("Take without refill = %v, %v; want false, 0", allowed, retryAfter)
+	}
+	if _, ok := bucket.FullAt(once); ok {
+		t.Error("bucket without refill reported to refill")
+	}
+}
+
+func TestTokenBucketFullAt(t *testing.T) {
+	limit := domain.RateLimit{Burst: 3, Refill: time.Minute}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	bucket := domain.NewTokenBucket(limit, now)
+
+	if fullAt, ok := bucket.FullAt(limit); !ok || !fullAt.Equal(now) {
+		t.Errorf("new bucket FullAt = %v, %v; want %v, true", fullAt, ok, now)
+	}
+	bucket.Take(limit, now)
+	bucket.Take(limit, now)
+	if fullAt, ok := bucket.FullAt(limit); !ok || !fullAt.Equal(now.Add(2*time.Minute)) {
+		t.Errorf("FullAt after two takes = %v, %v; want %v, true", fullAt, ok, now.Add(2*time.Minute))
+	}
+}
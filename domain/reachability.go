@@ -0,0 +1,27 @@
+package domain
+
+// MaxHops is the largest social distance at which users can see each other.
+// Connections should not scale infinitely.
+const MaxHops = 2
+
+// WithinMaxHops reports whether to is at most MaxHops away from from, given
+// the direct connections of both users. A user is always reachable from themselves.
+func WithinMaxHops(from, to string, fromConnections, toConnections []string) bool {
+	if from == to {
+		return true
+	}
+
+	direct := make(map[string]bool, len(fromConnections))
+	for _, id := range fromConnections {
+		if id == to {
+			return true
+		}
+		direct[id] = true
+	}
+	for _, id := range toConnections {
+		if direct[id] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// How much each kind of shared activity adds to a relationship's strength.
+const (
+	MeetingWeight    = 1.0
+	ExchangeWeight   = 1.0
+	MutualViewWeight = 0.1
+)
+
+// Relationship is the undirected edge between two users. UserAID is always
+// the lexically smaller ID, so each pair has exactly one Relationship.
+type Relationship struct {
+	UserAID string
+	UserBID string
+	// ExchangeCount is the number of completed exchanges between the pair.
+	ExchangeCount   int
+	LastExchangedAt *time.Time
+	// ViewCountA and ViewCountB count how often user A viewed B's traces and
+	// vice versa. A view is mutual while it does not outnumber the other side's.
+	ViewCountA int
+	ViewCountB int
+	// Strength grows with shared activity and halves every half-life without it.
+	Strength float64
+	// DecayedAt is the time Strength was last brought up to date.
+	DecayedAt time.Time
+	CreatedAt time.Time
+}
+
+// NewRelationship creates a new Relationship between two users, in either order.
+func NewRelationship(userID, otherID string, createdAt time.Time) *Relationship {
+	a, b := RelationshipPair(userID, otherID)
+	return &Relationship{
+		UserAID:   a,
+		UserBID:   b,
+		DecayedAt: createdAt,
+		CreatedAt: createdAt,
+	}
+}
+
+// RelationshipPair orders two user IDs the way Relationship stores them.
+func RelationshipPair(userID, otherID string) (string, string) {
+	if otherID < userID {
+		return otherID, userID
+	}
+	return userID, otherID
+}
+
+// Other returns the user on the other side of the relationship from userID.
+func (r *Relationship) Other(userID string) string {
+	if r.UserAID == userID {
+		return r.UserBID
+	}
+	return r.UserAID
+}
+
+// RecordMeeting strengthens the relationship for an approved meeting.
+func (r *Relationship) RecordMeeting() {
+	r.Strength += MeetingWeight
+}
+
+// RecordExchange strengthens the relationship for a completed exchange.
+func (r *Relationship) RecordExchange(at time.Time) {
+	r.ExchangeCount++
+	r.LastExchangedAt = &at
+	r.Strength += ExchangeWeight
+}
+
+// RecordView counts viewerID viewing the other user's trace. The relationship
+// only gets stronger when the view is reciprocated, so one-sided attention
+// cannot hold an edge open. Reports whether the view was mutual.
+func (r *Relationship) RecordView(viewerID string) bool {
+	own, other := &r.ViewCountA, r.ViewCountB
+	if viewerID == r.UserBID {
+		own, other = &r.ViewCountB, r.ViewCountA
+	}
+	*own++
+	if *own > other {
+		return false
+	}
+	r.Strength += MutualViewWeight
+	return true
+}
+
+// RelationshipPolicy decides how fast relationships fade and when they stop counting.
+type RelationshipPolicy struct {
+	// HalfLife is how long an inactive relationship takes to lose half its strength.
+	HalfLife time.Duration
+	// MinStrength is the strength below which a relationship no longer
+	// connects its users for reachability.
+	MinStrength float64
+	// MaxDegree is how many active relationships a user may have; 0 means no limit.
+	MaxDegree int
+}
+
+// Decay brings the relationship's strength up to date at now.
+func (p RelationshipPolicy) Decay(r *Relationship, now time.Time) {
+	elapsed := now.Sub(r.DecayedAt)
+	if elapsed <= 0 || p.HalfLife <= 0 {
+		return
+	}
+	r.Strength *= math.Pow(0.5, float64(elapsed)/float64(p.HalfLife))
+	r.DecayedAt = now
+}
+
+// RetractMeeting takes back what a meeting recorded at recordedAt still adds
+// to the relationship at now, such as when an approval is overridden.
+// Strength never drops below zero.
+func (p RelationshipPolicy) RetractMeeting(r *Relationship, recordedAt, now time.Time) {
+	p.Decay(r, now)
+	weight := MeetingWeight
+	if elapsed := now.Sub(recordedAt); elapsed > 0 && p.HalfLife > 0 {
+		weight *= math.Pow(0.5, float64(elapsed)/float64(p.HalfLife))
+	}
+	r.Strength = math.Max(0, r.Strength-weight)
+}
+
+// IsActive reports whether the relationship is strong enough to connect its users.
+func (p RelationshipPolicy) IsActive(r *Relationship) bool {
+	return r.Strength >= p.MinStrength
+}
+
+// HasRoom reports whether a user with degree active relationships may gain another.
+func (p RelationshipPolicy) HasRoom(degree int) bool {
+	return p.MaxDegree <= 0 || degree < p.MaxDegree
+}
@@ -0,0 +1,78 @@
+package domain
+
+import "time"
+
+// ReportReason is the category a reporter picks when filing a report.
+type ReportReason string
+
+const (
+	ReportReasonSpam                 ReportReason = "spam"
+	ReportReasonHarassment           ReportReason = "harassment"
+	ReportReasonImpersonation        ReportReason = "impersonation"
+	ReportReasonInappropriateContent ReportReason = "inappropriate_content"
+	ReportReasonOther                ReportReason = "other"
+)
+
+// IsValid returns true if r is one of the known reason categories.
+func (r ReportReason) IsValid() bool {
+	switch r {
+	case ReportReasonSpam, ReportReasonHarassment, ReportReasonImpersonation,
+		ReportReasonInappropriateContent, ReportReasonOther:
+		return true
+	}
+	return false
+}
+
+// ReportStatus represents where a report is in the moderation queue.
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "open"
+	ReportStatusResolved  ReportStatus = "resolved"
+	ReportStatusDismissed ReportStatus = "dismissed"
+)
+
+// Report is a user flagging another user to the moderators.
+type Report struct {
+	ID         string
+	ReporterID string
+	ReportedID string
+	Reason     ReportReason
+	Details    string
+	Status     ReportStatus
+	// ResolutionNote is what the moderator wrote when closing the report.
+	ResolutionNote *string
+	CreatedAt      time.Time
+	// ClosedAt is when a moderator resolved or dismissed the report.
+	ClosedAt *time.Time
+}
+
+// NewReport creates a new open Report.
+func NewReport(id, reporterID, reportedID string, reason ReportReason, details string, createdAt time.Time) *Report {
+	return &Report{
+		ID:         id,
+		ReporterID: reporterID,
+		ReportedID: reportedID,
+		Reason:     reason,
+		Details:    details,
+		Status:     ReportStatusOpen,
+		CreatedAt:  createdAt,
+	}
+}
+
+// Close takes the report out of the moderation queue with the given outcome.
+// An empty note is recorded as no note.
+func (r *Report) Close(status ReportStatus, note string, at time.Time) {
+	r.Status = status
+	if note != "" {
+		r.ResolutionNote = &note
+	} else {
+		r.ResolutionNote = nil
+	}
+	r.ClosedAt = &at
+}
+
+// IsOpen returns true if no moderator has acted on the report yet.
+func (r *Report) IsOpen() bool {
+	return r.Status == ReportStatusOpen
+}
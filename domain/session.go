@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// LineIdentity is what a verified LINE Login ID token says about its user.
+type LineIdentity struct {
+	// LineUserID is the token's subject, the same ID the Messaging API uses.
+	LineUserID string
+	// Nonce is the value the client passed to the authorization request, if any.
+	// Identities from access tokens have none.
+	Nonce string
+}
+
+// SessionClaims are what an access token issued by this service asserts.
+type SessionClaims struct {
+	UserID    string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// RefreshToken lets a client get a new access token without signing in again.
+// Only a hash of the token is stored; each token is used once and replaced.
+type RefreshToken struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	// RevokedAt is when the token was used up or revoked.
+	RevokedAt *time.Time
+}
+
+// NewRefreshToken creates a refresh token for the user valid until expiresAt.
+func NewRefreshToken(id, userID, tokenHash string, createdAt, expiresAt time.Time) *RefreshToken {
+	return &RefreshToken{
+		ID:        id,
+		UserID:    userID,
+		TokenHash: tokenHash,
+		CreatedAt: createdAt,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// IsRevoked returns true if the token was used up or revoked.
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsExpired returns true if the token can no longer be used at now.
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// Trace is an expression a user posts: an artwork or something they made.
+type Trace struct {
+	ID       string
+	AuthorID string
+	// CircleID scopes the trace to a circle's members. Nil means the trace
+	// follows the author's regular visibility.
+	CircleID  *string
+	Body      string
+	MediaURL  *string
+	CreatedAt time.Time
+}
+
+// NewTrace creates a new Trace.
+// circleID and mediaURL are optional and can be nil.
+func NewTrace(id, authorID string, circleID *string, body string, mediaURL *string, createdAt time.Time) *Trace {
+	return &Trace{
+		ID:        id,
+		AuthorID:  authorID,
+		CircleID:  circleID,
+		Body:      body,
+		MediaURL:  mediaURL,
+		CreatedAt: createdAt,
+	}
+}
+
+// IsCircleScoped returns true if only members of a circle may see the trace.
+func (t *Trace) IsCircleScoped() bool {
+	return t.CircleID != nil
+}
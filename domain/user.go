@@ -7,15 +7,35 @@ type User struct {
 	LineUserID    string
 	DisplayName   string
 	WalletAddress *string
+	// Locale is the language LINE messages are sent to the user in.
+	Locale Locale
+	// Bio and AvatarURL are the profile the user writes themselves.
+	// AvatarURL is nil until they set one.
+	Bio       string
+	AvatarURL *string
 }
 
 // NewUser creates a new User with required fields.
-// walletAddress is optional and can be nil.
+// walletAddress is optional and can be nil. The user starts in DefaultLocale.
 func NewUser(id, lineUserID, displayName string, walletAddress *string) *User {
 	return &User{
 		ID:            id,
 		LineUserID:    lineUserID,
 		DisplayName:   displayName,
 		WalletAddress: walletAddress,
+		Locale:        DefaultLocale,
 	}
 }
+
+// LinkWallet records walletAddress as the user's verified wallet.
+func (u *User) LinkWallet(walletAddress string) {
+	u.WalletAddress = &walletAddress
+}
+
+// LineProfile is what LINE shares about the account behind a LineUserID.
+type LineProfile struct {
+	DisplayName string
+	// Language is the BCP 47 tag of the language the user set in LINE.
+	// It is empty if the user has not consented to sharing it.
+	Language string
+}
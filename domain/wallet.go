@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// WalletChallenge is a one-time message a user must sign with their wallet
+// to prove they own it before the address is linked to their account.
+type WalletChallenge struct {
+	UserID        string
+	WalletAddress string
+	Nonce         string
+	Message       string
+	ExpiresAt     time.Time
+}
+
+// NewWalletChallenge creates a challenge for linking walletAddress to the user.
+// walletAddress must already be in EIP-55 checksum form because it is part of
+// the signed message.
+func NewWalletChallenge(userID, walletAddress, nonce string, issuedAt, expiresAt time.Time) *WalletChallenge {
+	return &WalletChallenge{
+		UserID:        userID,
+		WalletAddress: walletAddress,
+		Nonce:         nonce,
+		Message:       walletChallengeMessage(walletAddress, nonce, issuedAt),
+		ExpiresAt:     expiresAt,
+	}
+}
+
+// IsExpired returns true if the challenge can no longer be answered at now.
+func (c *WalletChallenge) IsExpired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
+
+// walletChallengeMessage is the human-readable text shown in the wallet when signing.
+func walletChallengeMessage(walletAddress, nonce string, issuedAt time.Time) string {
+	return fmt.Sprintf(
+		"TraceRiver wants you to link your wallet to your account.\n\nWallet: %s\nNonce: %s\nIssued At: %s",
+		walletAddress,
+		nonce,
+		issuedAt.UTC().Format(time.RFC3339),
+	)
+}
@@ -0,0 +1,119 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure/table"
+	"github.com/dkpcb/pet/repository"
+)
+
+// AnchorRepository is the GORM implementation of repository.AnchorRepository.
+type AnchorRepository struct {
+	db *gorm.DB
+}
+
+// NewAnchorRepository creates a new AnchorRepository.
+func NewAnchorRepository(db *gorm.DB) repository.AnchorRepository {
+	return &AnchorRepository{db: db}
+}
+
+// Save persists a new batch together with its leaves.
+func (r *AnchorRepository) Save(ctx context.Context, batch *domain.AnchorBatch) error {
+	row, leaves := table.FromDomainAnchorBatch(batch)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(row).Error; err != nil {
+			return err
+		}
+		return tx.Create(&leaves).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save anchor batch: %w", err)
+	}
+	return nil
+}
+
+// Update records the anchoring state of an existing batch.
+func (r *AnchorRepository) Update(ctx context.Context, batch *domain.AnchorBatch) error {
+	row, _ := table.FromDomainAnchorBatch(batch)
+	err := r.db.WithContext(ctx).Model(&table.AnchorBatch{}).Where("epoch = ?", row.Epoch).
+		Updates(map[string]interface{}{"tx_ref": row.TxRef, "anchored_at": row.AnchoredAt}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update anchor batch: %w", err)
+	}
+	return nil
+}
+
+// FindLatest retrieves the batch with the highest epoch.
+func (r *AnchorRepository) FindLatest(ctx context.Context) (*domain.AnchorBatch, error) {
+	var row table.AnchorBatch
+	if err := r.db.WithContext(ctx).Order("epoch DESC").First(&row).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find latest anchor batch: %w", err)
+	}
+	return r.withLeaves(ctx, &row)
+}
+
+// FindUnanchored retrieves batches whose root has not been published yet.
+func (r *AnchorRepository) FindUnanchored(ctx context.Context) ([]*domain.AnchorBatch, error) {
+	var rows []table.AnchorBatch
+	if err := r.db.WithContext(ctx).Where("anchored_at IS NULL").Order("epoch").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find unanchored batches: %w", err)
+	}
+
+	result := make([]*domain.AnchorBatch, len(rows))
+	for i := range rows {
+		batch, err := r.withLeaves(ctx, &rows[i])
+		if err != nil {
+			return nil, err
+		}
+		result[i] = batch
+	}
+	return result, nil
+}
+
+// FindByInteractionID retrieves the batch containing an interaction.
+func (r *AnchorRepository) FindByInteractionID(ctx context.Context, interactionID string) (*domain.AnchorBatch, error) {
+	var leaf table.AnchorLeaf
+	if err := r.db.WithContext(ctx).Where("interaction_id = ?", interactionID).First(&leaf).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find anchor leaf: %w", err)
+	}
+
+	var row table.AnchorBatch
+	if err := r.db.WithContext(ctx).Where("epoch = ?", leaf.Epoch).First(&row).Error; err != nil {
+		return nil, fmt.Errorf("failed to find anchor batch: %w", err)
+	}
+	return r.withLeaves(ctx, &row)
+}
+
+// FindPendingInteractionIDs retrieves approved interactions not in any batch yet.
+func (r *AnchorRepository) FindPendingInteractionIDs(ctx context.Context, limit int) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Model(&table.Interaction{}).
+		Where("status = ?", string(domain.InteractionStatusApproved)).
+		Where("id NOT IN (?)", r.db.Model(&table.AnchorLeaf{}).Select("interaction_id")).
+		Order("created_at, id").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find interactions to anchor: %w", err)
+	}
+	return ids, nil
+}
+
+// withLeaves loads the leaves of row and converts both to a domain model.
+func (r *AnchorRepository) withLeaves(ctx context.Context, row *table.AnchorBatch) (*domain.AnchorBatch, error) {
+	var leaves []table.AnchorLeaf
+	if err := r.db.WithContext(ctx).Where("epoch = ?", row.Epoch).Order("position").Find(&leaves).Error; err != nil {
+		return nil, fmt.Errorf("failed to find anchor leaves: %w", err)
+	}
+	return row.ToDomain(leaves), nil
+}
@@ -0,0 +1,142 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// The EIP-712 typed data an attestation is signed as. Changing any of these
+// invalidates every attestation issued so far, so bump the version instead.
+const (
+	attestationDomainName    = "TraceRiver"
+	attestationDomainVersion = "1"
+	eip712DomainType         = "EIP712Domain(string name,string version)"
+	meetingType              = "Meeting(string interactionId,string requesterId,string approverId," +
+		"address requesterWallet,address approverWallet,uint256 metAt,uint256 issuedAt)"
+)
+
+// AttestationSigner is the secp256k1 implementation of repository.AttestationSigner.
+type AttestationSigner struct {
+	key *secp256k1.PrivateKey
+}
+
+// NewAttestationSigner creates a new AttestationSigner signing with key.
+func NewAttestationSigner(key *secp256k1.PrivateKey) repository.AttestationSigner {
+	return &AttestationSigner{key: key}
+}
+
+// ParseSigningKey decodes a 0x-prefixed or bare hex secp256k1 private key.
+func ParseSigningKey(hexKey string) (*secp256k1.PrivateKey, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("signing key is not hex: %w", err)
+	}
+	if len(b) != 32 {
+		return nil, fmt.Errorf("signing key must be 32 bytes, got %d", len(b))
+	}
+	return secp256k1.PrivKeyFromBytes(b), nil
+}
+
+// Sign signs the attestation's EIP-712 digest.
+func (s *AttestationSigner) Sign(ctx context.Context, attestation *domain.Attestation) (string, string, error) {
+	digest, err := attestationDigest(attestation)
+	if err != nil {
+		return "", "", err
+	}
+	sig := signHash(s.key, digest)
+	return AddressOf(s.key), "0x" + hex.EncodeToString(sig), nil
+}
+
+// AttestationVerifier is the offline implementation of repository.AttestationVerifier.
+type AttestationVerifier struct{}
+
+// NewAttestationVerifier creates a new AttestationVerifier.
+func NewAttestationVerifier() repository.AttestationVerifier {
+	return &AttestationVerifier{}
+}
+
+// Verify checks that attestation.Signature was produced by attestation.Signer.
+func (v *AttestationVerifier) Verify(attestation *domain.Attestation) error {
+	return VerifyAttestation(attestation)
+}
+
+// VerifyAttestation checks that attestation.Signature was produced by
+// attestation.Signer. It needs no database or network access, so it can be
+// used by anyone holding an exported attestation.
+func VerifyAttestation(attestation *domain.Attestation) error {
+	if !attestation.IsSigned() {
+		return fmt.Errorf("attestation is not signed")
+	}
+	claimed, err := parseAddress(attestation.Signer)
+	if err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(attestation.Signature, "0x"))
+	if err != nil {
+		return fmt.Errorf("signature is not hex: %w", err)
+	}
+	digest, err := attestationDigest(attestation)
+	if err != nil {
+		return err
+	}
+	recovered, err := recoverAddress(digest, sig)
+	if err != nil {
+		return err
+	}
+	if checksumAddress(recovered) != checksumAddress(claimed) {
+		return fmt.Errorf("attestation was signed by %s, not %s", checksumAddress(recovered), checksumAddress(claimed))
+	}
+	return nil
+}
+
+// attestationDigest computes keccak256("\x19\x01" || domainSeparator || hashStruct(meeting)).
+func attestationDigest(a *domain.Attestation) ([]byte, error) {
+	requesterWallet, err := optionalAddress(a.RequesterWallet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid requester wallet: %w", err)
+	}
+	approverWallet, err := optionalAddress(a.ApproverWallet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid approver wallet: %w", err)
+	}
+
+	domainSeparator := keccak256(
+		keccak256([]byte(eip712DomainType)),
+		keccak256([]byte(attestationDomainName)),
+		keccak256([]byte(attestationDomainVersion)),
+	)
+	structHash := keccak256(
+		keccak256([]byte(meetingType)),
+		keccak256([]byte(a.InteractionID)),
+		keccak256([]byte(a.RequesterID)),
+		keccak256([]byte(a.ApproverID)),
+		leftPad32(requesterWallet),
+		leftPad32(approverWallet),
+		leftPad32(big.NewInt(a.MetAt.Unix()).Bytes()),
+		leftPad32(big.NewInt(a.IssuedAt.Unix()).Bytes()),
+	)
+	return keccak256([]byte{0x19, 0x01}, domainSeparator, structHash), nil
+}
+
+// optionalAddress decodes a wallet, using the zero address when none is linked.
+func optionalAddress(address *string) ([]byte, error) {
+	if address == nil {
+		return make([]byte, addressLength), nil
+	}
+	return parseAddress(*address)
+}
+
+// leftPad32 encodes b as a 32-byte ABI word.
+func leftPad32(b []byte) []byte {
+	word := make([]byte, 32)
+	copy(word[32-len(b):], b)
+	return word
+}
@@ -0,0 +1,100 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// testAttestationKey is the well-known example key from the web3.js
+// documentation, whose address is 0x2c7536E3605D9C16a7a3D7b1898e529396a65c23.
+const (
+	testAttestationKey     = "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	testAttestationAddress = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+)
+
+func testAttestation() *domain.Attestation {
+	wallet := testAttestationAddress
+	metAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	return &domain.Attestation{
+		InteractionID:   "44444444-4444-4444-8444-444444444444",
+		RequesterID:     "11111111-1111-4111-8111-111111111111",
+		ApproverID:      "22222222-2222-4222-8222-222222222222",
+		RequesterWallet: &wallet,
+		MetAt:           metAt,
+		IssuedAt:        metAt.Add(time.Hour),
+	}
+}
+
+func TestAttestationDigestKnownVector(t *testing.T) {
+	// The digest go-ethereum's signer/core/apitypes.TypedDataAndHash (and so
+	// eth_signTypedData_v4) computes for the same typed data.
+	const want = "e100bc9a7abd7f0eba030d5f3dd6632ee51507ff330186462e17cfbede7e0825"
+
+	digest, err := attestationDigest(testAttestation())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hex.EncodeToString(digest); got != want {
+		t.Errorf("digest = %s, want %s", got, want)
+	}
+}
+
+// signTestAttestation signs testAttestation with testAttestationKey.
+func signTestAttestation(t *testing.T) *domain.Attestation {
+	t.Helper()
+	key, err := ParseSigningKey(testAttestationKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attestation := testAttestation()
+	attestation.Signer, attestation.Signature, err = NewAttestationSigner(key).Sign(context.Background(), attestation)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	return attestation
+}
+
+func TestAttestationSignAndVerify(t *testing.T) {
+	attestation := signTestAttestation(t)
+	if attestation.Signer != testAttestationAddress {
+		t.Errorf("signer = %s, want %s", attestation.Signer, testAttestationAddress)
+	}
+	if err := NewAttestationVerifier().Verify(attestation); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+}
+
+func TestAttestationVerifyRejectsTampering(t *testing.T) {
+	otherWallet := "0x0000000000000000000000000000000000000001"
+	tests := []struct {
+		name   string
+		tamper func(*domain.Attestation)
+	}{
+		{"interaction", func(a *domain.Attestation) { a.InteractionID = "55555555-5555-4555-8555-555555555555" }},
+		{"requester", func(a *domain.Attestation) { a.RequesterID = "33333333-3333-4333-8333-333333333333" }},
+		{"approver", func(a *domain.Attestation) { a.ApproverID = "33333333-3333-4333-8333-333333333333" }},
+		{"requester wallet removed", func(a *domain.Attestation) { a.RequesterWallet = nil }},
+		{"approver wallet added", func(a *domain.Attestation) { a.ApproverWallet = &otherWallet }},
+		{"met at", func(a *domain.Attestation) { a.MetAt = a.MetAt.Add(time.Second) }},
+		{"issued at", func(a *domain.Attestation) { a.IssuedAt = a.IssuedAt.Add(time.Second) }},
+		{"signer", func(a *domain.Attestation) { a.Signer = otherWallet }},
+		{"signature", func(a *domain.Attestation) {
+			sig, _ := hex.DecodeString(a.Signature[2:])
+			sig[10] ^= 0xff
+			a.Signature = "0x" + hex.EncodeToString(sig)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attestation := signTestAttestation(t)
+			tt.tamper(attestation)
+			if err := VerifyAttestation(attestation); err == nil {
+				t.Error("tampered attestation verified")
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure/table"
+	"github.com/dkpcb/pet/repository"
+)
+
+// BlockRepository is the GORM implementation of repository.BlockRepository.
+type BlockRepository struct {
+	db *gorm.DB
+}
+
+// NewBlockRepository creates a new BlockRepository.
+func NewBlockRepository(db *gorm.DB) repository.BlockRepository {
+	return &BlockRepository{db: db}
+}
+
+// SaveAndDisconnect persists a block, keeping the original one if it already
+// exists, and cuts the two users off in the same transaction.
+func (r *BlockRepository) SaveAndDisconnect(ctx context.Context, block *domain.Block) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		row := table.FromDomainBlock(block)
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(row).Error; err != nil {
+			return fmt.Errorf("failed to save block: %w", err)
+		}
+
+		a, b := domain.RelationshipPair(block.BlockerID, block.BlockedID)
+		err := tx.Where("user_a_id = ? AND user_b_id = ?", a, b).Delete(&table.Relationship{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete relationship: %w", err)
+		}
+
+		now := time.Now()
+		err = tx.Model(&table.Interaction{}).
+			Where("status = ?", string(domain.InteractionStatusPending)).
+			Where("(requester_id = ? AND approver_id = ?) OR (requester_id = ? AND approver_id = ?)",
+				block.BlockerID, block.BlockedID, block.BlockedID, block.BlockerID).
+			Updates(map[string]interface{}{
+				"status":     string(domain.InteractionStatusExpired),
+				"updated_at": now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to expire interactions: %w", err)
+		}
+
+		err = tx.Model(&table.Exchange{}).
+			Where("status = ?", string(domain.ExchangeStatusPending)).
+			Where("(offerer_id = ? AND recipient_id = ?) OR (offerer_id = ? AND recipient_id = ?)",
+				block.BlockerID, block.BlockedID, block.BlockedID, block.BlockerID).
+			Updates(map[string]interface{}{
+				"status":       string(domain.ExchangeStatusDeclined),
+				"responded_at": now,
+				"updated_at":   now,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to decline exchanges: %w", err)
+		}
+		return nil
+	})
+}
+
+// Delete removes the block blockerID placed against blockedID.
+func (r *BlockRepository) Delete(ctx context.Context, blockerID, blockedID string) error {
+	err := r.db.WithContext(ctx).
+		Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Delete(&table.Block{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete block: %w", err)
+	}
+	return nil
+}
+
+// FindByBlockerID retrieves the blocks placed by blockerID, newest first.
+func (r *BlockRepository) FindByBlockerID(ctx context.Context, blockerID string) ([]*domain.Block, error) {
+	var rows []table.Block
+	err := r.db.WithContext(ctx).
+		Where("blocker_id = ?", blockerID).
+		Order("created_at DESC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blocks by blocker ID: %w", err)
+	}
+
+	result := make([]*domain.Block, len(rows))
+	for i, row := range rows {
+		result[i] = row.ToDomain()
+	}
+	return result, nil
+}
+
+// ExistsBetween returns true if either user has blocked the other.
+func (r *BlockRepository) ExistsBetween(ctx context.Context, userID, otherID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&table.Block{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userID, otherID, otherID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to check block: %w", err)
+	}
+	return count > 0, nil
+}
+
+// FindBlockedUserIDs retrieves the users userID has blocked or been blocked by.
+func (r *BlockRepository) FindBlockedUserIDs(ctx context.Context, userID string) ([]string, error) {
+	var rows []table.Block
+	err := r.db.WithContext(ctx).
+		Select("blocker_id", "blocked_id").
+		Where("blocker_id = ? OR blocked_id = ?", userID, userID).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blocked users: %w", err)
+	}
+
+	result := make([]string, len(rows))
+	for i, row := range rows {
+		result[i] = row.BlockedID
+		if row.BlockedID == userID {
+			result[i] = row.BlockerID
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,89 @@
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+)
+
+func TestBlockRepositorySaveAndDisconnect(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	saveUsers(t, db, requesterID, approverID, thirdUserID)
+	interactions := infrastructure.NewInteractionRepository(db)
+	exchanges := infrastructure.NewExchangeRepository(db)
+	relationships := infrastructure.NewRelationshipRepository(db)
+	createdAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+	if _, err := relationships.Connect(ctx, requesterID, approverID, domain.RelationshipPolicy{MinStrength: 0.5}, meeting); err != nil {
+		t.Fatal(err)
+	}
+	saveInteraction(t, interactions, interactionID(1), domain.InteractionStatusPending, nil, createdAt)
+	saveInteraction(t, interactions, interactionID(2), domain.InteractionStatusApproved, nil, createdAt)
+	// Pending interactions the blocked user did not start, or with someone
+	// else.
+	for id, pair := range map[string][2]string{
+		interactionID(3): {approverID, requesterID},
+		interactionID(4): {approverID, thirdUserID},
+	} {
+		if err := interactions.Save(ctx, domain.NewInteraction(id, pair[0], pair[1], domain.InteractionStatusPending, nil, createdAt)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := infrastructure.NewTraceRepository(db).Save(ctx, domain.NewTrace(offeredTraceID, requesterID, nil, "hello", nil, createdAt)); err != nil {
+		t.Fatal(err)
+	}
+	for id, recipientID := range map[string]string{
+		interactionID(5): approverID,
+		interactionID(6): thirdUserID,
+	} {
+		if err := exchanges.Save(ctx, domain.NewExchange(id, requesterID, recipientID, offeredTraceID, createdAt)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Blocking twice must not fail.
+	for range 2 {
+		if err := infrastructure.NewBlockRepository(db).SaveAndDisconnect(ctx, domain.NewBlock(approverID, requesterID, time.Now())); err != nil {
+			t.Fatalf("SaveAndDisconnect: %v", err)
+		}
+	}
+
+	rel, err := relationships.FindBetween(ctx, requesterID, approverID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel != nil {
+		t.Errorf("relationship %+v kept after the block", rel)
+	}
+	for id, want := range map[string]domain.InteractionStatus{
+		interactionID(1): domain.InteractionStatusExpired,
+		interactionID(2): domain.InteractionStatusApproved,
+		interactionID(3): domain.InteractionStatusExpired,
+		interactionID(4): domain.InteractionStatusPending,
+	} {
+		i, err := interactions.FindByID(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i.Status != want {
+			t.Errorf("interaction %s status = %s, want %s", id, i.Status, want)
+		}
+	}
+	for id, want := range map[string]domain.ExchangeStatus{
+		interactionID(5): domain.ExchangeStatusDeclined,
+		interactionID(6): domain.ExchangeStatusPending,
+	} {
+		e, err := exchanges.FindByID(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.Status != want {
+			t.Errorf("exchange %s status = %s, want %s", id, e.Status, want)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure/table"
+	"github.com/dkpcb/pet/repository"
+)
+
+// CircleRepository is the GORM implementation of repository.CircleRepository.
+type CircleRepository struct {
+	db *gorm.DB
+}
+
+// NewCircleRepository creates a new CircleRepository.
+func NewCircleRepository(db *gorm.DB) repository.CircleRepository {
+	return &CircleRepository{db: db}
+}
+
+// Save persists a new circle.
+func (r *CircleRepository) Save(ctx context.Context, circle *domain.Circle) error {
+	row := table.FromDomainCircle(circle)
+	if err := r.db.WithContext(ctx).Create(row).Error; err != nil {
+		return fmt.Errorf("failed to save circle: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a circle by its ID.
+func (r *CircleRepository) FindByID(ctx context.Context, id string) (*domain.Circle, error) {
+	var row table.Circle
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&row).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find circle by ID: %w", err)
+	}
+	return row.ToDomain(), nil
+}
+
+// FindByMemberID retrieves all circles a user is a member of.
+func (r *CircleRepository) FindByMemberID(ctx context.Context, userID string) ([]*domain.Circle, error) {
+	var rows []table.Circle
+	err := r.db.WithContext(ctx).
+		Joins("JOIN circle_members ON circle_members.circle_id = circles.id").
+		Where("circle_members.user_id = ?", userID).
+		Order("circles.created_at").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find circles by member ID: %w", err)
+	}
+
+	result := make([]*domain.Circle, len(rows))
+	for i, row := range rows {
+		result[i] = row.ToDomain()
+	}
+	return result, nil
+}
+
+// AddMember persists a new membership.
+func (r *CircleRepository) AddMember(ctx context.Context, member *domain.CircleMember) error {
+	row := table.FromDomainCircleMember(member)
+	if err := r.db.WithContext(ctx).Create(row).Error; err != nil {
+		return fmt.Errorf("failed to add circle member: %w", err)
+	}
+	return nil
+}
+
+// RemoveMember deletes a membership.
+func (r *CircleRepository) RemoveMember(ctx context.Context, circleID, userID string) error {
+	err := r.db.WithContext(ctx).
+		Where("circle_id = ? AND user_id = ?", circleID, userID).
+		Delete(&table.CircleMember{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to remove circle member: %w", err)
+	}
+	return nil
+}
+
+// FindMember retrieves a user's membership of a circle.
+func (r *CircleRepository) FindMember(ctx context.Context, circleID, userID string) (*domain.CircleMember, error) {
+	var row table.CircleMember
+	err := r.db.WithContext(ctx).Where("circle_id = ? AND user_id = ?", circleID, userID).First(&row).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find circle member: %w", err)
+	}
+	return row.ToDomain(), nil
+}
+
+// FindMembers retrieves all members of a circle, in joining order.
+func (r *CircleRepository) FindMembers(ctx context.Context, circleID string) ([]*domain.CircleMember, error) {
+	var rows []table.CircleMember
+	if err := r.db.WithContext(ctx).Where("circle_id = ?", circleID).Order("joined_at").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find circle members: %w", err)
+	}
+
+	result := make([]*domain.CircleMember, len(rows))
+	for i, row := range rows {
+		result[i] = row.ToDomain()
+	}
+	return result, nil
+}
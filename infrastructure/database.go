@@ -0,0 +1,66 @@
+package infrastructure
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Dialect identifies the SQL backend the repositories run against.
+type Dialect string
+
+const (
+	DialectMySQL    Dialect = "mysql"
+	DialectPostgres Dialect = "postgres"
+	DialectSQLite   Dialect = "sqlite"
+)
+
+// OpenDatabase opens a GORM connection for the given dialect.
+// For SQLite the dsn is a file path or ":memory:"; the pure-Go driver is used
+// so the binary stays cgo-free for local development and tests.
+// Failed and slow queries are logged to logger. Driver errors are translated,
+// so a unique index violation is gorm.ErrDuplicatedKey on every dialect.
+func OpenDatabase(dialect Dialect, dsn string, logger *slog.Logger) (*gorm.DB, error) {
+	cfg := &gorm.Config{Logger: newGormLogger(logger), TranslateError: true}
+	switch dialect {
+	case DialectMySQL:
+		db, err := gorm.Open(mysql.Open(dsn), cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open mysql database: %w", err)
+		}
+		return db, nil
+	case DialectPostgres:
+		db, err := gorm.Open(postgres.Open(dsn), cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open postgres database: %w", err)
+		}
+		return db, nil
+	case DialectSQLite:
+		db, err := gorm.Open(sqlite.Open(dsn), cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open sqlite database: %w", err)
+		}
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, fmt.Errorf("failed to access sqlite connection: %w", err)
+		}
+		// SQLite serializes writers anyway, and an in-memory database only
+		// exists on the connection that created it.
+		sqlDB.SetMaxOpenConns(1)
+		if err := db.Exec("PRAGMA foreign_keys = ON").Error; err != nil {
+			return nil, fmt.Errorf("failed to enable sqlite foreign keys: %w", err)
+		}
+		return db, nil
+	default:
+		return nil, fmt.Errorf("unsupported database dialect: %q", dialect)
+	}
+}
+
+// DialectOf reports the dialect of an open connection.
+func DialectOf(db *gorm.DB) Dialect {
+	return Dialect(db.Dialector.Name())
+}
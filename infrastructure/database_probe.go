@@ -0,0 +1,48 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/dkpcb/pet/repository"
+)
+
+// DatabaseProbe is the GORM implementation of repository.DatabaseProbe.
+type DatabaseProbe struct {
+	db       *gorm.DB
+	migrator *Migrator
+}
+
+// NewDatabaseProbe creates a new DatabaseProbe expecting the migrations
+// embedded for the dialect of db.
+func NewDatabaseProbe(db *gorm.DB) (repository.DatabaseProbe, error) {
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		return nil, err
+	}
+	return &DatabaseProbe{db: db, migrator: migrator}, nil
+}
+
+// Ping checks that the database answers.
+func (p *DatabaseProbe) Ping(ctx context.Context) error {
+	sqlDB, err := p.db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database handle: %w", err)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+	return nil
+}
+
+// SchemaVersion returns the applied schema version and the newest one
+// shipped with the binary.
+func (p *DatabaseProbe) SchemaVersion(ctx context.Context) (int, int, error) {
+	current, err := p.migrator.Version(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return current, p.migrator.LatestVersion(), nil
+}
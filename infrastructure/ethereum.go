@@ -0,0 +1,123 @@
+package infrastructure
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+)
+
+// Ethereum-compatible primitives shared by the wallet verifier and any code
+// that signs on behalf of the server. They depend only on secp256k1 and
+// Keccak-256 so nothing here needs a chain SDK.
+
+const (
+	addressLength   = 20
+	signatureLength = 65
+)
+
+// keccak256 hashes data with the legacy Keccak-256 used by Ethereum (not SHA3-256).
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// personalMessageHash returns the EIP-191 version 0x45 ("personal_sign") digest of message.
+func personalMessageHash(message string) []byte {
+	prefix := fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(message))
+	return keccak256([]byte(prefix), []byte(message))
+}
+
+// addressFromPublicKey derives the 20-byte account address of a public key.
+func addressFromPublicKey(pub *secp256k1.PublicKey) []byte {
+	// Drop the 0x04 uncompressed-point prefix before hashing.
+	return keccak256(pub.SerializeUncompressed()[1:])[12:]
+}
+
+// checksumAddress encodes a 20-byte address in EIP-55 mixed-case form.
+func checksumAddress(addr []byte) string {
+	lower := hex.EncodeToString(addr)
+	hash := keccak256([]byte(lower))
+
+	var b strings.Builder
+	b.WriteString("0x")
+	for i, c := range lower {
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if c >= 'a' && nibble&0x0f >= 8 {
+			c -= 'a' - 'A'
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// parseAddress decodes a 0x-prefixed hex address. All-lowercase and
+// all-uppercase input is accepted as is; mixed case must carry a valid EIP-55 checksum.
+func parseAddress(address string) ([]byte, error) {
+	digits, ok := strings.CutPrefix(address, "0x")
+	if !ok || len(digits) != addressLength*2 {
+		return nil, fmt.Errorf("wallet address must be 0x followed by %d hex digits", addressLength*2)
+	}
+	addr, err := hex.DecodeString(digits)
+	if err != nil {
+		return nil, fmt.Errorf("wallet address is not hex: %w", err)
+	}
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) && checksumAddress(addr) != address {
+		return nil, fmt.Errorf("wallet address has an invalid EIP-55 checksum")
+	}
+	return addr, nil
+}
+
+// SignPersonalMessage signs message with key using EIP-191 personal_sign and
+// returns the 65-byte r||s||v signature with v in {27, 28}, as wallets do.
+func SignPersonalMessage(key *secp256k1.PrivateKey, message string) []byte {
+	return signHash(key, personalMessageHash(message))
+}
+
+// signHash signs a 32-byte digest and returns it in Ethereum r||s||v layout.
+func signHash(key *secp256k1.PrivateKey, hash []byte) []byte {
+	// SignCompact returns v||r||s with v = 27 + recovery id for uncompressed keys.
+	compact := ecdsa.SignCompact(key, hash, false)
+	sig := make([]byte, signatureLength)
+	copy(sig, compact[1:])
+	sig[64] = compact[0]
+	return sig
+}
+
+// recoverAddress returns the address that produced an r||s||v signature over hash.
+func recoverAddress(hash, sig []byte) ([]byte, error) {
+	if len(sig) != signatureLength {
+		return nil, fmt.Errorf("signature must be %d bytes, got %d", signatureLength, len(sig))
+	}
+	v := sig[64]
+	if v < 27 {
+		// Some signers (e.g. hardware wallets) return the raw recovery id.
+		v += 27
+	}
+	if v != 27 && v != 28 {
+		return nil, fmt.Errorf("invalid signature recovery id: %d", sig[64])
+	}
+
+	compact := make([]byte, signatureLength)
+	compact[0] = v
+	copy(compact[1:], sig[:64])
+	pub, _, err := ecdsa.RecoverCompact(compact, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover public key: %w", err)
+	}
+	return addressFromPublicKey(pub), nil
+}
+
+// AddressOf returns the EIP-55 checksummed address controlled by key.
+func AddressOf(key *secp256k1.PrivateKey) string {
+	return checksumAddress(addressFromPublicKey(key.PubKey()))
+}
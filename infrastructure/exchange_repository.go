@@ -0,0 +1,98 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure/table"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ExchangeRepository is the GORM implementation of repository.ExchangeRepository.
+type ExchangeRepository struct {
+	db *gorm.DB
+}
+
+// NewExchangeRepository creates a new ExchangeRepository.
+func NewExchangeRepository(db *gorm.DB) repository.ExchangeRepository {
+	return &ExchangeRepository{db: db}
+}
+
+// Save persists a new exchange.
+func (r *ExchangeRepository) Save(ctx context.Context, exchange *domain.Exchange) error {
+	row := table.FromDomainExchange(exchange)
+	if err := r.db.WithContext(ctx).Create(row).Error; err != nil {
+		return fmt.Errorf("failed to save exchange: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves an exchange by its ID.
+func (r *ExchangeRepository) FindByID(ctx context.Context, id string) (*domain.Exchange, error) {
+	var row table.Exchange
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&row).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find exchange by ID: %w", err)
+	}
+	return row.ToDomain(), nil
+}
+
+// Respond persists the recipient's response if the exchange is still pending.
+func (r *ExchangeRepository) Respond(ctx context.Context, exchange *domain.Exchange) (bool, error) {
+	return respondExchange(r.db.WithContext(ctx), exchange)
+}
+
+// RespondAndConnect persists the recipient's response and connects the two
+// users in one transaction.
+func (r *ExchangeRepository) RespondAndConnect(ctx context.Context, exchange *domain.Exchange, policy domain.RelationshipPolicy, fn func(*domain.Relationship)) (string, bool, error) {
+	full := ""
+	ok := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Update the exchange first: the row stays locked until commit, so a
+		// concurrent response waits and then finds it answered.
+		var err error
+		ok, err = respondExchange(tx, exchange)
+		if err != nil || !ok {
+			return err
+		}
+		// Connect in a savepoint, so a pair without room rolls back only
+		// the relationship row connect inserted and keeps the response.
+		err = tx.Transaction(func(tx *gorm.DB) error {
+			return connect(tx, exchange.OffererID, exchange.RecipientID, policy, fn)
+		})
+		var noRoom errNoRoom
+		if errors.As(err, &noRoom) {
+			full = noRoom.userID
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return "", false, err
+	}
+	return full, ok, nil
+}
+
+// respondExchange saves the response if the exchange is still pending.
+func respondExchange(db *gorm.DB, exchange *domain.Exchange) (bool, error) {
+	result := db.
+		Model(&table.Exchange{}).
+		Where("id = ? AND status = ?", exchange.ID, string(domain.ExchangeStatusPending)).
+		Updates(map[string]interface{}{
+			"status":            string(exchange.Status),
+			"response_trace_id": exchange.ResponseTraceID,
+			"responded_at":      exchange.RespondedAt,
+			"updated_at":        time.Now(),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to respond to exchange: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
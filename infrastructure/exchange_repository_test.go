@@ -0,0 +1,121 @@
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+)
+
+const (
+	exchangeID      = "66666666-6666-4666-8666-666666666666"
+	offeredTraceID  = "55555555-5555-4555-8555-555555555555"
+	responseTraceID = "77777777-7777-4777-8777-777777777777"
+)
+
+// savePendingExchange stores a pending exchange from requesterID to
+// approverID and returns a copy completed as each concurrent responder would.
+func savePendingExchange(t *testing.T, repo interface {
+	Save(context.Context, *domain.Exchange) error
+}, traces interface {
+	Save(context.Context, *domain.Trace) error
+}) func() *domain.Exchange {
+	t.Helper()
+	ctx := context.Background()
+	createdAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	for id, authorID := range map[string]string{offeredTraceID: requesterID, responseTraceID: approverID} {
+		if err := traces.Save(ctx, domain.NewTrace(id, authorID, nil, "hello", nil, createdAt)); err != nil {
+			t.Fatalf("save trace: %v", err)
+		}
+	}
+	if err := repo.Save(ctx, domain.NewExchange(exchangeID, requesterID, approverID, offeredTraceID, createdAt)); err != nil {
+		t.Fatalf("save exchange: %v", err)
+	}
+	return func() *domain.Exchange {
+		e := domain.NewExchange(exchangeID, requesterID, approverID, offeredTraceID, createdAt)
+		e.Complete(responseTraceID, time.Now())
+		return e
+	}
+}
+
+func exchanged(rel *domain.Relationship) {
+	rel.RecordExchange(time.Now())
+}
+
+func TestExchangeRepositoryRespondAndConnect(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	saveUsers(t, db, requesterID, approverID)
+	repo := infrastructure.NewExchangeRepository(db)
+	relationships := infrastructure.NewRelationshipRepository(db)
+	policy := domain.RelationshipPolicy{MinStrength: 0.5}
+	complete := savePendingExchange(t, repo, infrastructure.NewTraceRepository(db))
+
+	full, ok, err := repo.RespondAndConnect(ctx, complete(), policy, exchanged)
+	if err != nil || full != "" || !ok {
+		t.Fatalf("RespondAndConnect = %q, %v, %v; want \"\", true, nil", full, ok, err)
+	}
+	rel, err := relationships.FindBetween(ctx, requesterID, approverID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel == nil {
+		t.Fatal("no relationship after the exchange")
+	}
+	strength := rel.Strength
+
+	// A second completion of the same exchange must not count it again.
+	full, ok, err = repo.RespondAndConnect(ctx, complete(), policy, exchanged)
+	if err != nil || full != "" || ok {
+		t.Fatalf("repeated RespondAndConnect = %q, %v, %v; want \"\", false, nil", full, ok, err)
+	}
+	rel, err = relationships.FindBetween(ctx, requesterID, approverID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel.Strength != strength {
+		t.Errorf("strength = %v after a repeated completion, want %v", rel.Strength, strength)
+	}
+}
+
+func TestExchangeRepositoryRespondAndConnectWithoutRoom(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	saveUsers(t, db, requesterID, approverID, thirdUserID)
+	repo := infrastructure.NewExchangeRepository(db)
+	relationships := infrastructure.NewRelationshipRepository(db)
+	policy := domain.RelationshipPolicy{MinStrength: 0.5, MaxDegree: 1}
+	complete := savePendingExchange(t, repo, infrastructure.NewTraceRepository(db))
+
+	// The recipient's only slot is taken.
+	if full, err := relationships.Connect(ctx, approverID, thirdUserID, policy, meeting); err != nil || full != "" {
+		t.Fatalf("Connect = %q, %v", full, err)
+	}
+
+	full, ok, err := repo.RespondAndConnect(ctx, complete(), policy, exchanged)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if full != approverID || !ok {
+		t.Fatalf("RespondAndConnect = %q, %v; want %q, true", full, ok, approverID)
+	}
+
+	// The traces were given, so the exchange is completed, but the users
+	// are not connected.
+	stored, err := repo.FindByID(ctx, exchangeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != domain.ExchangeStatusCompleted {
+		t.Errorf("status = %s, want completed", stored.Status)
+	}
+	rel, err := relationships.FindBetween(ctx, requesterID, approverID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel != nil {
+		t.Errorf("relationship %+v saved without room", rel)
+	}
+}
@@ -0,0 +1,93 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/dkpcb/pet/repository"
+)
+
+// FakeChainEntry is one record in a FakeChain.
+type FakeChainEntry struct {
+	Height int
+	Epoch  int64
+	Root   []byte
+	TxRef  string
+}
+
+// FakeChain is an in-process, append-only stand-in for a blockchain that
+// implements repository.AnchorService. Each entry's reference hashes the
+// previous one, so the log behaves like a chain of blocks. It is meant for
+// tests only: state is lost when the process exits, after which no stored
+// batch could be proven against it.
+type FakeChain struct {
+	mu      sync.Mutex
+	entries []FakeChainEntry
+}
+
+// NewFakeChain creates an empty FakeChain.
+func NewFakeChain() *FakeChain {
+	return &FakeChain{}
+}
+
+var _ repository.AnchorService = (*FakeChain)(nil)
+
+// Anchor appends root for epoch to the log.
+func (c *FakeChain) Anchor(ctx context.Context, epoch int64, root []byte) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, e := range c.entries {
+		if e.Epoch != epoch {
+			continue
+		}
+		if !bytes.Equal(e.Root, root) {
+			return "", fmt.Errorf("epoch %d is already anchored with a different root", epoch)
+		}
+		return e.TxRef, nil
+	}
+
+	var prev []byte
+	if n := len(c.entries); n > 0 {
+		prev, _ = hex.DecodeString(c.entries[n-1].TxRef[2:])
+	}
+	h := sha256.New()
+	h.Write(prev)
+	binary.Write(h, binary.BigEndian, epoch)
+	h.Write(root)
+
+	entry := FakeChainEntry{
+		Height: len(c.entries),
+		Epoch:  epoch,
+		Root:   bytes.Clone(root),
+		TxRef:  "0x" + hex.EncodeToString(h.Sum(nil)),
+	}
+	c.entries = append(c.entries, entry)
+	return entry.TxRef, nil
+}
+
+// FindRoot retrieves the root anchored for epoch.
+func (c *FakeChain) FindRoot(ctx context.Context, epoch int64) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, e := range c.entries {
+		if e.Epoch == epoch {
+			return bytes.Clone(e.Root), nil
+		}
+	}
+	return nil, nil
+}
+
+// Entries returns a copy of the log, oldest first.
+func (c *FakeChain) Entries() []FakeChainEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]FakeChainEntry(nil), c.entries...)
+}
@@ -0,0 +1,551 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// The decorators below wrap a repository so an Observer sees every call,
+// labelled "<Interface>.<Method>". They add nothing else, so instrumented and
+// plain repositories are interchangeable.
+
+// instrumentedUserRepository reports every call to a repository.UserRepository.
+type instrumentedUserRepository struct {
+	next    repository.UserRepository
+	observe Observer
+}
+
+// NewInstrumentedUserRepository wraps next so observe sees every call.
+func NewInstrumentedUserRepository(next repository.UserRepository, observe Observer) repository.UserRepository {
+	return &instrumentedUserRepository{next: next, observe: observe}
+}
+
+func (r *instrumentedUserRepository) Save(ctx context.Context, user *domain.User) error {
+	ctx, done := r.observe(ctx, "UserRepository.Save")
+	err := r.next.Save(ctx, user)
+	done(err)
+	return err
+}
+
+func (r *instrumentedUserRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	ctx, done := r.observe(ctx, "UserRepository.FindByID")
+	result, err := r.next.FindByID(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedUserRepository) FindByLineUserID(ctx context.Context, lineUserID string) (*domain.User, error) {
+	ctx, done := r.observe(ctx, "UserRepository.FindByLineUserID")
+	result, err := r.next.FindByLineUserID(ctx, lineUserID)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedUserRepository) FindByWalletAddress(ctx context.Context, walletAddress string) (*domain.User, error) {
+	ctx, done := r.observe(ctx, "UserRepository.FindByWalletAddress")
+	result, err := r.next.FindByWalletAddress(ctx, walletAddress)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedUserRepository) List(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	ctx, done := r.observe(ctx, "UserRepository.List")
+	result, err := r.next.List(ctx, limit, offset)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedUserRepository) Update(ctx context.Context, user *domain.User) error {
+	ctx, done := r.observe(ctx, "UserRepository.Update")
+	err := r.next.Update(ctx, user)
+	done(err)
+	return err
+}
+
+// instrumentedInteractionRepository reports every call to a repository.InteractionRepository.
+type instrumentedInteractionRepository struct {
+	next    repository.InteractionRepository
+	observe Observer
+}
+
+// NewInstrumentedInteractionRepository wraps next so observe sees every call.
+func NewInstrumentedInteractionRepository(next repository.InteractionRepository, observe Observer) repository.InteractionRepository {
+	return &instrumentedInteractionRepository{next: next, observe: observe}
+}
+
+func (r *instrumentedInteractionRepository) Save(ctx context.Context, interaction *domain.Interaction) error {
+	ctx, done := r.observe(ctx, "InteractionRepository.Save")
+	err := r.next.Save(ctx, interaction)
+	done(err)
+	return err
+}
+
+func (r *instrumentedInteractionRepository) FindByID(ctx context.Context, id string) (*domain.Interaction, error) {
+	ctx, done := r.observe(ctx, "InteractionRepository.FindByID")
+	result, err := r.next.FindByID(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedInteractionRepository) FindByRequesterID(ctx context.Context, requesterID string, query domain.InteractionQuery) ([]*domain.Interaction, error) {
+	ctx, done := r.observe(ctx, "InteractionRepository.FindByRequesterID")
+	result, err := r.next.FindByRequesterID(ctx, requesterID, query)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedInteractionRepository) FindByApproverID(ctx context.Context, approverID string, query domain.InteractionQuery) ([]*domain.Interaction, error) {
+	ctx, done := r.observe(ctx, "InteractionRepository.FindByApproverID")
+	result, err := r.next.FindByApproverID(ctx, approverID, query)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedInteractionRepository) CountPendingByApproverID(ctx context.Context, approverID string) (int, error) {
+	ctx, done := r.observe(ctx, "InteractionRepository.CountPendingByApproverID")
+	result, err := r.next.CountPendingByApproverID(ctx, approverID)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedInteractionRepository) CountByStatus(ctx context.Context) (map[domain.InteractionStatus]int, error) {
+	ctx, done := r.observe(ctx, "InteractionRepository.CountByStatus")
+	result, err := r.next.CountByStatus(ctx)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedInteractionRepository) FindByMetadata(ctx context.Context, key string, value interface{}) ([]*domain.Interaction, error) {
+	ctx, done := r.observe(ctx, "InteractionRepository.FindByMetadata")
+	result, err := r.next.FindByMetadata(ctx, key, value)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedInteractionRepository) Update(ctx context.Context, interaction *domain.Interaction) error {
+	ctx, done := r.observe(ctx, "InteractionRepository.Update")
+	err := r.next.Update(ctx, interaction)
+	done(err)
+	return err
+}
+
+func (r *instrumentedInteractionRepository) Transition(ctx context.Context, interaction *domain.Interaction, from domain.InteractionStatus) (bool, error) {
+	ctx, done := r.observe(ctx, "InteractionRepository.Transition")
+	result, err := r.next.Transition(ctx, interaction, from)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedInteractionRepository) TransitionAndConnect(ctx context.Context, interaction *domain.Interaction, from domain.InteractionStatus, policy domain.RelationshipPolicy, fn func(*domain.Relationship)) (string, bool, error) {
+	ctx, done := r.observe(ctx, "InteractionRepository.TransitionAndConnect")
+	full, ok, err := r.next.TransitionAndConnect(ctx, interaction, from, policy, fn)
+	done(err)
+	return full, ok, err
+}
+
+func (r *instrumentedInteractionRepository) TransitionAndModify(ctx context.Context, interaction *domain.Interaction, from domain.InteractionStatus, fn func(*domain.Relationship)) (bool, error) {
+	ctx, done := r.observe(ctx, "InteractionRepository.TransitionAndModify")
+	ok, err := r.next.TransitionAndModify(ctx, interaction, from, fn)
+	done(err)
+	return ok, err
+}
+
+// instrumentedCircleRepository reports every call to a repository.CircleRepository.
+type instrumentedCircleRepository struct {
+	next    repository.CircleRepository
+	observe Observer
+}
+
+// NewInstrumentedCircleRepository wraps next so observe sees every call.
+func NewInstrumentedCircleRepository(next repository.CircleRepository, observe Observer) repository.CircleRepository {
+	return &instrumentedCircleRepository{next: next, observe: observe}
+}
+
+func (r *instrumentedCircleRepository) Save(ctx context.Context, circle *domain.Circle) error {
+	ctx, done := r.observe(ctx, "CircleRepository.Save")
+	err := r.next.Save(ctx, circle)
+	done(err)
+	return err
+}
+
+func (r *instrumentedCircleRepository) FindByID(ctx context.Context, id string) (*domain.Circle, error) {
+	ctx, done := r.observe(ctx, "CircleRepository.FindByID")
+	result, err := r.next.FindByID(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedCircleRepository) FindByMemberID(ctx context.Context, userID string) ([]*domain.Circle, error) {
+	ctx, done := r.observe(ctx, "CircleRepository.FindByMemberID")
+	result, err := r.next.FindByMemberID(ctx, userID)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedCircleRepository) AddMember(ctx context.Context, member *domain.CircleMember) error {
+	ctx, done := r.observe(ctx, "CircleRepository.AddMember")
+	err := r.next.AddMember(ctx, member)
+	done(err)
+	return err
+}
+
+func (r *instrumentedCircleRepository) RemoveMember(ctx context.Context, circleID, userID string) error {
+	ctx, done := r.observe(ctx, "CircleRepository.RemoveMember")
+	err := r.next.RemoveMember(ctx, circleID, userID)
+	done(err)
+	return err
+}
+
+func (r *instrumentedCircleRepository) FindMember(ctx context.Context, circleID, userID string) (*domain.CircleMember, error) {
+	ctx, done := r.observe(ctx, "CircleRepository.FindMember")
+	result, err := r.next.FindMember(ctx, circleID, userID)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedCircleRepository) FindMembers(ctx context.Context, circleID string) ([]*domain.CircleMember, error) {
+	ctx, done := r.observe(ctx, "CircleRepository.FindMembers")
+	result, err := r.next.FindMembers(ctx, circleID)
+	done(err)
+	return result, err
+}
+
+// instrumentedTraceRepository reports every call to a repository.TraceRepository.
+type instrumentedTraceRepository struct {
+	next    repository.TraceRepository
+	observe Observer
+}
+
+// NewInstrumentedTraceRepository wraps next so observe sees every call.
+func NewInstrumentedTraceRepository(next repository.TraceRepository, observe Observer) repository.TraceRepository {
+	return &instrumentedTraceRepository{next: next, observe: observe}
+}
+
+func (r *instrumentedTraceRepository) Save(ctx context.Context, trace *domain.Trace) error {
+	ctx, done := r.observe(ctx, "TraceRepository.Save")
+	err := r.next.Save(ctx, trace)
+	done(err)
+	return err
+}
+
+func (r *instrumentedTraceRepository) FindByID(ctx context.Context, id string) (*domain.Trace, error) {
+	ctx, done := r.observe(ctx, "TraceRepository.FindByID")
+	result, err := r.next.FindByID(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedTraceRepository) FindByCircleID(ctx context.Context, circleID string) ([]*domain.Trace, error) {
+	ctx, done := r.observe(ctx, "TraceRepository.FindByCircleID")
+	result, err := r.next.FindByCircleID(ctx, circleID)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedTraceRepository) FindByAuthorID(ctx context.Context, authorID string, limit int) ([]*domain.Trace, error) {
+	ctx, done := r.observe(ctx, "TraceRepository.FindByAuthorID")
+	result, err := r.next.FindByAuthorID(ctx, authorID, limit)
+	done(err)
+	return result, err
+}
+
+// instrumentedExchangeRepository reports every call to a repository.ExchangeRepository.
+type instrumentedExchangeRepository struct {
+	next    repository.ExchangeRepository
+	observe Observer
+}
+
+// NewInstrumentedExchangeRepository wraps next so observe sees every call.
+func NewInstrumentedExchangeRepository(next repository.ExchangeRepository, observe Observer) repository.ExchangeRepository {
+	return &instrumentedExchangeRepository{next: next, observe: observe}
+}
+
+func (r *instrumentedExchangeRepository) Save(ctx context.Context, exchange *domain.Exchange) error {
+	ctx, done := r.observe(ctx, "ExchangeRepository.Save")
+	err := r.next.Save(ctx, exchange)
+	done(err)
+	return err
+}
+
+func (r *instrumentedExchangeRepository) FindByID(ctx context.Context, id string) (*domain.Exchange, error) {
+	ctx, done := r.observe(ctx, "ExchangeRepository.FindByID")
+	result, err := r.next.FindByID(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedExchangeRepository) Respond(ctx context.Context, exchange *domain.Exchange) (bool, error) {
+	ctx, done := r.observe(ctx, "ExchangeRepository.Respond")
+	result, err := r.next.Respond(ctx, exchange)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedExchangeRepository) RespondAndConnect(ctx context.Context, exchange *domain.Exchange, policy domain.RelationshipPolicy, fn func(*domain.Relationship)) (string, bool, error) {
+	ctx, done := r.observe(ctx, "ExchangeRepository.RespondAndConnect")
+	full, ok, err := r.next.RespondAndConnect(ctx, exchange, policy, fn)
+	done(err)
+	return full, ok, err
+}
+
+// instrumentedRelationshipRepository reports every call to a repository.RelationshipRepository.
+type instrumentedRelationshipRepository struct {
+	next    repository.RelationshipRepository
+	observe Observer
+}
+
+// NewInstrumentedRelationshipRepository wraps next so observe sees every call.
+func NewInstrumentedRelationshipRepository(next repository.RelationshipRepository, observe Observer) repository.RelationshipRepository {
+	return &instrumentedRelationshipRepository{next: next, observe: observe}
+}
+
+func (r *instrumentedRelationshipRepository) FindBetween(ctx context.Context, userID, otherID string) (*domain.Relationship, error) {
+	ctx, done := r.observe(ctx, "RelationshipRepository.FindBetween")
+	result, err := r.next.FindBetween(ctx, userID, otherID)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedRelationshipRepository) Modify(ctx context.Context, userID, otherID string, fn func(*domain.Relationship)) (bool, error) {
+	ctx, done := r.observe(ctx, "RelationshipRepository.Modify")
+	result, err := r.next.Modify(ctx, userID, otherID, fn)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedRelationshipRepository) Connect(ctx context.Context, userID, otherID string, policy domain.RelationshipPolicy, fn func(*domain.Relationship)) (string, error) {
+	ctx, done := r.observe(ctx, "RelationshipRepository.Connect")
+	result, err := r.next.Connect(ctx, userID, otherID, policy, fn)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedRelationshipRepository) Delete(ctx context.Context, userID, otherID string) error {
+	ctx, done := r.observe(ctx, "RelationshipRepository.Delete")
+	err := r.next.Delete(ctx, userID, otherID)
+	done(err)
+	return err
+}
+
+func (r *instrumentedRelationshipRepository) FindByUserID(ctx context.Context, userID string, minStrength float64) ([]*domain.Relationship, error) {
+	ctx, done := r.observe(ctx, "RelationshipRepository.FindByUserID")
+	result, err := r.next.FindByUserID(ctx, userID, minStrength)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedRelationshipRepository) List(ctx context.Context, limit, offset int) ([]*domain.Relationship, error) {
+	ctx, done := r.observe(ctx, "RelationshipRepository.List")
+	result, err := r.next.List(ctx, limit, offset)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedRelationshipRepository) FindConnectedUserIDs(ctx context.Context, userID string, minStrength float64) ([]string, error) {
+	ctx, done := r.observe(ctx, "RelationshipRepository.FindConnectedUserIDs")
+	result, err := r.next.FindConnectedUserIDs(ctx, userID, minStrength)
+	done(err)
+	return result, err
+}
+
+// instrumentedBlockRepository reports every call to a repository.BlockRepository.
+type instrumentedBlockRepository struct {
+	next    repository.BlockRepository
+	observe Observer
+}
+
+// NewInstrumentedBlockRepository wraps next so observe sees every call.
+func NewInstrumentedBlockRepository(next repository.BlockRepository, observe Observer) repository.BlockRepository {
+	return &instrumentedBlockRepository{next: next, observe: observe}
+}
+
+func (r *instrumentedBlockRepository) SaveAndDisconnect(ctx context.Context, block *domain.Block) error {
+	ctx, done := r.observe(ctx, "BlockRepository.SaveAndDisconnect")
+	err := r.next.SaveAndDisconnect(ctx, block)
+	done(err)
+	return err
+}
+
+func (r *instrumentedBlockRepository) Delete(ctx context.Context, blockerID, blockedID string) error {
+	ctx, done := r.observe(ctx, "BlockRepository.Delete")
+	err := r.next.Delete(ctx, blockerID, blockedID)
+	done(err)
+	return err
+}
+
+func (r *instrumentedBlockRepository) FindByBlockerID(ctx context.Context, blockerID string) ([]*domain.Block, error) {
+	ctx, done := r.observe(ctx, "BlockRepository.FindByBlockerID")
+	result, err := r.next.FindByBlockerID(ctx, blockerID)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedBlockRepository) ExistsBetween(ctx context.Context, userID, otherID string) (bool, error) {
+	ctx, done := r.observe(ctx, "BlockRepository.ExistsBetween")
+	result, err := r.next.ExistsBetween(ctx, userID, otherID)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedBlockRepository) FindBlockedUserIDs(ctx context.Context, userID string) ([]string, error) {
+	ctx, done := r.observe(ctx, "BlockRepository.FindBlockedUserIDs")
+	result, err := r.next.FindBlockedUserIDs(ctx, userID)
+	done(err)
+	return result, err
+}
+
+// instrumentedReportRepository reports every call to a repository.ReportRepository.
+type instrumentedReportRepository struct {
+	next    repository.ReportRepository
+	observe Observer
+}
+
+// NewInstrumentedReportRepository wraps next so observe sees every call.
+func NewInstrumentedReportRepository(next repository.ReportRepository, observe Observer) repository.ReportRepository {
+	return &instrumentedReportRepository{next: next, observe: observe}
+}
+
+func (r *instrumentedReportRepository) Save(ctx context.Context, report *domain.Report) error {
+	ctx, done := r.observe(ctx, "ReportRepository.Save")
+	err := r.next.Save(ctx, report)
+	done(err)
+	return err
+}
+
+func (r *instrumentedReportRepository) FindByID(ctx context.Context, id string) (*domain.Report, error) {
+	ctx, done := r.observe(ctx, "ReportRepository.FindByID")
+	result, err := r.next.FindByID(ctx, id)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedReportRepository) FindByStatus(ctx context.Context, status domain.ReportStatus, limit, offset int) ([]*domain.Report, error) {
+	ctx, done := r.observe(ctx, "ReportRepository.FindByStatus")
+	result, err := r.next.FindByStatus(ctx, status, limit, offset)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedReportRepository) Close(ctx context.Context, report *domain.Report) (bool, error) {
+	ctx, done := r.observe(ctx, "ReportRepository.Close")
+	result, err := r.next.Close(ctx, report)
+	done(err)
+	return result, err
+}
+
+// instrumentedRefreshTokenRepository reports every call to a repository.RefreshTokenRepository.
+type instrumentedRefreshTokenRepository struct {
+	next    repository.RefreshTokenRepository
+	observe Observer
+}
+
+// NewInstrumentedRefreshTokenRepository wraps next so observe sees every call.
+func NewInstrumentedRefreshTokenRepository(next repository.RefreshTokenRepository, observe Observer) repository.RefreshTokenRepository {
+	return &instrumentedRefreshTokenRepository{next: next, observe: observe}
+}
+
+func (r *instrumentedRefreshTokenRepository) Save(ctx context.Context, token *domain.RefreshToken) error {
+	ctx, done := r.observe(ctx, "RefreshTokenRepository.Save")
+	err := r.next.Save(ctx, token)
+	done(err)
+	return err
+}
+
+func (r *instrumentedRefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	ctx, done := r.observe(ctx, "RefreshTokenRepository.FindByHash")
+	result, err := r.next.FindByHash(ctx, tokenHash)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedRefreshTokenRepository) Revoke(ctx context.Context, id string, at time.Time) (bool, error) {
+	ctx, done := r.observe(ctx, "RefreshTokenRepository.Revoke")
+	result, err := r.next.Revoke(ctx, id, at)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedRefreshTokenRepository) RevokeByUserID(ctx context.Context, userID string, at time.Time) error {
+	ctx, done := r.observe(ctx, "RefreshTokenRepository.RevokeByUserID")
+	err := r.next.RevokeByUserID(ctx, userID, at)
+	done(err)
+	return err
+}
+
+// instrumentedWalletChallengeRepository reports every call to a repository.WalletChallengeRepository.
+type instrumentedWalletChallengeRepository struct {
+	next    repository.WalletChallengeRepository
+	observe Observer
+}
+
+// NewInstrumentedWalletChallengeRepository wraps next so observe sees every call.
+func NewInstrumentedWalletChallengeRepository(next repository.WalletChallengeRepository, observe Observer) repository.WalletChallengeRepository {
+	return &instrumentedWalletChallengeRepository{next: next, observe: observe}
+}
+
+func (r *instrumentedWalletChallengeRepository) Save(ctx context.Context, challenge *domain.WalletChallenge) error {
+	ctx, done := r.observe(ctx, "WalletChallengeRepository.Save")
+	err := r.next.Save(ctx, challenge)
+	done(err)
+	return err
+}
+
+func (r *instrumentedWalletChallengeRepository) FindByUserID(ctx context.Context, userID string) (*domain.WalletChallenge, error) {
+	ctx, done := r.observe(ctx, "WalletChallengeRepository.FindByUserID")
+	result, err := r.next.FindByUserID(ctx, userID)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedWalletChallengeRepository) Delete(ctx context.Context, userID string) error {
+	ctx, done := r.observe(ctx, "WalletChallengeRepository.Delete")
+	err := r.next.Delete(ctx, userID)
+	done(err)
+	return err
+}
+
+// instrumentedRateLimiter reports every call to a repository.RateLimiter.
+type instrumentedRateLimiter struct {
+	next    repository.RateLimiter
+	observe Observer
+}
+
+// NewInstrumentedRateLimiter wraps next so observe sees every call.
+func NewInstrumentedRateLimiter(next repository.RateLimiter, observe Observer) repository.RateLimiter {
+	return &instrumentedRateLimiter{next: next, observe: observe}
+}
+
+func (r *instrumentedRateLimiter) Take(ctx context.Context, key string, limit domain.RateLimit) (bool, time.Duration, error) {
+	ctx, done := r.observe(ctx, "RateLimiter.Take")
+	allowed, retryAfter, err := r.next.Take(ctx, key, limit)
+	done(err)
+	return allowed, retryAfter, err
+}
+
+// instrumentedLineWebhookRecordRepository reports every call to a repository.LineWebhookRecordRepository.
+type instrumentedLineWebhookRecordRepository struct {
+	next    repository.LineWebhookRecordRepository
+	observe Observer
+}
+
+// NewInstrumentedLineWebhookRecordRepository wraps next so observe sees every call.
+func NewInstrumentedLineWebhookRecordRepository(next repository.LineWebhookRecordRepository, observe Observer) repository.LineWebhookRecordRepository {
+	return &instrumentedLineWebhookRecordRepository{next: next, observe: observe}
+}
+
+func (r *instrumentedLineWebhookRecordRepository) Save(ctx context.Context, record *domain.LineWebhookRecord) error {
+	ctx, done := r.observe(ctx, "LineWebhookRecordRepository.Save")
+	err := r.next.Save(ctx, record)
+	done(err)
+	return err
+}
+
+func (r *instrumentedLineWebhookRecordRepository) List(ctx context.Context) ([]*domain.LineWebhookRecord, error) {
+	ctx, done := r.observe(ctx, "LineWebhookRecordRepository.List")
+	result, err := r.next.List(ctx)
+	done(err)
+	return result, err
+}
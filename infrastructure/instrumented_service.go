@@ -0,0 +1,82 @@
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// The decorators below wrap an external service so an Observer sees every
+// call, labelled "<Interface>.<Method>", just like the repository ones.
+
+// instrumentedLineService reports every call to a repository.LineService.
+type instrumentedLineService struct {
+	next    repository.LineService
+	observe Observer
+}
+
+// NewInstrumentedLineService wraps next so observe sees every call.
+func NewInstrumentedLineService(next repository.LineService, observe Observer) repository.LineService {
+	return &instrumentedLineService{next: next, observe: observe}
+}
+
+func (r *instrumentedLineService) SendMessage(ctx context.Context, userID string, message string) error {
+	ctx, done := r.observe(ctx, "LineService.SendMessage")
+	err := r.next.SendMessage(ctx, userID, message)
+	done(err)
+	return err
+}
+
+func (r *instrumentedLineService) SendFlexMessage(ctx context.Context, userID string, flexMessage string) error {
+	ctx, done := r.observe(ctx, "LineService.SendFlexMessage")
+	err := r.next.SendFlexMessage(ctx, userID, flexMessage)
+	done(err)
+	return err
+}
+
+func (r *instrumentedLineService) ReplyMessage(ctx context.Context, replyToken string, message string) error {
+	ctx, done := r.observe(ctx, "LineService.ReplyMessage")
+	err := r.next.ReplyMessage(ctx, replyToken, message)
+	done(err)
+	return err
+}
+
+func (r *instrumentedLineService) GetProfile(ctx context.Context, userID string) (*domain.LineProfile, error) {
+	ctx, done := r.observe(ctx, "LineService.GetProfile")
+	result, err := r.next.GetProfile(ctx, userID)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedLineService) VerifyCredentials(ctx context.Context) error {
+	ctx, done := r.observe(ctx, "LineService.VerifyCredentials")
+	err := r.next.VerifyCredentials(ctx)
+	done(err)
+	return err
+}
+
+// instrumentedLineLoginVerifier reports every call to a repository.LineLoginVerifier.
+type instrumentedLineLoginVerifier struct {
+	next    repository.LineLoginVerifier
+	observe Observer
+}
+
+// NewInstrumentedLineLoginVerifier wraps next so observe sees every call.
+func NewInstrumentedLineLoginVerifier(next repository.LineLoginVerifier, observe Observer) repository.LineLoginVerifier {
+	return &instrumentedLineLoginVerifier{next: next, observe: observe}
+}
+
+func (r *instrumentedLineLoginVerifier) VerifyIDToken(ctx context.Context, idToken string) (*domain.LineIdentity, error) {
+	ctx, done := r.observe(ctx, "LineLoginVerifier.VerifyIDToken")
+	result, err := r.next.VerifyIDToken(ctx, idToken)
+	done(err)
+	return result, err
+}
+
+func (r *instrumentedLineLoginVerifier) VerifyAccessToken(ctx context.Context, accessToken string) (*domain.LineIdentity, error) {
+	ctx, done := r.observe(ctx, "LineLoginVerifier.VerifyAccessToken")
+	result, err := r.next.VerifyAccessToken(ctx, accessToken)
+	done(err)
+	return result, err
+}
@@ -0,0 +1,205 @@
+package infrastructure_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+	"github.com/dkpcb/pet/metrics"
+	"github.com/dkpcb/pet/tracing"
+)
+
+// observed is a metrics registry and tracer to instrument calls with.
+type observed struct {
+	registry  *metrics.Registry
+	durations *metrics.HistogramVec
+	tracer    *tracing.Tracer
+	exporter  *tracing.InMemoryExporter
+}
+
+func newObserved(t *testing.T) *observed {
+	t.Helper()
+	registry := metrics.NewRegistry()
+	exporter := tracing.NewInMemoryExporter()
+	tracer := tracing.NewTracer(exporter, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	t.Cleanup(func() { tracer.Shutdown(context.Background()) })
+	return &observed{
+		registry:  registry,
+		durations: registry.Histogram("test_duration_seconds", "Test calls.", metrics.DefaultBuckets, "method", "outcome"),
+		tracer:    tracer,
+		exporter:  exporter,
+	}
+}
+
+func (o *observed) observer() infrastructure.Observer {
+	return infrastructure.Observers(infrastructure.ObserveDurations(o.durations), infrastructure.ObserveSpans())
+}
+
+func (o *observed) metricsText(t *testing.T) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := o.registry.WriteText(context.Background(), &buf); err != nil {
+		t.Fatalf("WriteText() = %v", err)
+	}
+	return buf.String()
+}
+
+func (o *observed) spans() []tracing.SpanData {
+	o.tracer.ForceFlush(context.Background())
+	return o.exporter.Spans()
+}
+
+func TestInstrumentedRepository(t *testing.T) {
+	o := newObserved(t)
+	dir := t.TempDir()
+	repo := infrastructure.NewInstrumentedLineWebhookRecordRepository(
+		infrastructure.NewLineWebhookRecordRepository(filepath.Join(dir, "records.jsonl")), o.observer())
+	// A directory cannot be opened as a recording, so every call fails.
+	broken := infrastructure.NewInstrumentedLineWebhookRecordRepository(
+		infrastructure.NewLineWebhookRecordRepository(dir), o.observer())
+
+	ctx, root := o.tracer.Start(context.Background(), "POST /webhook/line", tracing.KindServer)
+	record := &domain.LineWebhookRecord{ReceivedAt: time.Now(), Body: []byte(`{"events":[]}`)}
+	if err := repo.Save(ctx, record); err != nil {
+		t.Fatalf("Save() = %v", err)
+	}
+	records, err := repo.List(ctx)
+	if err != nil || len(records) != 1 {
+		t.Fatalf("List() = %d records, %v; want the saved one", len(records), err)
+	}
+	if err := broken.Save(ctx, record); err == nil {
+		t.Fatal("Save() to a directory = nil, want an error")
+	}
+	root.End()
+
+	text := o.metricsText(t)
+	for _, want := range []string{
+		`test_duration_seconds_count{method="LineWebhookRecordRepository.Save",outcome="ok"} 1`,
+		`test_duration_seconds_count{method="LineWebhookRecordRepository.List",outcome="ok"} 1`,
+		`test_duration_seconds_count{method="LineWebhookRecordRepository.Save",outcome="error"} 1`,
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("metrics lack %s:\n%s", want, text)
+		}
+	}
+
+	spans := o.spans()
+	if len(spans) != 4 {
+		t.Fatalf("exported %d spans, want 3 calls and the root", len(spans))
+	}
+	rootID := root.SpanContext().SpanID
+	wantNames := []string{"LineWebhookRecordRepository.Save", "LineWebhookRecordRepository.List", "LineWebhookRecordRepository.Save"}
+	for i, name := range wantNames {
+		span := spans[i]
+		if span.Name != name || span.Kind != tracing.KindClient {
+			t.Errorf("span %d = %q kind %d, want %q kind %d", i, span.Name, span.Kind, name, tracing.KindClient)
+		}
+		if span.Parent != rootID {
+			t.Errorf("span %q parent = %s, want the request span %s", span.Name, span.Parent, rootID)
+		}
+	}
+	if spans[0].Err != "" || spans[2].Err == "" {
+		t.Errorf("span errors = %q, %q; want only the failed call marked", spans[0].Err, spans[2].Err)
+	}
+}
+
+func TestInstrumentedRateLimiter(t *testing.T) {
+	o := newObserved(t)
+	limiter := infrastructure.NewInstrumentedRateLimiter(infrastructure.NewMemoryRateLimiter(), o.observer())
+	limit := domain.RateLimit{Burst: 1, Refill: time.Hour}
+
+	// Results must pass through the decorator unchanged.
+	if allowed, _, err := limiter.Take(context.Background(), "user", limit); err != nil || !allowed {
+		t.Fatalf("first Take() = %v, %v; want allowed", allowed, err)
+	}
+	allowed, retryAfter, err := limiter.Take(context.Background(), "user", limit)
+	if err != nil || allowed || retryAfter <= 0 {
+		t.Fatalf("second Take() = %v, %v, %v; want refused with a retry delay", allowed, retryAfter, err)
+	}
+
+	if want := `test_duration_seconds_count{method="RateLimiter.Take",outcome="ok"} 2`; !strings.Contains(o.metricsText(t), want) {
+		t.Errorf("metrics lack %s", want)
+	}
+	// Without a span in the context there is nothing to be a child of.
+	if spans := o.spans(); len(spans) != 0 {
+		t.Errorf("exported %d spans without a parent span, want none", len(spans))
+	}
+}
+
+func TestObserversOrder(t *testing.T) {
+	var calls []string
+	observer := func(name string) infrastructure.Observer {
+		return func(ctx context.Context, method string) (context.Context, func(error)) {
+			calls = append(calls, name+" start "+method)
+			return ctx, func(error) { calls = append(calls, name+" done") }
+		}
+	}
+
+	_, done := infrastructure.Observers(observer("a"), observer("b"))(context.Background(), "M")
+	done(nil)
+
+	want := []string{"a start M", "b start M", "b done", "a done"}
+	if strings.Join(calls, "|") != strings.Join(want, "|") {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestMetricsTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	registry := metrics.NewRegistry()
+	durations := registry.Histogram("test_request_duration_seconds", "Test requests.", metrics.DefaultBuckets, "endpoint", "code")
+	client := &http.Client{Transport: infrastructure.NewMetricsTransport(nil, durations)}
+
+	for _, path := range []string{"/oauth2/v2.1/verify?id_token=secret", "/oauth2/v2.1/verify", "/missing", "/v2/bot/profile/U0001", "/v2/bot/profile/U0002"} {
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		resp.Body.Close()
+	}
+	// Nothing listens on a closed server, so no response arrives.
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	if _, err := client.Get(closed.URL + "/gone"); err == nil {
+		t.Fatal("GET on a closed server succeeded")
+	}
+
+	var buf bytes.Buffer
+	if err := registry.WriteText(context.Background(), &buf); err != nil {
+		t.Fatalf("WriteText() = %v", err)
+	}
+	text := buf.String()
+	for _, want := range []string{
+		`test_request_duration_seconds_count{endpoint="/oauth2/v2.1/verify",code="200"} 2`,
+		`test_request_duration_seconds_count{endpoint="/missing",code="404"} 1`,
+		`test_request_duration_seconds_count{endpoint="/gone",code="error"} 1`,
+		`test_request_duration_seconds_count{endpoint="/v2/bot/profile/{id}",code="200"} 2`,
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("metrics lack %s:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "secret") {
+		t.Error("a query string leaked into the endpoint label")
+	}
+	if strings.Contains(text, "U0001") {
+		t.Error("a user ID leaked into the endpoint label")
+	}
+}
@@ -2,7 +2,10 @@ package infrastructure
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -42,10 +45,11 @@ func (r *InteractionRepository) FindByID(ctx context.Context, id string) (*domai
 	return row.ToDomain(), nil
 }
 
-// FindByRequesterID retrieves all interactions requested by a specific user.
-func (r *InteractionRepository) FindByRequesterID(ctx context.Context, requesterID string) ([]*domain.Interaction, error) {
+// FindByRequesterID retrieves the interactions requested by a specific user
+// that match query, newest first.
+func (r *InteractionRepository) FindByRequesterID(ctx context.Context, requesterID string, query domain.InteractionQuery) ([]*domain.Interaction, error) {
 	var rows []table.Interaction
-	if err := r.db.WithContext(ctx).Where("requester_id = ?", requesterID).Find(&rows).Error; err != nil {
+	if err := r.page(ctx, query).Where("requester_id = ?", requesterID).Find(&rows).Error; err != nil {
 		return nil, fmt.Errorf("failed to find interactions by requester ID: %w", err)
 	}
 
@@ -56,10 +60,11 @@ func (r *InteractionRepository) FindByRequesterID(ctx context.Context, requester
 	return result, nil
 }
 
-// FindByApproverID retrieves all interactions where a specific user is the approver.
-func (r *InteractionRepository) FindByApproverID(ctx context.Context, approverID string) ([]*domain.Interaction, error) {
+// FindByApproverID retrieves the interactions where a specific user is the
+// approver that match query, newest first.
+func (r *InteractionRepository) FindByApproverID(ctx context.Context, approverID string, query domain.InteractionQuery) ([]*domain.Interaction, error) {
 	var rows []table.Interaction
-	if err := r.db.WithContext(ctx).Where("approver_id = ?", approverID).Find(&rows).Error; err != nil {
+	if err := r.page(ctx, query).Where("approver_id = ?", approverID).Find(&rows).Error; err != nil {
 		return nil, fmt.Errorf("failed to find interactions by approver ID: %w", err)
 	}
 
@@ -70,6 +75,92 @@ func (r *InteractionRepository) FindByApproverID(ctx context.Context, approverID
 	return result, nil
 }
 
+// page applies the status filter and keyset pagination of query. Pages are
+// ordered by (created_at, id) descending and continue strictly below the
+// cursor, so rows inserted meanwhile never shift a later page.
+func (r *InteractionRepository) page(ctx context.Context, query domain.InteractionQuery) *gorm.DB {
+	db := r.db.WithContext(ctx).Order("created_at DESC, id DESC")
+	if query.Status != nil {
+		db = db.Where("status = ?", string(*query.Status))
+	}
+	if query.After != nil {
+		db = db.Where("created_at < ? OR (created_at = ? AND id < ?)",
+			query.After.CreatedAt, query.After.CreatedAt, query.After.ID)
+	}
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
+	}
+	return db
+}
+
+// CountPendingByApproverID counts the interactions waiting on a specific approver.
+func (r *InteractionRepository) CountPendingByApproverID(ctx context.Context, approverID string) (int, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&table.Interaction{}).
+		Where("approver_id = ? AND status = ?", approverID, string(domain.InteractionStatusPending)).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending interactions: %w", err)
+	}
+	return int(count), nil
+}
+
+// CountByStatus counts all interactions per status.
+func (r *InteractionRepository) CountByStatus(ctx context.Context) (map[domain.InteractionStatus]int, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&table.Interaction{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count interactions by status: %w", err)
+	}
+
+	counts := make(map[domain.InteractionStatus]int, len(rows))
+	for _, row := range rows {
+		counts[domain.InteractionStatus(row.Status)] = int(row.Count)
+	}
+	return counts, nil
+}
+
+// FindByMetadata retrieves all interactions whose metadata has key set to value.
+func (r *InteractionRepository) FindByMetadata(ctx context.Context, key string, value interface{}) ([]*domain.Interaction, error) {
+	query := r.db.WithContext(ctx)
+	switch DialectOf(r.db) {
+	case DialectPostgres:
+		// Containment is served by the GIN index on metadata.
+		doc, err := metadataDocument(key, value)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("metadata @> ?::jsonb", doc)
+	case DialectMySQL:
+		doc, err := metadataDocument(key, value)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("JSON_CONTAINS(metadata, ?)", doc)
+	default:
+		query = query.Where("json_extract(metadata, ?) = ?", jsonPath(key), value)
+	}
+
+	var rows []table.Interaction
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find interactions by metadata: %w", err)
+	}
+
+	result := make([]*domain.Interaction, len(rows))
+	for i, row := range rows {
+		result[i] = row.ToDomain()
+	}
+	return result, nil
+}
+
 // Update updates an existing interaction.
 func (r *InteractionRepository) Update(ctx context.Context, interaction *domain.Interaction) error {
 	row := table.FromDomainInteraction(interaction)
@@ -78,3 +169,88 @@ func (r *InteractionRepository) Update(ctx context.Context, interaction *domain.
 	}
 	return nil
 }
+
+// Transition saves the interaction's new status if it is still from.
+func (r *InteractionRepository) Transition(ctx context.Context, interaction *domain.Interaction, from domain.InteractionStatus) (bool, error) {
+	return transitionInteraction(r.db.WithContext(ctx), interaction, from)
+}
+
+// TransitionAndConnect saves the interaction's new status and connects its
+// users in one transaction.
+func (r *InteractionRepository) TransitionAndConnect(ctx context.Context, interaction *domain.Interaction, from domain.InteractionStatus, policy domain.RelationshipPolicy, fn func(*domain.Relationship)) (string, bool, error) {
+	ok := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Update the interaction first: the row stays locked until commit,
+		// so a concurrent transition waits and then finds it changed.
+		var err error
+		ok, err = transitionInteraction(tx, interaction, from)
+		if err != nil || !ok {
+			return err
+		}
+		return connect(tx, interaction.RequesterID, interaction.ApproverID, policy, fn)
+	})
+
+	var noRoom errNoRoom
+	if errors.As(err, &noRoom) {
+		return noRoom.userID, false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return "", ok, nil
+}
+
+// TransitionAndModify saves the interaction's new status and applies fn to
+// the existing relationship of its users in one transaction.
+func (r *InteractionRepository) TransitionAndModify(ctx context.Context, interaction *domain.Interaction, from domain.InteractionStatus, fn func(*domain.Relationship)) (bool, error) {
+	ok := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		ok, err = transitionInteraction(tx, interaction, from)
+		if err != nil || !ok {
+			return err
+		}
+		rel, err := lockExistingRelationship(tx, interaction.RequesterID, interaction.ApproverID)
+		if err != nil || rel == nil {
+			return err
+		}
+		fn(rel)
+		return saveRelationship(tx, rel)
+	})
+	if err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// transitionInteraction updates the status and attestation of the
+// interaction where its status is still from, and reports whether it did.
+func transitionInteraction(db *gorm.DB, interaction *domain.Interaction, from domain.InteractionStatus) (bool, error) {
+	result := db.Model(&table.Interaction{}).
+		Where("id = ? AND status = ?", interaction.ID, string(from)).
+		Updates(map[string]interface{}{
+			"status":      string(interaction.Status),
+			"attestation": table.FromDomainAttestation(interaction.Attestation),
+			"updated_at":  time.Now(),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to update interaction status: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// metadataDocument builds the JSON object {key: value} used for containment queries.
+func metadataDocument(key string, value interface{}) (string, error) {
+	doc, err := json.Marshal(map[string]interface{}{key: value})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode metadata filter: %w", err)
+	}
+	return string(doc), nil
+}
+
+// jsonPath builds a JSON path selecting a top-level key, quoted so keys
+// containing dots or spaces are not interpreted as nested paths.
+func jsonPath(key string) string {
+	quoted, _ := json.Marshal(key)
+	return "$." + string(quoted)
+}
@@ -0,0 +1,289 @@
+package infrastructure_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+)
+
+// newTestDB opens an in-memory SQLite database with every migration applied.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := infrastructure.OpenDatabase(infrastructure.DialectSQLite, ":memory:", slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	if err := infrastructure.Migrate(context.Background(), db); err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+	return db
+}
+
+// saveUsers stores a user for each ID.
+func saveUsers(t *testing.T, db *gorm.DB, ids ...string) {
+	t.Helper()
+	users := infrastructure.NewUserRepository(db)
+	for i, id := range ids {
+		lineUserID := "U" + id[:8]
+		if err := users.Save(context.Background(), domain.NewUser(id, lineUserID, "User "+string(rune('A'+i)), nil)); err != nil {
+			t.Fatalf("save user %s: %v", id, err)
+		}
+	}
+}
+
+const (
+	requesterID = "11111111-1111-4111-8111-111111111111"
+	approverID  = "22222222-2222-4222-8222-222222222222"
+	thirdUserID = "33333333-3333-4333-8333-333333333333"
+)
+
+// savePendingInteraction stores a pending interaction between requesterID
+// and approverID and returns a copy as each concurrent reader would load it.
+func savePendingInteraction(t *testing.T, repo interface {
+	Save(context.Context, *domain.Interaction) error
+}) func() *domain.Interaction {
+	t.Helper()
+	createdAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	const id = "44444444-4444-4444-8444-444444444444"
+	if err := repo.Save(context.Background(), domain.NewInteraction(id, requesterID, approverID, domain.InteractionStatusPending, nil, createdAt)); err != nil {
+		t.Fatalf("save interaction: %v", err)
+	}
+	return func() *domain.Interaction {
+		return domain.NewInteraction(id, requesterID, approverID, domain.InteractionStatusPending, nil, createdAt)
+	}
+}
+
+func meeting(rel *domain.Relationship) {
+	rel.RecordMeeting()
+}
+
+func TestInteractionRepositoryTransition(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	saveUsers(t, db, requesterID, approverID)
+	repo := infrastructure.NewInteractionRepository(db)
+	load := savePendingInteraction(t, repo)
+
+	// Two responses race: both read the interaction while it was pending.
+	approval, rejection := load(), load()
+	approval.Approve()
+	rejection.Reject()
+
+	ok, err := repo.Transition(ctx, approval, domain.InteractionStatusPending)
+	if err != nil || !ok {
+		t.Fatalf("first Transition = %v, %v; want true, nil", ok, err)
+	}
+	ok, err = repo.Transition(ctx, rejection, domain.InteractionStatusPending)
+	if err != nil || ok {
+		t.Fatalf("second Transition = %v, %v; want false, nil", ok, err)
+	}
+
+	stored, err := repo.FindByID(ctx, approval.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != domain.InteractionStatusApproved {
+		t.Errorf("status = %s, want approved", stored.Status)
+	}
+}
+
+func TestInteractionRepositoryTransitionAndConnect(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	saveUsers(t, db, requesterID, approverID)
+	repo := infrastructure.NewInteractionRepository(db)
+	relationships := infrastructure.NewRelationshipRepository(db)
+	policy := domain.RelationshipPolicy{MinStrength: 0.5}
+	load := savePendingInteraction(t, repo)
+
+	approval := load()
+	approval.Approve()
+	full, ok, err := repo.TransitionAndConnect(ctx, approval, domain.InteractionStatusPending, policy, meeting)
+	if err != nil || full != "" || !ok {
+		t.Fatalf("TransitionAndConnect = %q, %v, %v; want \"\", true, nil", full, ok, err)
+	}
+
+	// A second approval of the same request must not count the meeting again.
+	again := load()
+	again.Approve()
+	full, ok, err = repo.TransitionAndConnect(ctx, again, domain.InteractionStatusPending, policy, meeting)
+	if err != nil || full != "" || ok {
+		t.Fatalf("repeated TransitionAndConnect = %q, %v, %v; want \"\", false, nil", full, ok, err)
+	}
+
+	rel, err := relationships.FindBetween(ctx, requesterID, approverID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel == nil || rel.Strength != domain.MeetingWeight {
+		t.Errorf("relationship = %+v, want strength %v", rel, domain.MeetingWeight)
+	}
+	stored, err := repo.FindByID(ctx, approval.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != domain.InteractionStatusApproved {
+		t.Errorf("status = %s, want approved", stored.Status)
+	}
+}
+
+func TestInteractionRepositoryTransitionAndConnectWithoutRoom(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	saveUsers(t, db, requesterID, approverID, thirdUserID)
+	repo := infrastructure.NewInteractionRepository(db)
+	relationships := infrastructure.NewRelationshipRepository(db)
+	policy := domain.RelationshipPolicy{MinStrength: 0.5, MaxDegree: 1}
+	load := savePendingInteraction(t, repo)
+
+	// The approver's only slot is taken.
+	if full, err := relationships.Connect(ctx, approverID, thirdUserID, policy, meeting); err != nil || full != "" {
+		t.Fatalf("Connect = %q, %v", full, err)
+	}
+
+	approval := load()
+	approval.Approve()
+	full, ok, err := repo.TransitionAndConnect(ctx, approval, domain.InteractionStatusPending, policy, meeting)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if full != approverID || ok {
+		t.Fatalf("TransitionAndConnect = %q, %v; want %q, false", full, ok, approverID)
+	}
+
+	// Neither the approval nor the connection was saved.
+	stored, err := repo.FindByID(ctx, approval.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != domain.InteractionStatusPending {
+		t.Errorf("status = %s, want pending", stored.Status)
+	}
+	rel, err := relationships.FindBetween(ctx, requesterID, approverID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel != nil {
+		t.Errorf("relationship %+v saved without room", rel)
+	}
+}
+
+// saveInteraction stores an interaction from requesterID to approverID.
+func saveInteraction(t *testing.T, repo interface {
+	Save(context.Context, *domain.Interaction) error
+}, id string, status domain.InteractionStatus, metadata map[string]interface{}, createdAt time.Time) {
+	t.Helper()
+	if err := repo.Save(context.Background(), domain.NewInteraction(id, requesterID, approverID, status, metadata, createdAt)); err != nil {
+		t.Fatalf("save interaction %s: %v", id, err)
+	}
+}
+
+func interactionID(n int) string {
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", n)
+}
+
+func TestInteractionRepositoryKeysetPagination(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	saveUsers(t, db, requesterID, approverID)
+	repo := infrastructure.NewInteractionRepository(db)
+
+	// Interactions 2 to 4 share a timestamp, so the ID decides their order.
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	createdAt := []time.Time{base, base.Add(time.Second), base.Add(time.Second), base.Add(time.Second), base.Add(2 * time.Second)}
+	for i, at := range createdAt {
+		saveInteraction(t, repo, interactionID(i+1), domain.InteractionStatusPending, nil, at)
+	}
+	want := []string{interactionID(5), interactionID(4), interactionID(3), interactionID(2), interactionID(1)}
+
+	var got []string
+	query := domain.InteractionQuery{Limit: 2}
+	for page := 0; ; page++ {
+		if page > len(want) {
+			t.Fatal("pagination does not end")
+		}
+		interactions, err := repo.FindByRequesterID(ctx, requesterID, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(interactions) == 0 {
+			break
+		}
+		for _, i := range interactions {
+			got = append(got, i.ID)
+		}
+		if page == 0 {
+			// A request made while paging lands before the first page and
+			// must not shift the later ones.
+			saveInteraction(t, repo, interactionID(6), domain.InteractionStatusPending, nil, base.Add(time.Hour))
+		}
+		cursor := interactions[len(interactions)-1].Cursor()
+		query.After = &cursor
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("pages = %v, want %v", got, want)
+	}
+
+	approved := domain.InteractionStatusApproved
+	interactions, err := repo.FindByApproverID(ctx, approverID, domain.InteractionQuery{Status: &approved})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(interactions) != 0 {
+		t.Errorf("status filter returned %d pending interactions", len(interactions))
+	}
+}
+
+func TestInteractionRepositoryFindByMetadata(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	saveUsers(t, db, requesterID, approverID)
+	repo := infrastructure.NewInteractionRepository(db)
+
+	now := time.Now().Truncate(time.Second)
+	saveInteraction(t, repo, interactionID(1), domain.InteractionStatusPending, map[string]interface{}{"location": "Tokyo", "guests": 2}, now)
+	saveInteraction(t, repo, interactionID(2), domain.InteractionStatusPending, map[string]interface{}{"location": "Osaka"}, now)
+	saveInteraction(t, repo, interactionID(3), domain.InteractionStatusPending, map[string]interface{}{"venue.name": "Cafe", "venue": map[string]interface{}{"name": "Bar"}}, now)
+	saveInteraction(t, repo, interactionID(4), domain.InteractionStatusPending, nil, now)
+
+	tests := []struct {
+		name  string
+		key   string
+		value interface{}
+		want  []string
+	}{
+		{"string value", "location", "Tokyo", []string{interactionID(1)}},
+		{"number value", "guests", 2, []string{interactionID(1)}},
+		{"no match", "location", "Kyoto", nil},
+		{"key with a dot is not a path", "venue.name", "Cafe", []string{interactionID(3)}},
+		{"nested value is not top-level", "venue.name", "Bar", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactions, err := repo.FindByMetadata(ctx, tt.key, tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, i := range interactions {
+				got = append(got, i.ID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("FindByMetadata(%q, %v) = %v, want %v", tt.key, tt.value, got, tt.want)
+			}
+		})
+	}
+}
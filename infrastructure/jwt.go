@@ -0,0 +1,72 @@
+package infrastructure
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// jwtHeader is the JOSE header of a compact JWS.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// jwt is a compact JWS split into its parts. Nothing in it is trusted until
+// the signature over SigningInput has been checked.
+type jwt struct {
+	Header       jwtHeader
+	Payload      []byte
+	SigningInput string
+	Signature    []byte
+}
+
+var errMalformedJWT = errors.New("malformed JWT")
+
+// parseJWT splits a compact JWS and decodes its header.
+func parseJWT(token string) (*jwt, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errMalformedJWT
+	}
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errMalformedJWT
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errMalformedJWT
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errMalformedJWT
+	}
+	var header jwtHeader
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, errMalformedJWT
+	}
+	return &jwt{
+		Header:       header,
+		Payload:      payload,
+		SigningInput: parts[0] + "." + parts[1],
+		Signature:    signature,
+	}, nil
+}
+
+// encodeJWT builds a compact JWS from a header and claims, signing it with sign.
+func encodeJWT(header jwtHeader, claims interface{}, sign func(signingInput []byte) []byte) (string, error) {
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode JWT header: %w", err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode JWT claims: %w", err)
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	signature := sign([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
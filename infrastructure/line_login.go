@@ -0,0 +1,333 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// LINE Login endpoints and limits.
+const (
+	// DefaultLineLoginJWKSURL is where LINE publishes the keys ID tokens are signed with.
+	DefaultLineLoginJWKSURL = "https://api.line.me/oauth2/v2.1/certs"
+	// DefaultLineAPIURL is the base URL of the Messaging API and of the access
+	// token verify and profile endpoints.
+	DefaultLineAPIURL = "https://api.line.me"
+	// lineLoginIssuer is the iss of every LINE Login ID token.
+	lineLoginIssuer = "https://access.line.me"
+	// lineLoginClockSkew is how far our clock may be behind LINE's.
+	lineLoginClockSkew = time.Minute
+	// jwksMinRefresh limits how often an unknown key ID makes us refetch the
+	// key set, so garbage tokens cannot be used to hammer LINE.
+	jwksMinRefresh = time.Minute
+)
+
+// LineLoginConfig holds how LINE Login tokens are checked.
+type LineLoginConfig struct {
+	// ChannelID is the LINE Login channel ID; tokens must be issued for it.
+	ChannelID string
+	// APIURL is the base URL access tokens are verified against.
+	APIURL string
+	// JWKSURL is where the signing keys are fetched from.
+	JWKSURL string
+	// JWKSCacheTTL is how long fetched keys are used before fetching them again.
+	JWKSCacheTTL time.Duration
+}
+
+// lineIDTokenClaims is the part of a LINE Login ID token payload we use.
+type lineIDTokenClaims struct {
+	Issuer   string `json:"iss"`
+	Subject  string `json:"sub"`
+	Audience string `json:"aud"`
+	Expiry   int64  `json:"exp"`
+	IssuedAt int64  `json:"iat"`
+	Nonce    string `json:"nonce"`
+}
+
+// LineLoginVerifier is the LINE Platform implementation of repository.LineLoginVerifier.
+// ID tokens are checked locally against LINE's published keys; access tokens
+// can only be checked by asking LINE.
+type LineLoginVerifier struct {
+	cfg    LineLoginConfig
+	client *http.Client
+	logger *slog.Logger
+	now    func() time.Time
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewLineLoginVerifier creates a new LineLoginVerifier that calls LINE
+// through transport, or http.DefaultTransport if it is nil.
+func NewLineLoginVerifier(cfg LineLoginConfig, transport http.RoundTripper, logger *slog.Logger) repository.LineLoginVerifier {
+	return &LineLoginVerifier{
+		cfg:    cfg,
+		client: &http.Client{Transport: transport, Timeout: 10 * time.Second},
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// VerifyIDToken checks the token against LINE's published keys.
+func (v *LineLoginVerifier) VerifyIDToken(ctx context.Context, idToken string) (*domain.LineIdentity, error) {
+	parsed, err := parseJWT(idToken)
+	if err != nil {
+		return nil, domain.Errorf(domain.ErrUnauthenticated, "%w", err)
+	}
+	key, err := v.key(ctx, parsed.Header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyJWTSignature(parsed, key); err != nil {
+		return nil, domain.Errorf(domain.ErrUnauthenticated, "%w", err)
+	}
+
+	var claims lineIDTokenClaims
+	if err := json.Unmarshal(parsed.Payload, &claims); err != nil {
+		return nil, domain.Errorf(domain.ErrUnauthenticated, "malformed ID token claims: %w", err)
+	}
+	switch {
+	case claims.Issuer != lineLoginIssuer:
+		return nil, domain.Errorf(domain.ErrUnauthenticated, "ID token issued by %q", claims.Issuer)
+	case v.cfg.ChannelID == "" || claims.Audience != v.cfg.ChannelID:
+		return nil, domain.NewError(domain.ErrUnauthenticated, "ID token was issued for another channel")
+	case claims.Subject == "":
+		return nil, domain.NewError(domain.ErrUnauthenticated, "ID token has no subject")
+	case !v.now().Add(-lineLoginClockSkew).Before(time.Unix(claims.Expiry, 0)):
+		return nil, domain.NewError(domain.ErrUnauthenticated, "ID token has expired")
+	}
+	return &domain.LineIdentity{LineUserID: claims.Subject, Nonce: claims.Nonce}, nil
+}
+
+// VerifyAccessToken checks the token with LINE's verify endpoint and reads
+// the user ID from the profile it grants access to.
+func (v *LineLoginVerifier) VerifyAccessToken(ctx context.Context, accessToken string) (*domain.LineIdentity, error) {
+	// 1. Ask LINE whether the token is live and whose channel it belongs to
+	var verified struct {
+		ClientID  string `json:"client_id"`
+		ExpiresIn int64  `json:"expires_in"`
+	}
+	query := url.Values{"access_token": {accessToken}}
+	status, err := v.getJSON(ctx, "/oauth2/v2.1/verify?"+query.Encode(), "", &verified)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify access token: %w", err)
+	}
+	switch {
+	case status == http.StatusBadRequest || status == http.StatusUnauthorized:
+		return nil, domain.NewError(domain.ErrUnauthenticated, "access token is invalid or expired")
+	case status != http.StatusOK:
+		return nil, fmt.Errorf("failed to verify access token: LINE answered %d", status)
+	case v.cfg.ChannelID == "" || verified.ClientID != v.cfg.ChannelID:
+		return nil, domain.NewError(domain.ErrUnauthenticated, "access token was issued for another channel")
+	case verified.ExpiresIn <= 0:
+		return nil, domain.NewError(domain.ErrUnauthenticated, "access token has expired")
+	}
+
+	// 2. The verify answer does not say whose token it is; the profile does
+	var profile struct {
+		UserID string `json:"userId"`
+	}
+	status, err = v.getJSON(ctx, "/v2/profile", accessToken, &profile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile for access token: %w", err)
+	}
+	switch {
+	case status == http.StatusUnauthorized:
+		return nil, domain.NewError(domain.ErrUnauthenticated, "access token was revoked")
+	case status != http.StatusOK:
+		return nil, fmt.Errorf("failed to get profile for access token: LINE answered %d", status)
+	case profile.UserID == "":
+		return nil, errors.New("LINE profile has no user ID")
+	}
+	return &domain.LineIdentity{LineUserID: profile.UserID}, nil
+}
+
+// getJSON calls a LINE API endpoint and decodes a 200 answer into v.
+// Other statuses are returned for the caller to interpret.
+func (v *LineLoginVerifier) getJSON(ctx context.Context, path, bearer string, out interface{}) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(v.cfg.APIURL, "/")+path, nil)
+	if err != nil {
+		return 0, err
+	}
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return 0, fmt.Errorf("failed to decode answer: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+// key returns the public key with the given ID, fetching the key set when the
+// cache is stale or does not know the ID.
+func (v *LineLoginVerifier) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.now()
+	stale := v.keys == nil || now.Sub(v.fetchedAt) >= v.cfg.JWKSCacheTTL
+	key, ok := v.keys[kid]
+	if !stale && (ok || now.Sub(v.fetchedAt) < jwksMinRefresh) {
+		if !ok {
+			return nil, domain.Errorf(domain.ErrUnauthenticated, "ID token signed with unknown key %q", kid)
+		}
+		return key, nil
+	}
+
+	keys, err := v.fetchKeys(ctx)
+	if err != nil {
+		if ok {
+			// LINE being unreachable should not sign everyone out while
+			// the key we already have still works.
+			v.logger.WarnContext(ctx, "failed to refresh LINE Login keys, using cached keys", "error", err)
+			return key, nil
+		}
+		return nil, err
+	}
+	v.keys, v.fetchedAt = keys, now
+	if key, ok = keys[kid]; !ok {
+		return nil, domain.Errorf(domain.ErrUnauthenticated, "ID token signed with unknown key %q", kid)
+	}
+	return key, nil
+}
+
+// jsonWebKey is one entry of a JWK Set. Only EC P-256 and RSA keys are used.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// fetchKeys downloads the JWK Set, skipping keys it cannot use.
+func (v *LineLoginVerifier) fetchKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.cfg.JWKSURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build JWKS request: %w", err)
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: %s", resp.Status)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			v.logger.WarnContext(ctx, "skipping JWKS key", "kid", jwk.Kid, "error", err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+// publicKey decodes the key material of a JWK.
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y: %w", err)
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return key, nil
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid n: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid e: %w", err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+			return nil, errors.New("exponent is too large")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// verifyJWTSignature checks an ES256 or RS256 signature. The algorithm must
+// match the key type, so a token cannot choose how it is checked.
+func verifyJWTSignature(token *jwt, key crypto.PublicKey) error {
+	digest := sha256.Sum256([]byte(token.SigningInput))
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		if token.Header.Alg != "ES256" || len(token.Signature) != 64 {
+			return fmt.Errorf("unexpected %s signature for an EC key", token.Header.Alg)
+		}
+		r := new(big.Int).SetBytes(token.Signature[:32])
+		s := new(big.Int).SetBytes(token.Signature[32:])
+		if !ecdsa.Verify(key, digest[:], r, s) {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	case *rsa.PublicKey:
+		if token.Header.Alg != "RS256" {
+			return fmt.Errorf("unexpected %s signature for an RSA key", token.Header.Alg)
+		}
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], token.Signature); err != nil {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported key %T", key)
+	}
+}
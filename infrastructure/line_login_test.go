@@ -0,0 +1,264 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+const testChannelID = "1234567890"
+
+// testJWKS serves a JWK Set whose keys the test can change, counting fetches.
+type testJWKS struct {
+	mu      sync.Mutex
+	keys    map[string]*ecdsa.PrivateKey
+	fetches int
+}
+
+func (s *testJWKS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fetches++
+	set := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+	for kid, key := range s.keys {
+		set.Keys = append(set.Keys, ecJWK(kid, &key.PublicKey))
+	}
+	json.NewEncoder(w).Encode(set)
+}
+
+func (s *testJWKS) add(kid string, key *ecdsa.PrivateKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[kid] = key
+}
+
+func ecJWK(kid string, key *ecdsa.PublicKey) jsonWebKey {
+	return jsonWebKey{
+		Kty: "EC",
+		Kid: kid,
+		Use: "sig",
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		Y:   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+func generateES256Key(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// signES256 signs claims with key as LINE would.
+func signES256(t *testing.T, kid string, key *ecdsa.PrivateKey, claims lineIDTokenClaims) string {
+	t.Helper()
+	token, err := encodeJWT(jwtHeader{Alg: "ES256", Kid: kid, Typ: "JWT"}, claims, func(signingInput []byte) []byte {
+		digest := sha256.Sum256(signingInput)
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+type lineLoginTest struct {
+	jwks     *testJWKS
+	verifier *LineLoginVerifier
+	now      time.Time
+}
+
+func newLineLoginTest(t *testing.T, kid string, key *ecdsa.PrivateKey) *lineLoginTest {
+	t.Helper()
+	lt := &lineLoginTest{
+		jwks: &testJWKS{keys: map[string]*ecdsa.PrivateKey{kid: key}},
+		now:  time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	server := httptest.NewServer(lt.jwks)
+	t.Cleanup(server.Close)
+	lt.verifier = NewLineLoginVerifier(LineLoginConfig{
+		ChannelID:    testChannelID,
+		JWKSURL:      server.URL,
+		JWKSCacheTTL: time.Hour,
+	}, nil, slog.New(slog.NewTextHandler(io.Discard, nil))).(*LineLoginVerifier)
+	lt.verifier.now = func() time.Time { return lt.now }
+	return lt
+}
+
+// claims are valid claims for a token issued at the test's current time.
+func (lt *lineLoginTest) claims() lineIDTokenClaims {
+	return lineIDTokenClaims{
+		Issuer:   lineLoginIssuer,
+		Subject:  "U4af4980629",
+		Audience: testChannelID,
+		IssuedAt: lt.now.Unix(),
+		Expiry:   lt.now.Add(time.Hour).Unix(),
+		Nonce:    "n-0S6_WzA2Mj",
+	}
+}
+
+func TestLineLoginVerifyIDToken(t *testing.T) {
+	key := generateES256Key(t)
+	lt := newLineLoginTest(t, "k1", key)
+
+	identity, err := lt.verifier.VerifyIDToken(context.Background(), signES256(t, "k1", key, lt.claims()))
+	if err != nil {
+		t.Fatalf("VerifyIDToken: %v", err)
+	}
+	if identity.LineUserID != "U4af4980629" || identity.Nonce != "n-0S6_WzA2Mj" {
+		t.Errorf("identity = %+v", identity)
+	}
+}
+
+func TestLineLoginRejectsIDToken(t *testing.T) {
+	key := generateES256Key(t)
+	lt := newLineLoginTest(t, "k1", key)
+	claims := func(edit func(*lineIDTokenClaims)) lineIDTokenClaims {
+		c := lt.claims()
+		edit(&c)
+		return c
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"other channel", signES256(t, "k1", key, claims(func(c *lineIDTokenClaims) { c.Audience = "9876543210" }))},
+		{"other issuer", signES256(t, "k1", key, claims(func(c *lineIDTokenClaims) { c.Issuer = "https://evil.example" }))},
+		{"expired", signES256(t, "k1", key, claims(func(c *lineIDTokenClaims) { c.Expiry = lt.now.Add(-2 * lineLoginClockSkew).Unix() }))},
+		{"no subject", signES256(t, "k1", key, claims(func(c *lineIDTokenClaims) { c.Subject = "" }))},
+		{"signed with another key", signES256(t, "k1", generateES256Key(t), lt.claims())},
+		{"malformed", "not.a.jwt"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := lt.verifier.VerifyIDToken(context.Background(), tt.token)
+			if !errors.Is(err, domain.ErrUnauthenticated) {
+				t.Errorf("err = %v, want ErrUnauthenticated", err)
+			}
+		})
+	}
+}
+
+func TestLineLoginAcceptsTokenWithinClockSkew(t *testing.T) {
+	key := generateES256Key(t)
+	lt := newLineLoginTest(t, "k1", key)
+	claims := lt.claims()
+	claims.Expiry = lt.now.Add(-lineLoginClockSkew / 2).Unix()
+
+	if _, err := lt.verifier.VerifyIDToken(context.Background(), signES256(t, "k1", key, claims)); err != nil {
+		t.Errorf("VerifyIDToken: %v", err)
+	}
+}
+
+func TestLineLoginRejectsAlgorithmConfusion(t *testing.T) {
+	key := generateES256Key(t)
+	lt := newLineLoginTest(t, "k1", key)
+
+	// An attacker who knows the public key signs with HS256, using the key
+	// itself as the HMAC secret, hoping it is checked as a shared secret.
+	jwk := ecJWK("k1", &key.PublicKey)
+	secrets := map[string][]byte{
+		"JWK":          mustJSON(t, jwk),
+		"raw point":    elliptic.Marshal(elliptic.P256(), key.X, key.Y),
+		"x coordinate": key.X.Bytes(),
+	}
+	for name, secret := range secrets {
+		t.Run(name, func(t *testing.T) {
+			token, err := encodeJWT(jwtHeader{Alg: "HS256", Kid: "k1"}, lt.claims(), func(signingInput []byte) []byte {
+				mac := hmac.New(sha256.New, secret)
+				mac.Write(signingInput)
+				return mac.Sum(nil)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := lt.verifier.VerifyIDToken(context.Background(), token); !errors.Is(err, domain.ErrUnauthenticated) {
+				t.Errorf("HS256 token err = %v, want ErrUnauthenticated", err)
+			}
+		})
+	}
+
+	// Nor may a token name an algorithm other than the key's own.
+	valid := signES256(t, "k1", key, lt.claims())
+	parsed, err := parseJWT(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed.Header.Alg = "RS256"
+	if err := verifyJWTSignature(parsed, &key.PublicKey); err == nil {
+		t.Error("RS256 header accepted for an EC key")
+	}
+}
+
+func TestLineLoginRefetchesKeysForUnknownKid(t *testing.T) {
+	ctx := context.Background()
+	oldKey, newKey := generateES256Key(t), generateES256Key(t)
+	lt := newLineLoginTest(t, "old", oldKey)
+
+	if _, err := lt.verifier.VerifyIDToken(ctx, signES256(t, "old", oldKey, lt.claims())); err != nil {
+		t.Fatalf("VerifyIDToken with the old key: %v", err)
+	}
+
+	// LINE rotates its keys while the cached set is still fresh.
+	lt.jwks.add("new", newKey)
+	rotated := signES256(t, "new", newKey, lt.claims())
+
+	// Right after a fetch, an unknown kid does not fetch again, so garbage
+	// tokens cannot be used to hammer LINE.
+	if _, err := lt.verifier.VerifyIDToken(ctx, rotated); !errors.Is(err, domain.ErrUnauthenticated) {
+		t.Fatalf("err = %v, want ErrUnauthenticated before the refetch interval", err)
+	}
+	if lt.jwks.fetches != 1 {
+		t.Fatalf("fetches = %d, want 1", lt.jwks.fetches)
+	}
+
+	lt.now = lt.now.Add(jwksMinRefresh)
+	if _, err := lt.verifier.VerifyIDToken(ctx, signES256(t, "new", newKey, lt.claims())); err != nil {
+		t.Fatalf("VerifyIDToken with the rotated key: %v", err)
+	}
+	if lt.jwks.fetches != 2 {
+		t.Errorf("fetches = %d, want 2", lt.jwks.fetches)
+	}
+
+	// Known keys are served from the cache.
+	if _, err := lt.verifier.VerifyIDToken(ctx, signES256(t, "old", oldKey, lt.claims())); err != nil {
+		t.Fatalf("VerifyIDToken with the old key again: %v", err)
+	}
+	if lt.jwks.fetches != 2 {
+		t.Errorf("fetches = %d after a cached key, want 2", lt.jwks.fetches)
+	}
+}
+
+func mustJSON(t *testing.T, v interface{}) []byte {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
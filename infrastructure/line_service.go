@@ -2,8 +2,16 @@ package infrastructure
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/logging"
 	"github.com/dkpcb/pet/repository"
 )
 
@@ -11,12 +19,19 @@ import (
 // This implementation would use the LINE Messaging API SDK.
 type LineService struct {
 	channelAccessToken string
+	apiURL             string
+	client             *http.Client
+	logger             *slog.Logger
 }
 
-// NewLineService creates a new LineService.
-func NewLineService(channelAccessToken string) repository.LineService {
+// NewLineService creates a new LineService that calls the Messaging API at
+// apiURL through transport, or http.DefaultTransport if it is nil.
+func NewLineService(channelAccessToken, apiURL string, transport http.RoundTripper, logger *slog.Logger) repository.LineService {
 	return &LineService{
 		channelAccessToken: channelAccessToken,
+		apiURL:             apiURL,
+		client:             &http.Client{Transport: transport, Timeout: 10 * time.Second},
+		logger:             logger,
 	}
 }
 
@@ -30,7 +45,7 @@ func (s *LineService) SendMessage(ctx context.Context, userID string, message st
 	// return err
 
 	// Placeholder implementation
-	fmt.Printf("LINE: Sending message to %s: %s\n", userID, message)
+	s.logger.InfoContext(ctx, "LINE: sending message", slog.String("to", userID), slog.String(logging.KeyMessageText, message))
 	return nil
 }
 
@@ -40,6 +55,80 @@ func (s *LineService) SendFlexMessage(ctx context.Context, userID string, flexMe
 	// For now, this is a placeholder
 
 	// Placeholder implementation
-	fmt.Printf("LINE: Sending flex message to %s: %s\n", userID, flexMessage)
+	s.logger.InfoContext(ctx, "LINE: sending flex message", slog.String("to", userID), slog.String(logging.KeyMessageText, flexMessage))
 	return nil
 }
+
+// ReplyMessage answers a webhook event with a text message.
+func (s *LineService) ReplyMessage(ctx context.Context, replyToken string, message string) error {
+	// TODO: Implement actual LINE Messaging API call
+	// Example:
+	// _, err := client.ReplyMessage(replyToken, linebot.NewTextMessage(message)).Do()
+	// return err
+
+	// Placeholder implementation
+	s.logger.InfoContext(ctx, "LINE: replying", slog.String(logging.KeyReplyToken, replyToken), slog.String(logging.KeyMessageText, message))
+	return nil
+}
+
+// GetProfile retrieves the LINE profile of a user who added the bot.
+func (s *LineService) GetProfile(ctx context.Context, userID string) (*domain.LineProfile, error) {
+	var profile struct {
+		DisplayName string `json:"displayName"`
+		Language    string `json:"language"`
+	}
+	status, err := s.getJSON(ctx, "/v2/bot/profile/"+url.PathEscape(userID), &profile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	switch {
+	case status == http.StatusNotFound:
+		return nil, domain.Errorf(domain.ErrNotFound, "LINE user %s has not added the bot", userID)
+	case status != http.StatusOK:
+		return nil, fmt.Errorf("failed to get profile: LINE answered %d", status)
+	}
+	return &domain.LineProfile{DisplayName: profile.DisplayName, Language: profile.Language}, nil
+}
+
+// VerifyCredentials checks that LINE accepts the channel access token by
+// asking for the bot's own profile. A missing or rejected token is reported
+// as domain.ErrUnauthenticated; other errors may pass on their own.
+func (s *LineService) VerifyCredentials(ctx context.Context) error {
+	if s.channelAccessToken == "" {
+		return domain.NewError(domain.ErrUnauthenticated, "no channel access token configured")
+	}
+	status, err := s.getJSON(ctx, "/v2/bot/info", nil)
+	if err != nil {
+		return fmt.Errorf("failed to reach LINE: %w", err)
+	}
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return domain.Errorf(domain.ErrUnauthenticated, "LINE rejected the channel access token (%d)", status)
+	case status != http.StatusOK:
+		return fmt.Errorf("failed to get bot info: LINE answered %d", status)
+	}
+	return nil
+}
+
+// getJSON calls a Messaging API endpoint with the channel access token and
+// decodes a 200 answer into out, unless out is nil. Other statuses are
+// returned for the caller to interpret.
+func (s *LineService) getJSON(ctx context.Context, path string, out interface{}) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(s.apiURL, "/")+path, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.channelAccessToken)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || out == nil {
+		return resp.StatusCode, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return 0, fmt.Errorf("failed to decode answer: %w", err)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,108 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+)
+
+func TestLineServiceVerifyCredentials(t *testing.T) {
+	const token = "channel-token"
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name       string
+		token      string
+		status     int
+		wantErr    bool
+		wantUnauth bool
+	}{
+		{"accepted", token, http.StatusOK, false, false},
+		{"not configured", "", http.StatusOK, true, true},
+		{"revoked", token, http.StatusUnauthorized, true, true},
+		{"forbidden", token, http.StatusForbidden, true, true},
+		{"LINE unavailable", token, http.StatusServiceUnavailable, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.URL.Path != "/v2/bot/info" {
+					t.Errorf("path = %s, want /v2/bot/info", r.URL.Path)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer "+token {
+					t.Errorf("Authorization = %q", got)
+				}
+				w.WriteHeader(tt.status)
+				io.WriteString(w, `{"userId":"Ubot","basicId":"@bot","displayName":"Bot"}`)
+			}))
+			defer server.Close()
+
+			service := infrastructure.NewLineService(tt.token, server.URL, nil, logger)
+			err := service.VerifyCredentials(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if got := errors.Is(err, domain.ErrUnauthenticated); got != tt.wantUnauth {
+				t.Errorf("errors.Is(err, ErrUnauthenticated) = %v, want %v (err %v)", got, tt.wantUnauth, err)
+			}
+			if tt.token == "" && calls != 0 {
+				t.Error("LINE was asked about a missing token")
+			}
+		})
+	}
+}
+
+func TestLineServiceGetProfile(t *testing.T) {
+	const token = "channel-token"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer "+token {
+			t.Errorf("Authorization = %q", got)
+		}
+		switch r.URL.Path {
+		case "/v2/bot/profile/U0001":
+			io.WriteString(w, `{"userId":"U0001","displayName":"Ada","pictureUrl":"https://example.com/a.png","language":"en"}`)
+		case "/v2/bot/profile/U0002":
+			io.WriteString(w, `{"userId":"U0002","displayName":"Grace"}`)
+		case "/v2/bot/profile/U0404":
+			http.Error(w, `{"message":"Not found"}`, http.StatusNotFound)
+		default:
+			http.Error(w, `{"message":"Internal error"}`, http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+	service := infrastructure.NewLineService(token, server.URL, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := context.Background()
+
+	profile, err := service.GetProfile(ctx, "U0001")
+	if err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+	if *profile != (domain.LineProfile{DisplayName: "Ada", Language: "en"}) {
+		t.Errorf("profile = %+v", profile)
+	}
+
+	// Users who do not share their language have none in the profile.
+	profile, err = service.GetProfile(ctx, "U0002")
+	if err != nil {
+		t.Fatalf("GetProfile without language: %v", err)
+	}
+	if profile.Language != "" || profile.DisplayName != "Grace" {
+		t.Errorf("profile = %+v", profile)
+	}
+
+	if _, err := service.GetProfile(ctx, "U0404"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("unknown user err = %v, want ErrNotFound", err)
+	}
+	if _, err := service.GetProfile(ctx, "U0500"); err == nil || errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("LINE failure err = %v, want an internal error", err)
+	}
+}
@@ -0,0 +1,111 @@
+package infrastructure
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// maxRecordLine bounds one record when reading a recording back; LINE
+// webhook bodies are far smaller.
+const maxRecordLine = 4 << 20
+
+// lineWebhookRecordLine is one line of a recording file.
+type lineWebhookRecordLine struct {
+	ReceivedAt time.Time       `json:"receivedAt"`
+	Signature  string          `json:"signature,omitempty"`
+	Body       json.RawMessage `json:"body"`
+}
+
+// LineWebhookRecordRepository is the file implementation of
+// repository.LineWebhookRecordRepository. Records are appended to a JSON
+// Lines file, so a recording can be read, trimmed or checked in as a test
+// fixture with ordinary tools.
+type LineWebhookRecordRepository struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewLineWebhookRecordRepository creates a repository keeping records in
+// the file at path. The file is created on the first Save.
+func NewLineWebhookRecordRepository(path string) repository.LineWebhookRecordRepository {
+	return &LineWebhookRecordRepository{path: path}
+}
+
+// Save appends a record to the file.
+func (r *LineWebhookRecordRepository) Save(ctx context.Context, record *domain.LineWebhookRecord) error {
+	// Message text is kept as sent rather than HTML-escaped. Encode ends
+	// the line with a newline.
+	var line bytes.Buffer
+	enc := json.NewEncoder(&line)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(lineWebhookRecordLine{
+		ReceivedAt: record.ReceivedAt,
+		Signature:  record.Signature,
+		Body:       record.Body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook record: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Recordings hold what users wrote, so only the server's user may read them.
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open webhook recording: %w", err)
+	}
+	if _, err := f.Write(line.Bytes()); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to save webhook record: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to save webhook record: %w", err)
+	}
+	return nil
+}
+
+// List reads every record from the file. A missing file has no records.
+func (r *LineWebhookRecordRepository) List(ctx context.Context) ([]*domain.LineWebhookRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, err := os.Open(r.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open webhook recording: %w", err)
+	}
+	defer f.Close()
+
+	var records []*domain.LineWebhookRecord
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxRecordLine)
+	for n := 1; scanner.Scan(); n++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var line lineWebhookRecordLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return nil, fmt.Errorf("invalid webhook record on line %d: %w", n, err)
+		}
+		records = append(records, &domain.LineWebhookRecord{
+			ReceivedAt: line.ReceivedAt,
+			Signature:  line.Signature,
+			Body:       line.Body,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read webhook recording: %w", err)
+	}
+	return records, nil
+}
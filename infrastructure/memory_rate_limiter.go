@@ -0,0 +1,67 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// rateLimitSweepInterval is how often a rate limiter discards the buckets
+// that have refilled. A full bucket is the same as a missing one.
+const rateLimitSweepInterval = time.Minute
+
+// MemoryRateLimiter keeps token buckets in process memory. Limits are per
+// process, so with several replicas each enforces its own; use
+// NewSQLRateLimiter to share buckets. State is lost when the process exits.
+type MemoryRateLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// memoryBucket is a token bucket and the limit it was last taken from under.
+type memoryBucket struct {
+	bucket *domain.TokenBucket
+	limit  domain.RateLimit
+}
+
+// NewMemoryRateLimiter creates a MemoryRateLimiter with no buckets.
+func NewMemoryRateLimiter() *MemoryRateLimiter {
+	return &MemoryRateLimiter{buckets: make(map[string]*memoryBucket), now: time.Now}
+}
+
+var _ repository.RateLimiter = (*MemoryRateLimiter)(nil)
+
+// Take removes a token from the bucket for key.
+func (l *MemoryRateLimiter) Take(ctx context.Context, key string, limit domain.RateLimit) (bool, time.Duration, error) {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Sub(l.lastSweep) >= rateLimitSweepInterval {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &memoryBucket{bucket: domain.NewTokenBucket(limit, now)}
+		l.buckets[key] = b
+	}
+	b.limit = limit
+	allowed, retryAfter := b.bucket.Take(limit, now)
+	return allowed, retryAfter, nil
+}
+
+// sweep discards the buckets that are full at now. The caller holds l.mu.
+func (l *MemoryRateLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if fullAt, ok := b.bucket.FullAt(b.limit); ok && !fullAt.After(now) {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
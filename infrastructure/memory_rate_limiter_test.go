@@ -0,0 +1,42 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+func TestMemoryRateLimiterSweepsFullBuckets(t *testing.T) {
+	ctx := context.Background()
+	limit := domain.RateLimit{Burst: 2, Refill: time.Minute}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewMemoryRateLimiter()
+	limiter.now = func() time.Time { return now }
+
+	// Many one-off callers each leave a bucket behind.
+	for i := 0; i < 100; i++ {
+		if allowed, _, err := limiter.Take(ctx, fmt.Sprintf("caller:%d", i), limit); err != nil || !allowed {
+			t.Fatalf("Take = %v, %v", allowed, err)
+		}
+	}
+	// One caller is still limited.
+	limiter.Take(ctx, "busy", limit)
+	limiter.Take(ctx, "busy", limit)
+
+	// After a minute the one-off buckets are full again, the busy one is not.
+	now = now.Add(time.Minute)
+	if allowed, _, _ := limiter.Take(ctx, "busy", limit); !allowed {
+		t.Fatal("busy caller refused after a refill")
+	}
+	if len(limiter.buckets) != 1 {
+		t.Fatalf("%d buckets held, want only the busy one", len(limiter.buckets))
+	}
+
+	// The swept busy bucket would have refused; keeping it keeps the limit.
+	if allowed, _, _ := limiter.Take(ctx, "busy", limit); allowed {
+		t.Error("busy caller allowed past the limit")
+	}
+}
@@ -0,0 +1,53 @@
+package infrastructure
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dkpcb/pet/metrics"
+)
+
+// metricsTransport records the latency and status code of every request
+// sent through it.
+type metricsTransport struct {
+	next      http.RoundTripper
+	durations *metrics.HistogramVec
+}
+
+// NewMetricsTransport wraps next so every request is recorded in durations,
+// which must have the labels "endpoint" (the URL path with IDs replaced by
+// placeholders, so neither tokens in the query nor user IDs become labels)
+// and "code" (the status code, or "error" when
+// no response arrived). A nil next means http.DefaultTransport.
+func NewMetricsTransport(next http.RoundTripper, durations *metrics.HistogramVec) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &metricsTransport{next: next, durations: durations}
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	t.durations.Observe(time.Since(start).Seconds(), endpointOf(req.URL.Path), code)
+	return resp, err
+}
+
+// idPathPrefixes are the LINE API paths that end in an ID.
+var idPathPrefixes = []string{"/v2/bot/profile/"}
+
+// endpointOf names the endpoint a request path belongs to.
+func endpointOf(path string) string {
+	for _, prefix := range idPathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return prefix + "{id}"
+		}
+	}
+	return path
+}
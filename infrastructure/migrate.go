@@ -0,0 +1,419 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dkpcb/pet/migrations"
+)
+
+// The migration lock is held while migrating so two instances starting at the
+// same time do not apply the same migration twice.
+const (
+	migrationLockKey  = 7_265_726_976 // arbitrary, stable across releases
+	migrationLockName = "traceriver_schema_migrations"
+	migrationLockWait = 60 // seconds
+)
+
+// Migration is a single versioned schema change.
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// MigrationStatus describes whether a known migration has been applied.
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+}
+
+// schemaMigration is the row recorded for every applied migration.
+type schemaMigration struct {
+	Version   int       `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"type:varchar(255);not null"`
+	Checksum  string    `gorm:"type:varchar(64);not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM.
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrator applies the embedded migrations for a connection's dialect and
+// records them in the schema_migrations table.
+type Migrator struct {
+	db         *gorm.DB
+	dialect    Dialect
+	migrations []Migration
+}
+
+// NewMigrator creates a Migrator for the dialect of db.
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	dialect := DialectOf(db)
+	fsys, err := migrations.FS(string(dialect))
+	if err != nil {
+		return nil, err
+	}
+	loaded, err := loadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: loaded}, nil
+}
+
+// Migrate applies all pending migrations.
+// It is a shorthand for local development and integration tests.
+func Migrate(ctx context.Context, db *gorm.DB) error {
+	m, err := NewMigrator(db)
+	if err != nil {
+		return err
+	}
+	_, err = m.Up(ctx)
+	return err
+}
+
+// LatestVersion returns the newest version shipped with the binary.
+func (m *Migrator) LatestVersion() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the newest applied version, or 0 when nothing is applied.
+// It does not take the migration lock so it can be polled while another
+// instance is migrating.
+func (m *Migrator) Version(ctx context.Context) (int, error) {
+	db := m.db.WithContext(ctx)
+	if !db.Migrator().HasTable(&schemaMigration{}) {
+		return 0, nil
+	}
+	var version int
+	if err := db.Model(&schemaMigration{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return version, nil
+}
+
+// Up applies every pending migration in version order and returns the ones applied.
+// It fails without applying anything if an applied migration was edited afterwards.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(applied); err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			if err := m.apply(conn, mig.Up, func(tx *gorm.DB) error {
+				return tx.Create(&schemaMigration{
+					Version:   mig.Version,
+					Name:      mig.Name,
+					Checksum:  mig.Checksum,
+					AppliedAt: time.Now(),
+				}).Error
+			}); err != nil {
+				return fmt.Errorf("failed to apply migration %03d_%s: %w", mig.Version, mig.Name, err)
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down reverts the newest steps applied migrations and returns the ones reverted.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(applied); err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+			if err := m.apply(conn, mig.Down, func(tx *gorm.DB) error {
+				return tx.Delete(&schemaMigration{}, "version = ?", mig.Version).Error
+			}); err != nil {
+				return fmt.Errorf("failed to revert migration %03d_%s: %w", mig.Version, mig.Name, err)
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Baseline records every migration up to and including version as applied
+// without running it, for a database whose schema was created by other means.
+// It returns the ones recorded; those already applied are left as they are.
+func (m *Migrator) Baseline(ctx context.Context, version int) ([]Migration, error) {
+	if !m.has(version) {
+		return nil, fmt.Errorf("no migration with version %03d", version)
+	}
+	var done []Migration
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(applied); err != nil {
+			return err
+		}
+		return conn.Transaction(func(tx *gorm.DB) error {
+			for _, mig := range m.migrations {
+				if mig.Version > version {
+					break
+				}
+				if _, ok := applied[mig.Version]; ok {
+					continue
+				}
+				if err := tx.Create(&schemaMigration{
+					Version:   mig.Version,
+					Name:      mig.Name,
+					Checksum:  mig.Checksum,
+					AppliedAt: time.Now(),
+				}).Error; err != nil {
+					return fmt.Errorf("failed to record migration %03d_%s: %w", mig.Version, mig.Name, err)
+				}
+				done = append(done, mig)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return done, nil
+}
+
+// has reports whether version is a known migration.
+func (m *Migrator) has(version int) bool {
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// Status lists every known migration with its applied state.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var result []MigrationStatus
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(applied); err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			status := MigrationStatus{Version: mig.Version, Name: mig.Name}
+			if row, ok := applied[mig.Version]; ok {
+				appliedAt := row.AppliedAt
+				status.Applied = true
+				status.AppliedAt = &appliedAt
+			}
+			result = append(result, status)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// withLock runs fn on a single pooled connection while holding the migration
+// lock. Session-level advisory locks belong to a connection, so every
+// statement must go through the same one.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := m.lock(conn); err != nil {
+			return err
+		}
+		defer m.unlock(conn)
+
+		if err := conn.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			checksum VARCHAR(64) NOT NULL,
+			applied_at TIMESTAMP NOT NULL
+		)`).Error; err != nil {
+			return fmt.Errorf("failed to create schema_migrations table: %w", err)
+		}
+		return fn(conn)
+	})
+}
+
+func (m *Migrator) lock(conn *gorm.DB) error {
+	switch m.dialect {
+	case DialectPostgres:
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", migrationLockKey).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+	case DialectMySQL:
+		var acquired int
+		if err := conn.Raw("SELECT GET_LOCK(?, ?)", migrationLockName, migrationLockWait).Scan(&acquired).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		if acquired != 1 {
+			return fmt.Errorf("timed out waiting for migration lock after %ds", migrationLockWait)
+		}
+	}
+	// SQLite has no advisory locks; each migration runs in a transaction
+	// together with its schema_migrations row, so a concurrent runner fails on
+	// the primary key instead of applying the migration twice.
+	return nil
+}
+
+func (m *Migrator) unlock(conn *gorm.DB) {
+	switch m.dialect {
+	case DialectPostgres:
+		conn.Exec("SELECT pg_advisory_unlock(?)", migrationLockKey)
+	case DialectMySQL:
+		conn.Exec("SELECT RELEASE_LOCK(?)", migrationLockName)
+	}
+}
+
+// applied returns the recorded migrations keyed by version.
+func (m *Migrator) applied(conn *gorm.DB) (map[int]schemaMigration, error) {
+	var rows []schemaMigration
+	if err := conn.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	result := make(map[int]schemaMigration, len(rows))
+	for _, row := range rows {
+		result[row.Version] = row
+	}
+	return result, nil
+}
+
+// verify detects applied migrations whose file was edited or removed since.
+func (m *Migrator) verify(applied map[int]schemaMigration) error {
+	known := make(map[int]Migration, len(m.migrations))
+	for _, mig := range m.migrations {
+		known[mig.Version] = mig
+	}
+	for version, row := range applied {
+		mig, ok := known[version]
+		if !ok {
+			return fmt.Errorf("applied migration %03d_%s is missing from this build", version, row.Name)
+		}
+		if mig.Checksum != row.Checksum {
+			return fmt.Errorf("migration %03d_%s was modified after it was applied (checksum %s, recorded %s)",
+				version, mig.Name, mig.Checksum, row.Checksum)
+		}
+	}
+	return nil
+}
+
+// apply runs the statements of sql and record in one transaction.
+// MySQL commits DDL implicitly, so there the transaction only covers record.
+func (m *Migrator) apply(conn *gorm.DB, sql string, record func(tx *gorm.DB) error) error {
+	return conn.Transaction(func(tx *gorm.DB) error {
+		for _, stmt := range splitStatements(sql) {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return err
+			}
+		}
+		return record(tx)
+	})
+}
+
+// loadMigrations pairs the up and down files in fsys and sorts them by version.
+func loadMigrations(fsys fs.FS) ([]Migration, error) {
+	names, err := fs.Glob(fsys, "*.up.sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	result := make([]Migration, 0, len(names))
+	for _, upName := range names {
+		base := strings.TrimSuffix(upName, ".up.sql")
+		prefix, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name: %s", upName)
+		}
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", upName, err)
+		}
+
+		up, err := fs.ReadFile(fsys, upName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", upName, err)
+		}
+		down, err := fs.ReadFile(fsys, base+".down.sql")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read down migration for %s: %w", upName, err)
+		}
+
+		sum := sha256.Sum256(up)
+		result = append(result, Migration{
+			Version:  version,
+			Name:     name,
+			Up:       string(up),
+			Down:     string(down),
+			Checksum: hex.EncodeToString(sum[:]),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	for i := 1; i < len(result); i++ {
+		if result[i].Version == result[i-1].Version {
+			return nil, fmt.Errorf("duplicate migration version %03d", result[i].Version)
+		}
+	}
+	return result, nil
+}
+
+// splitStatements splits a migration file into individual statements.
+// Drivers differ in whether they accept several statements per Exec
+// (MySQL needs multiStatements=true), so each one is sent separately.
+func splitStatements(sql string) []string {
+	var stmts []string
+	for _, part := range strings.Split(sql, ";") {
+		if isBlankSQL(part) {
+			continue
+		}
+		stmts = append(stmts, strings.TrimSpace(part))
+	}
+	return stmts
+}
+
+// isBlankSQL reports whether s contains only whitespace and line comments.
+func isBlankSQL(s string) bool {
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,144 @@
+package infrastructure_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dkpcb/pet/infrastructure"
+)
+
+func TestMigratorUpAndDown(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	m, err := infrastructure.NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	latest := m.LatestVersion()
+
+	// newTestDB already migrated; running Up again applies nothing.
+	applied, err := m.Up(ctx)
+	if err != nil || len(applied) != 0 {
+		t.Fatalf("second Up = %d migrations, %v; want none", len(applied), err)
+	}
+	if version, err := m.Version(ctx); err != nil || version != latest {
+		t.Fatalf("Version = %d, %v; want %d", version, err, latest)
+	}
+
+	reverted, err := m.Down(ctx, 2)
+	if err != nil {
+		t.Fatalf("Down(2): %v", err)
+	}
+	if len(reverted) != 2 || reverted[0].Version != latest || reverted[1].Version != latest-1 {
+		t.Fatalf("Down(2) reverted %+v, want the two newest", reverted)
+	}
+	if version, _ := m.Version(ctx); version != latest-2 {
+		t.Errorf("Version after Down(2) = %d, want %d", version, latest-2)
+	}
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range status {
+		if want := s.Version <= latest-2; s.Applied != want {
+			t.Errorf("migration %d applied = %v, want %v", s.Version, s.Applied, want)
+		}
+	}
+
+	// Every down migration reverts its up migration cleanly.
+	if _, err := m.Down(ctx, latest); err != nil {
+		t.Fatalf("Down(all): %v", err)
+	}
+	if version, _ := m.Version(ctx); version != 0 {
+		t.Errorf("Version after Down(all) = %d, want 0", version)
+	}
+	if db.Migrator().HasTable("users") {
+		t.Error("users table left after reverting every migration")
+	}
+	applied, err = m.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up after Down(all): %v", err)
+	}
+	if len(applied) != latest {
+		t.Errorf("Up after Down(all) applied %d migrations, want %d", len(applied), latest)
+	}
+}
+
+func TestMigratorRejectsModifiedMigration(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	m, err := infrastructure.NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pretend migration 1 was applied from a different file than the one shipped.
+	if err := db.Exec("UPDATE schema_migrations SET checksum = ? WHERE version = 1", strings.Repeat("0", 64)).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	for name, run := range map[string]func() error{
+		"Up":     func() error { _, err := m.Up(ctx); return err },
+		"Down":   func() error { _, err := m.Down(ctx, 1); return err },
+		"Status": func() error { _, err := m.Status(ctx); return err },
+	} {
+		err := run()
+		if err == nil || !strings.Contains(err.Error(), "was modified after it was applied") {
+			t.Errorf("%s err = %v, want checksum mismatch", name, err)
+		}
+	}
+	if version, _ := m.Version(ctx); version != m.LatestVersion() {
+		t.Errorf("Version = %d after a refused Down, want %d", version, m.LatestVersion())
+	}
+}
+
+func TestMigratorBaseline(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	m, err := infrastructure.NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	latest := m.LatestVersion()
+
+	// A database whose schema was created by hand at the previous version:
+	// the tables are there, but nothing is recorded.
+	if _, err := m.Down(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Exec("DELETE FROM schema_migrations").Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(ctx); err == nil {
+		t.Fatal("Up over an unrecorded schema succeeded")
+	}
+
+	if _, err := m.Baseline(ctx, latest+1); err == nil {
+		t.Error("Baseline to an unknown version succeeded")
+	}
+	recorded, err := m.Baseline(ctx, latest-1)
+	if err != nil {
+		t.Fatalf("Baseline: %v", err)
+	}
+	if len(recorded) != latest-1 {
+		t.Fatalf("Baseline recorded %d migrations, want %d", len(recorded), latest-1)
+	}
+	if version, _ := m.Version(ctx); version != latest-1 {
+		t.Errorf("Version after Baseline = %d, want %d", version, latest-1)
+	}
+
+	// Only the migration after the baseline is run.
+	applied, err := m.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up after Baseline: %v", err)
+	}
+	if len(applied) != 1 || applied[0].Version != latest {
+		t.Errorf("Up after Baseline applied %+v, want only %d", applied, latest)
+	}
+
+	// Recording again changes nothing.
+	if recorded, err := m.Baseline(ctx, latest); err != nil || len(recorded) != 0 {
+		t.Errorf("second Baseline = %d migrations, %v; want none", len(recorded), err)
+	}
+}
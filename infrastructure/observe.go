@@ -0,0 +1,56 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/dkpcb/pet/metrics"
+	"github.com/dkpcb/pet/tracing"
+)
+
+// Observer is told about each call made through an instrumented repository
+// or service. method names the call, such as "UserRepository.FindByID". The
+// returned context is used for the call and done is called with its result.
+type Observer func(ctx context.Context, method string) (_ context.Context, done func(err error))
+
+// ObserveDurations records how long each call took in durations, which must
+// have the labels "method" and "outcome" ("ok" or "error").
+func ObserveDurations(durations *metrics.HistogramVec) Observer {
+	return func(ctx context.Context, method string) (context.Context, func(error)) {
+		start := time.Now()
+		return ctx, func(err error) {
+			outcome := "ok"
+			if err != nil {
+				outcome = "error"
+			}
+			durations.Observe(time.Since(start).Seconds(), method, outcome)
+		}
+	}
+}
+
+// ObserveSpans starts a client span for each call, as a child of the span in
+// the call's context, and marks it failed when the call returns an error.
+func ObserveSpans() Observer {
+	return func(ctx context.Context, method string) (context.Context, func(error)) {
+		ctx, span := tracing.Start(ctx, method, tracing.KindClient)
+		return ctx, func(err error) {
+			span.SetError(err)
+			span.End()
+		}
+	}
+}
+
+// Observers combines observers into one that tells each of them, in order.
+func Observers(observers ...Observer) Observer {
+	return func(ctx context.Context, method string) (context.Context, func(error)) {
+		dones := make([]func(error), len(observers))
+		for i, observe := range observers {
+			ctx, dones[i] = observe(ctx, method)
+		}
+		return ctx, func(err error) {
+			for i := len(dones) - 1; i >= 0; i-- {
+				dones[i](err)
+			}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure/table"
+	"github.com/dkpcb/pet/repository"
+)
+
+// SQLRateLimiter keeps token buckets in the database, so every replica
+// sharing the database enforces the same limits.
+type SQLRateLimiter struct {
+	db *gorm.DB
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewSQLRateLimiter creates a new SQLRateLimiter.
+func NewSQLRateLimiter(db *gorm.DB) repository.RateLimiter {
+	return &SQLRateLimiter{db: db}
+}
+
+// Take removes a token from the bucket for key inside a transaction.
+func (l *SQLRateLimiter) Take(ctx context.Context, key string, limit domain.RateLimit) (bool, time.Duration, error) {
+	var (
+		allowed    bool
+		retryAfter time.Duration
+	)
+	now := time.Now()
+	if err := l.sweep(ctx, now); err != nil {
+		return false, 0, err
+	}
+
+	err := l.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Insert a full bucket first so SELECT ... FOR UPDATE always has a row to lock
+		fresh := table.FromDomainTokenBucket(key, domain.NewTokenBucket(limit, now), limit)
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(fresh).Error; err != nil {
+			return fmt.Errorf("failed to create rate limit bucket: %w", err)
+		}
+
+		query := tx.Where("bucket_key = ?", key)
+		if DialectOf(l.db) != DialectSQLite {
+			query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+		}
+		var row table.RateLimitBucket
+		if err := query.First(&row).Error; err != nil {
+			return fmt.Errorf("failed to lock rate limit bucket: %w", err)
+		}
+
+		bucket := row.ToDomain()
+		allowed, retryAfter = bucket.Take(limit, now)
+		if err := tx.Save(table.FromDomainTokenBucket(key, bucket, limit)).Error; err != nil {
+			return fmt.Errorf("failed to save rate limit bucket: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, 0, err
+	}
+	return allowed, retryAfter, nil
+}
+
+// sweep deletes the buckets that are full at now, at most once per
+// rateLimitSweepInterval in this process.
+func (l *SQLRateLimiter) sweep(ctx context.Context, now time.Time) error {
+	l.mu.Lock()
+	due := now.Sub(l.lastSweep) >= rateLimitSweepInterval
+	if due {
+		l.lastSweep = now
+	}
+	l.mu.Unlock()
+	if !due {
+		return nil
+	}
+
+	if err := l.db.WithContext(ctx).Where("full_at <= ?", now).Delete(&table.RateLimitBucket{}).Error; err != nil {
+		return fmt.Errorf("failed to delete full rate limit buckets: %w", err)
+	}
+	return nil
+}
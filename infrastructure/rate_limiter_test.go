@@ -0,0 +1,44 @@
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+	"github.com/dkpcb/pet/infrastructure/table"
+)
+
+func TestSQLRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	limiter := infrastructure.NewSQLRateLimiter(db)
+
+	limit := domain.RateLimit{Burst: 1, Refill: time.Hour}
+	if allowed, _, err := limiter.Take(ctx, "limited", limit); err != nil || !allowed {
+		t.Fatalf("first Take = %v, %v", allowed, err)
+	}
+	allowed, retryAfter, err := limiter.Take(ctx, "limited", limit)
+	if err != nil || allowed || retryAfter <= 59*time.Minute {
+		t.Fatalf("second Take = %v, %v, %v; want refused for about an hour", allowed, retryAfter, err)
+	}
+
+	// A bucket that refills at once is full, and so stale, right away.
+	if _, _, err := limiter.Take(ctx, "refilled", domain.RateLimit{Burst: 1, Refill: time.Nanosecond}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond)
+
+	// Another replica sweeps when it first takes a token.
+	if _, _, err := infrastructure.NewSQLRateLimiter(db).Take(ctx, "other", limit); err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	if err := db.Model(&table.RateLimitBucket{}).Order("bucket_key").Pluck("bucket_key", &keys).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0] != "limited" || keys[1] != "other" {
+		t.Errorf("buckets = %v, want [limited other]", keys)
+	}
+}
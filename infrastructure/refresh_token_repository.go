@@ -0,0 +1,68 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure/table"
+	"github.com/dkpcb/pet/repository"
+)
+
+// RefreshTokenRepository is the GORM implementation of repository.RefreshTokenRepository.
+type RefreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository creates a new RefreshTokenRepository.
+func NewRefreshTokenRepository(db *gorm.DB) repository.RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+// Save persists a new refresh token.
+func (r *RefreshTokenRepository) Save(ctx context.Context, token *domain.RefreshToken) error {
+	row := table.FromDomainRefreshToken(token)
+	if err := r.db.WithContext(ctx).Create(row).Error; err != nil {
+		return fmt.Errorf("failed to save refresh token: %w", err)
+	}
+	return nil
+}
+
+// FindByHash retrieves a refresh token by the hash of its value.
+func (r *RefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	var row table.RefreshToken
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&row).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find refresh token by hash: %w", err)
+	}
+	return row.ToDomain(), nil
+}
+
+// Revoke marks the token revoked unless it already is.
+func (r *RefreshTokenRepository) Revoke(ctx context.Context, id string, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&table.RefreshToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to revoke refresh token: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// RevokeByUserID revokes every token of the user that is not revoked yet.
+func (r *RefreshTokenRepository) RevokeByUserID(ctx context.Context, userID string, at time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&table.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", at).Error
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens of user: %w", err)
+	}
+	return nil
+}
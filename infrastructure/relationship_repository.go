@@ -0,0 +1,241 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure/table"
+	"github.com/dkpcb/pet/repository"
+)
+
+// RelationshipRepository is the GORM implementation of repository.RelationshipRepository.
+type RelationshipRepository struct {
+	db *gorm.DB
+}
+
+// NewRelationshipRepository creates a new RelationshipRepository.
+func NewRelationshipRepository(db *gorm.DB) repository.RelationshipRepository {
+	return &RelationshipRepository{db: db}
+}
+
+// FindBetween retrieves the relationship between two users, in either order.
+func (r *RelationshipRepository) FindBetween(ctx context.Context, userID, otherID string) (*domain.Relationship, error) {
+	a, b := domain.RelationshipPair(userID, otherID)
+
+	var row table.Relationship
+	if err := r.db.WithContext(ctx).Where("user_a_id = ? AND user_b_id = ?", a, b).First(&row).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find relationship: %w", err)
+	}
+	return row.ToDomain(), nil
+}
+
+// Modify applies fn to the relationship inside a transaction, if it still exists.
+func (r *RelationshipRepository) Modify(ctx context.Context, userID, otherID string, fn func(*domain.Relationship)) (bool, error) {
+	found := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		rel, err := lockExistingRelationship(tx, userID, otherID)
+		if err != nil || rel == nil {
+			return err
+		}
+		found = true
+		fn(rel)
+		return saveRelationship(tx, rel)
+	})
+	if err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+// errNoRoom aborts a Connect transaction, rolling back the row lockRelationship inserted.
+type errNoRoom struct {
+	userID string
+}
+
+func (e errNoRoom) Error() string {
+	return fmt.Sprintf("user %s has no room for another connection", e.userID)
+}
+
+// Connect applies fn like Modify once both users are known to have room.
+func (r *RelationshipRepository) Connect(ctx context.Context, userID, otherID string, policy domain.RelationshipPolicy, fn func(*domain.Relationship)) (string, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return connect(tx, userID, otherID, policy, fn)
+	})
+
+	var noRoom errNoRoom
+	if errors.As(err, &noRoom) {
+		return noRoom.userID, nil
+	}
+	return "", err
+}
+
+// connect does the work of Connect inside tx, so other repositories can
+// connect two users in the same transaction as their own changes. It
+// returns errNoRoom if either user is at capacity.
+func connect(tx *gorm.DB, userID, otherID string, policy domain.RelationshipPolicy, fn func(*domain.Relationship)) error {
+	// Lock both users in a fixed order, so concurrent connections to either
+	// of them wait for this one and cannot both take the last slot.
+	a, b := domain.RelationshipPair(userID, otherID)
+	if canLock(tx) {
+		var ids []string
+		err := tx.Model(&table.User{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", []string{a, b}).
+			Order("id").
+			Pluck("id", &ids).Error
+		if err != nil {
+			return fmt.Errorf("failed to lock users: %w", err)
+		}
+	}
+
+	rel, err := lockRelationship(tx, a, b)
+	if err != nil {
+		return err
+	}
+
+	if !policy.IsActive(rel) {
+		for _, id := range []string{a, b} {
+			var degree int64
+			err := tx.Model(&table.Relationship{}).
+				Where("user_a_id = ? OR user_b_id = ?", id, id).
+				Where("strength >= ?", policy.MinStrength).
+				Where("NOT (user_a_id = ? AND user_b_id = ?)", a, b).
+				Count(&degree).Error
+			if err != nil {
+				return fmt.Errorf("failed to count connections: %w", err)
+			}
+			if !policy.HasRoom(int(degree)) {
+				return errNoRoom{userID: id}
+			}
+		}
+	}
+
+	fn(rel)
+	return saveRelationship(tx, rel)
+}
+
+// lockRelationship loads the relationship for update, inserting it first if
+// missing so that SELECT ... FOR UPDATE always has a row to lock.
+func lockRelationship(tx *gorm.DB, userID, otherID string) (*domain.Relationship, error) {
+	fresh := table.FromDomainRelationship(domain.NewRelationship(userID, otherID, time.Now()))
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(fresh).Error; err != nil {
+		return nil, fmt.Errorf("failed to create relationship: %w", err)
+	}
+	rel, err := lockExistingRelationship(tx, userID, otherID)
+	if err == nil && rel == nil {
+		err = fmt.Errorf("failed to lock relationship: %w", gorm.ErrRecordNotFound)
+	}
+	return rel, err
+}
+
+// lockExistingRelationship loads the relationship for update, or returns nil
+// if there is none. A relationship deleted by a release or a block stays deleted.
+func lockExistingRelationship(tx *gorm.DB, userID, otherID string) (*domain.Relationship, error) {
+	a, b := domain.RelationshipPair(userID, otherID)
+	query := tx.Where("user_a_id = ? AND user_b_id = ?", a, b)
+	if canLock(tx) {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	var row table.Relationship
+	if err := query.First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to lock relationship: %w", err)
+	}
+	return row.ToDomain(), nil
+}
+
+func saveRelationship(tx *gorm.DB, rel *domain.Relationship) error {
+	if err := tx.Save(table.FromDomainRelationship(rel)).Error; err != nil {
+		return fmt.Errorf("failed to save relationship: %w", err)
+	}
+	return nil
+}
+
+// canLock reports whether the database supports row locks. SQLite has none
+// and needs none: it serialises writers and OpenDatabase allows one connection.
+func canLock(db *gorm.DB) bool {
+	return DialectOf(db) != DialectSQLite
+}
+
+// Delete removes the relationship between two users.
+func (r *RelationshipRepository) Delete(ctx context.Context, userID, otherID string) error {
+	a, b := domain.RelationshipPair(userID, otherID)
+	err := r.db.WithContext(ctx).
+		Where("user_a_id = ? AND user_b_id = ?", a, b).
+		Delete(&table.Relationship{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete relationship: %w", err)
+	}
+	return nil
+}
+
+// FindByUserID retrieves the relationships of userID with at least minStrength, strongest first.
+func (r *RelationshipRepository) FindByUserID(ctx context.Context, userID string, minStrength float64) ([]*domain.Relationship, error) {
+	var rows []table.Relationship
+	err := r.db.WithContext(ctx).
+		Where("user_a_id = ? OR user_b_id = ?", userID, userID).
+		Where("strength >= ?", minStrength).
+		Order("strength DESC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find relationships by user ID: %w", err)
+	}
+
+	result := make([]*domain.Relationship, len(rows))
+	for i, row := range rows {
+		result[i] = row.ToDomain()
+	}
+	return result, nil
+}
+
+// List retrieves relationships ordered by user pair.
+func (r *RelationshipRepository) List(ctx context.Context, limit, offset int) ([]*domain.Relationship, error) {
+	var rows []table.Relationship
+	err := r.db.WithContext(ctx).
+		Order("user_a_id, user_b_id").
+		Limit(limit).
+		Offset(offset).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relationships: %w", err)
+	}
+
+	result := make([]*domain.Relationship, len(rows))
+	for i, row := range rows {
+		result[i] = row.ToDomain()
+	}
+	return result, nil
+}
+
+// FindConnectedUserIDs retrieves the users whose relationship with userID has at least minStrength.
+func (r *RelationshipRepository) FindConnectedUserIDs(ctx context.Context, userID string, minStrength float64) ([]string, error) {
+	var rows []table.Relationship
+	err := r.db.WithContext(ctx).
+		Select("user_a_id", "user_b_id").
+		Where("user_a_id = ? OR user_b_id = ?", userID, userID).
+		Where("strength >= ?", minStrength).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find connected users: %w", err)
+	}
+
+	result := make([]string, len(rows))
+	for i, row := range rows {
+		result[i] = row.UserBID
+		if row.UserBID == userID {
+			result[i] = row.UserAID
+		}
+	}
+	return result, nil
+}
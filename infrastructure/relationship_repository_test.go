@@ -0,0 +1,51 @@
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+)
+
+func TestRelationshipRepositoryModifyDoesNotRecreate(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	saveUsers(t, db, requesterID, approverID)
+	relationships := infrastructure.NewRelationshipRepository(db)
+	policy := domain.RelationshipPolicy{MinStrength: 0.5}
+
+	if full, err := relationships.Connect(ctx, requesterID, approverID, policy, meeting); err != nil || full != "" {
+		t.Fatalf("Connect = %q, %v", full, err)
+	}
+	found, err := relationships.Modify(ctx, approverID, requesterID, meeting)
+	if err != nil || !found {
+		t.Fatalf("Modify = %v, %v; want true, nil", found, err)
+	}
+	rel, err := relationships.FindBetween(ctx, requesterID, approverID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel == nil || rel.Strength != 2*domain.MeetingWeight {
+		t.Errorf("relationship = %+v, want strength %v", rel, 2*domain.MeetingWeight)
+	}
+
+	// A release deletes the relationship while the decay job or a trace
+	// view still holds it.
+	if err := relationships.Delete(ctx, requesterID, approverID); err != nil {
+		t.Fatal(err)
+	}
+	found, err = relationships.Modify(ctx, requesterID, approverID, func(*domain.Relationship) {
+		t.Error("Modify called fn for a deleted relationship")
+	})
+	if err != nil || found {
+		t.Fatalf("Modify after Delete = %v, %v; want false, nil", found, err)
+	}
+	rel, err = relationships.FindBetween(ctx, requesterID, approverID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel != nil {
+		t.Errorf("deleted relationship %+v came back", rel)
+	}
+}
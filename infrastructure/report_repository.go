@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure/table"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ReportRepository is the GORM implementation of repository.ReportRepository.
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+// NewReportRepository creates a new ReportRepository.
+func NewReportRepository(db *gorm.DB) repository.ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+// Save persists a new report.
+func (r *ReportRepository) Save(ctx context.Context, report *domain.Report) error {
+	row := table.FromDomainReport(report)
+	if err := r.db.WithContext(ctx).Create(row).Error; err != nil {
+		return fmt.Errorf("failed to save report: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a report by its ID.
+func (r *ReportRepository) FindByID(ctx context.Context, id string) (*domain.Report, error) {
+	var row table.Report
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&row).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find report by ID: %w", err)
+	}
+	return row.ToDomain(), nil
+}
+
+// FindByStatus retrieves reports with the given status, oldest first.
+func (r *ReportRepository) FindByStatus(ctx context.Context, status domain.ReportStatus, limit, offset int) ([]*domain.Report, error) {
+	var rows []table.Report
+	err := r.db.WithContext(ctx).
+		Where("status = ?", string(status)).
+		Order("created_at, id").
+		Limit(limit).
+		Offset(offset).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reports by status: %w", err)
+	}
+
+	result := make([]*domain.Report, len(rows))
+	for i, row := range rows {
+		result[i] = row.ToDomain()
+	}
+	return result, nil
+}
+
+// Close persists a moderator's decision if the report is still open.
+func (r *ReportRepository) Close(ctx context.Context, report *domain.Report) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&table.Report{}).
+		Where("id = ? AND status = ?", report.ID, string(domain.ReportStatusOpen)).
+		Updates(map[string]interface{}{
+			"status":          string(report.Status),
+			"resolution_note": report.ResolutionNote,
+			"closed_at":       report.ClosedAt,
+			"updated_at":      time.Now(),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to close report: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,86 @@
+package infrastructure
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// sessionTokenIssuer is the iss and aud of every access token, so tokens of
+// other services sharing the secret by mistake are not accepted.
+const sessionTokenIssuer = "traceriver"
+
+// MinSessionSecretLength is the shortest HMAC secret accepted for access tokens.
+const MinSessionSecretLength = 32
+
+// sessionClaims is the JWT payload of an access token.
+type sessionClaims struct {
+	Issuer   string `json:"iss"`
+	Audience string `json:"aud"`
+	Subject  string `json:"sub"`
+	IssuedAt int64  `json:"iat"`
+	Expiry   int64  `json:"exp"`
+}
+
+// SessionTokenSigner is the HS256 JWT implementation of repository.SessionTokenSigner.
+type SessionTokenSigner struct {
+	secret []byte
+	now    func() time.Time
+}
+
+// NewSessionTokenSigner creates a new SessionTokenSigner. The secret should
+// be at least MinSessionSecretLength random bytes.
+func NewSessionTokenSigner(secret []byte) repository.SessionTokenSigner {
+	return &SessionTokenSigner{secret: secret, now: time.Now}
+}
+
+// Sign encodes the claims as an HS256 JWT.
+func (s *SessionTokenSigner) Sign(claims *domain.SessionClaims) (string, error) {
+	return encodeJWT(jwtHeader{Alg: "HS256", Typ: "JWT"}, sessionClaims{
+		Issuer:   sessionTokenIssuer,
+		Audience: sessionTokenIssuer,
+		Subject:  claims.UserID,
+		IssuedAt: claims.IssuedAt.Unix(),
+		Expiry:   claims.ExpiresAt.Unix(),
+	}, s.mac)
+}
+
+// Verify checks the token's signature and expiry and returns its claims.
+func (s *SessionTokenSigner) Verify(token string) (*domain.SessionClaims, error) {
+	parsed, err := parseJWT(token)
+	if err != nil {
+		return nil, domain.Errorf(domain.ErrUnauthenticated, "%w", err)
+	}
+	// The algorithm is fixed rather than read from the header, so a token
+	// cannot pick a weaker one.
+	if parsed.Header.Alg != "HS256" || !hmac.Equal(parsed.Signature, s.mac([]byte(parsed.SigningInput))) {
+		return nil, domain.NewError(domain.ErrUnauthenticated, "access token signature mismatch")
+	}
+
+	var claims sessionClaims
+	if err := json.Unmarshal(parsed.Payload, &claims); err != nil {
+		return nil, domain.Errorf(domain.ErrUnauthenticated, "malformed access token claims: %w", err)
+	}
+	if claims.Issuer != sessionTokenIssuer || claims.Audience != sessionTokenIssuer || claims.Subject == "" {
+		return nil, domain.NewError(domain.ErrUnauthenticated, "access token was not issued by this service")
+	}
+	expiresAt := time.Unix(claims.Expiry, 0)
+	if !s.now().Before(expiresAt) {
+		return nil, domain.NewError(domain.ErrUnauthenticated, "access token has expired")
+	}
+	return &domain.SessionClaims{
+		UserID:    claims.Subject,
+		IssuedAt:  time.Unix(claims.IssuedAt, 0),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (s *SessionTokenSigner) mac(signingInput []byte) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write(signingInput)
+	return h.Sum(nil)
+}
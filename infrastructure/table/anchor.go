@@ -0,0 +1,81 @@
+package table
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// AnchorBatch is the GORM database model for anchor batches.
+// This is separate from the domain model to maintain clean architecture.
+type AnchorBatch struct {
+	Epoch      int64   `gorm:"primaryKey;autoIncrement:false"`
+	Root       string  `gorm:"type:char(64);not null"`
+	LeafCount  int     `gorm:"not null"`
+	TxRef      *string `gorm:"type:varchar(255)"`
+	CreatedAt  time.Time
+	AnchoredAt *time.Time
+}
+
+// TableName specifies the table name for GORM.
+func (AnchorBatch) TableName() string {
+	return "anchor_batches"
+}
+
+// AnchorLeaf is the GORM database model for the interactions in a batch.
+type AnchorLeaf struct {
+	InteractionID string `gorm:"type:char(36);primaryKey"`
+	Epoch         int64  `gorm:"not null;index"`
+	Position      int    `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM.
+func (AnchorLeaf) TableName() string {
+	return "anchor_leaves"
+}
+
+// ToDomain converts the database models to a domain model.
+// leaves must be ordered by position.
+func (b *AnchorBatch) ToDomain(leaves []AnchorLeaf) *domain.AnchorBatch {
+	root, _ := hex.DecodeString(b.Root)
+	ids := make([]string, len(leaves))
+	for i, leaf := range leaves {
+		ids[i] = leaf.InteractionID
+	}
+
+	var txRef string
+	if b.TxRef != nil {
+		txRef = *b.TxRef
+	}
+	return &domain.AnchorBatch{
+		Epoch:          b.Epoch,
+		Root:           root,
+		InteractionIDs: ids,
+		TxRef:          txRef,
+		CreatedAt:      b.CreatedAt,
+		AnchoredAt:     b.AnchoredAt,
+	}
+}
+
+// FromDomainAnchorBatch creates database models from a domain model.
+func FromDomainAnchorBatch(d *domain.AnchorBatch) (*AnchorBatch, []AnchorLeaf) {
+	var txRef *string
+	if d.TxRef != "" {
+		txRef = &d.TxRef
+	}
+	batch := &AnchorBatch{
+		Epoch:      d.Epoch,
+		Root:       hex.EncodeToString(d.Root),
+		LeafCount:  len(d.InteractionIDs),
+		TxRef:      txRef,
+		CreatedAt:  d.CreatedAt,
+		AnchoredAt: d.AnchoredAt,
+	}
+
+	leaves := make([]AnchorLeaf, len(d.InteractionIDs))
+	for i, id := range d.InteractionIDs {
+		leaves[i] = AnchorLeaf{InteractionID: id, Epoch: d.Epoch, Position: i}
+	}
+	return batch, leaves
+}
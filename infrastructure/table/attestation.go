@@ -0,0 +1,103 @@
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// attestationRecord is the JSON document stored in interactions.attestation.
+type attestationRecord struct {
+	InteractionID   string    `json:"interactionId"`
+	RequesterID     string    `json:"requesterId"`
+	ApproverID      string    `json:"approverId"`
+	RequesterWallet *string   `json:"requesterWallet"`
+	ApproverWallet  *string   `json:"approverWallet"`
+	MetAt           time.Time `json:"metAt"`
+	IssuedAt        time.Time `json:"issuedAt"`
+	Signer          string    `json:"signer"`
+	Signature       string    `json:"signature"`
+}
+
+// NullAttestation is a nullable attestation column, in the style of sql.NullString.
+type NullAttestation struct {
+	Attestation attestationRecord
+	Valid       bool
+}
+
+// Scan implements the sql.Scanner interface for GORM.
+func (n *NullAttestation) Scan(value interface{}) error {
+	var bytes []byte
+	switch v := value.(type) {
+	case nil:
+		*n = NullAttestation{}
+		return nil
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("unsupported attestation column type: %T", value)
+	}
+
+	if err := json.Unmarshal(bytes, &n.Attestation); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface for GORM.
+func (n NullAttestation) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(n.Attestation)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// ToDomain converts the column to a domain model, or nil when NULL.
+func (n NullAttestation) ToDomain() *domain.Attestation {
+	if !n.Valid {
+		return nil
+	}
+	r := n.Attestation
+	return &domain.Attestation{
+		InteractionID:   r.InteractionID,
+		RequesterID:     r.RequesterID,
+		ApproverID:      r.ApproverID,
+		RequesterWallet: r.RequesterWallet,
+		ApproverWallet:  r.ApproverWallet,
+		MetAt:           r.MetAt,
+		IssuedAt:        r.IssuedAt,
+		Signer:          r.Signer,
+		Signature:       r.Signature,
+	}
+}
+
+// FromDomainAttestation creates a column value from a domain model.
+func FromDomainAttestation(d *domain.Attestation) NullAttestation {
+	if d == nil {
+		return NullAttestation{}
+	}
+	return NullAttestation{
+		Attestation: attestationRecord{
+			InteractionID:   d.InteractionID,
+			RequesterID:     d.RequesterID,
+			ApproverID:      d.ApproverID,
+			RequesterWallet: d.RequesterWallet,
+			ApproverWallet:  d.ApproverWallet,
+			MetAt:           d.MetAt,
+			IssuedAt:        d.IssuedAt,
+			Signer:          d.Signer,
+			Signature:       d.Signature,
+		},
+		Valid: true,
+	}
+}
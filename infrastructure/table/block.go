@@ -0,0 +1,34 @@
+package table
+
+import (
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// Block is the GORM database model for blocks.
+// This is separate from the domain model to maintain clean architecture.
+type Block struct {
+	BlockerID string    `gorm:"type:char(36);primaryKey"`
+	BlockedID string    `gorm:"type:char(36);primaryKey"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM.
+func (Block) TableName() string {
+	return "blocks"
+}
+
+// ToDomain converts the database model to a domain model.
+func (b *Block) ToDomain() *domain.Block {
+	return domain.NewBlock(b.BlockerID, b.BlockedID, b.CreatedAt)
+}
+
+// FromDomainBlock creates a database model from a domain model.
+func FromDomainBlock(d *domain.Block) *Block {
+	return &Block{
+		BlockerID: d.BlockerID,
+		BlockedID: d.BlockedID,
+		CreatedAt: d.CreatedAt,
+	}
+}
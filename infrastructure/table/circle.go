@@ -0,0 +1,66 @@
+package table
+
+import (
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// Circle is the GORM database model for circles.
+// This is separate from the domain model to maintain clean architecture.
+type Circle struct {
+	ID        string    `gorm:"type:char(36);primaryKey"`
+	FounderID string    `gorm:"type:char(36);not null;index"`
+	Name      string    `gorm:"type:varchar(255);not null"`
+	Manifesto string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM.
+func (Circle) TableName() string {
+	return "circles"
+}
+
+// ToDomain converts the database model to a domain model.
+func (c *Circle) ToDomain() *domain.Circle {
+	return domain.NewCircle(c.ID, c.FounderID, c.Name, c.Manifesto, c.CreatedAt)
+}
+
+// FromDomainCircle creates a database model from a domain model.
+func FromDomainCircle(d *domain.Circle) *Circle {
+	return &Circle{
+		ID:        d.ID,
+		FounderID: d.FounderID,
+		Name:      d.Name,
+		Manifesto: d.Manifesto,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: time.Now(),
+	}
+}
+
+// CircleMember is the GORM database model for circle memberships.
+type CircleMember struct {
+	CircleID string    `gorm:"type:char(36);primaryKey"`
+	UserID   string    `gorm:"type:char(36);primaryKey;index"`
+	JoinedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM.
+func (CircleMember) TableName() string {
+	return "circle_members"
+}
+
+// ToDomain converts the database model to a domain model.
+func (m *CircleMember) ToDomain() *domain.CircleMember {
+	return domain.NewCircleMember(m.CircleID, m.UserID, m.JoinedAt)
+}
+
+// FromDomainCircleMember creates a database model from a domain model.
+func FromDomainCircleMember(d *domain.CircleMember) *CircleMember {
+	return &CircleMember{
+		CircleID: d.CircleID,
+		UserID:   d.UserID,
+		JoinedAt: d.JoinedAt,
+	}
+}
@@ -0,0 +1,50 @@
+package table
+
+import (
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// Exchange is the GORM database model for exchanges.
+// This is separate from the domain model to maintain clean architecture.
+type Exchange struct {
+	ID              string    `gorm:"type:char(36);primaryKey"`
+	OffererID       string    `gorm:"type:char(36);not null;index"`
+	RecipientID     string    `gorm:"type:char(36);not null;index"`
+	OfferedTraceID  string    `gorm:"type:char(36);not null"`
+	ResponseTraceID *string   `gorm:"type:char(36)"`
+	Status          string    `gorm:"type:varchar(20);not null"`
+	CreatedAt       time.Time `gorm:"not null"`
+	RespondedAt     *time.Time
+	UpdatedAt       time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM.
+func (Exchange) TableName() string {
+	return "exchanges"
+}
+
+// ToDomain converts the database model to a domain model.
+func (e *Exchange) ToDomain() *domain.Exchange {
+	exchange := domain.NewExchange(e.ID, e.OffererID, e.RecipientID, e.OfferedTraceID, e.CreatedAt)
+	exchange.ResponseTraceID = e.ResponseTraceID
+	exchange.Status = domain.ExchangeStatus(e.Status)
+	exchange.RespondedAt = e.RespondedAt
+	return exchange
+}
+
+// FromDomainExchange creates a database model from a domain model.
+func FromDomainExchange(d *domain.Exchange) *Exchange {
+	return &Exchange{
+		ID:              d.ID,
+		OffererID:       d.OffererID,
+		RecipientID:     d.RecipientID,
+		OfferedTraceID:  d.OfferedTraceID,
+		ResponseTraceID: d.ResponseTraceID,
+		Status:          string(d.Status),
+		CreatedAt:       d.CreatedAt,
+		RespondedAt:     d.RespondedAt,
+		UpdatedAt:       time.Now(),
+	}
+}
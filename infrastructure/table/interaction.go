@@ -3,6 +3,7 @@ package table
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/dkpcb/pet/domain"
@@ -11,13 +12,14 @@ import (
 // Interaction is the GORM database model for interactions.
 // This is separate from the domain model to maintain clean architecture.
 type Interaction struct {
-	ID          string    `gorm:"type:char(36);primaryKey"`
-	RequesterID string    `gorm:"type:char(36);not null;index"`
-	ApproverID  string    `gorm:"type:char(36);not null;index"`
-	Status      string    `gorm:"type:varchar(20);not null"`
-	Metadata    Metadata  `gorm:"type:json"`
-	CreatedAt   time.Time `gorm:"not null"`
-	UpdatedAt   time.Time `gorm:"not null"`
+	ID          string          `gorm:"type:char(36);primaryKey"`
+	RequesterID string          `gorm:"type:char(36);not null;index"`
+	ApproverID  string          `gorm:"type:char(36);not null;index"`
+	Status      string          `gorm:"type:varchar(20);not null"`
+	Metadata    Metadata        `gorm:"type:json"`
+	Attestation NullAttestation `gorm:"type:json"`
+	CreatedAt   time.Time       `gorm:"not null"`
+	UpdatedAt   time.Time       `gorm:"not null"`
 }
 
 // TableName specifies the table name for GORM.
@@ -29,15 +31,19 @@ func (Interaction) TableName() string {
 type Metadata map[string]interface{}
 
 // Scan implements the sql.Scanner interface for GORM.
+// MySQL returns JSON columns as []byte while SQLite returns TEXT columns as string.
 func (m *Metadata) Scan(value interface{}) error {
-	if value == nil {
+	var bytes []byte
+	switch v := value.(type) {
+	case nil:
 		*m = nil
 		return nil
-	}
-
-	bytes, ok := value.([]byte)
-	if !ok {
-		return nil
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("unsupported metadata column type: %T", value)
 	}
 
 	result := make(map[string]interface{})
@@ -50,11 +56,17 @@ func (m *Metadata) Scan(value interface{}) error {
 }
 
 // Value implements the driver.Valuer interface for GORM.
+// The JSON is returned as a string so SQLite stores TEXT rather than a BLOB,
+// which keeps it usable with SQLite's JSON functions.
 func (m Metadata) Value() (driver.Value, error) {
 	if m == nil {
 		return nil, nil
 	}
-	return json.Marshal(m)
+	bytes, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
 }
 
 // ToDomain converts the database model to a domain model.
@@ -64,7 +76,7 @@ func (i *Interaction) ToDomain() *domain.Interaction {
 		metadata = i.Metadata
 	}
 
-	return domain.NewInteraction(
+	interaction := domain.NewInteraction(
 		i.ID,
 		i.RequesterID,
 		i.ApproverID,
@@ -72,6 +84,8 @@ func (i *Interaction) ToDomain() *domain.Interaction {
 		metadata,
 		i.CreatedAt,
 	)
+	interaction.Attest(i.Attestation.ToDomain())
+	return interaction
 }
 
 // FromDomain creates a database model from a domain model.
@@ -83,6 +97,7 @@ func FromDomainInteraction(d *domain.Interaction) *Interaction {
 		ApproverID:  d.ApproverID,
 		Status:      string(d.Status),
 		Metadata:    d.Metadata,
+		Attestation: FromDomainAttestation(d.Attestation),
 		CreatedAt:   d.CreatedAt,
 		UpdatedAt:   now,
 	}
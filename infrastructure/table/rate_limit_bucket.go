@@ -0,0 +1,41 @@
+package table
+
+import (
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// RateLimitBucket is the GORM database model for rate limit buckets.
+// This is separate from the domain model to maintain clean architecture.
+type RateLimitBucket struct {
+	BucketKey string    `gorm:"type:varchar(191);primaryKey"`
+	Tokens    float64   `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null;autoUpdateTime:false"`
+	// FullAt is when the bucket is full again, or nil if it never will be.
+	FullAt *time.Time
+}
+
+// TableName specifies the table name for GORM.
+func (RateLimitBucket) TableName() string {
+	return "rate_limit_buckets"
+}
+
+// ToDomain converts the database model to a domain model.
+func (b *RateLimitBucket) ToDomain() *domain.TokenBucket {
+	return &domain.TokenBucket{Tokens: b.Tokens, UpdatedAt: b.UpdatedAt}
+}
+
+// FromDomainTokenBucket creates a database model from a domain model
+// refilling under limit.
+func FromDomainTokenBucket(key string, d *domain.TokenBucket, limit domain.RateLimit) *RateLimitBucket {
+	row := &RateLimitBucket{
+		BucketKey: key,
+		Tokens:    d.Tokens,
+		UpdatedAt: d.UpdatedAt,
+	}
+	if fullAt, ok := d.FullAt(limit); ok {
+		row.FullAt = &fullAt
+	}
+	return row
+}
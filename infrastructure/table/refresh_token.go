@@ -0,0 +1,42 @@
+package table
+
+import (
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// RefreshToken is the GORM database model for refresh tokens.
+// This is separate from the domain model to maintain clean architecture.
+type RefreshToken struct {
+	ID        string    `gorm:"type:char(36);primaryKey"`
+	UserID    string    `gorm:"type:char(36);not null;index"`
+	TokenHash string    `gorm:"type:char(64);not null;uniqueIndex"`
+	CreatedAt time.Time `gorm:"not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	RevokedAt *time.Time
+}
+
+// TableName specifies the table name for GORM.
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+// ToDomain converts the database model to a domain model.
+func (t *RefreshToken) ToDomain() *domain.RefreshToken {
+	token := domain.NewRefreshToken(t.ID, t.UserID, t.TokenHash, t.CreatedAt, t.ExpiresAt)
+	token.RevokedAt = t.RevokedAt
+	return token
+}
+
+// FromDomainRefreshToken creates a database model from a domain model.
+func FromDomainRefreshToken(d *domain.RefreshToken) *RefreshToken {
+	return &RefreshToken{
+		ID:        d.ID,
+		UserID:    d.UserID,
+		TokenHash: d.TokenHash,
+		CreatedAt: d.CreatedAt,
+		ExpiresAt: d.ExpiresAt,
+		RevokedAt: d.RevokedAt,
+	}
+}
@@ -0,0 +1,58 @@
+package table
+
+import (
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// Relationship is the GORM database model for relationships.
+// This is separate from the domain model to maintain clean architecture.
+type Relationship struct {
+	UserAID         string `gorm:"column:user_a_id;type:char(36);primaryKey"`
+	UserBID         string `gorm:"column:user_b_id;type:char(36);primaryKey"`
+	ExchangeCount   int    `gorm:"not null"`
+	LastExchangedAt *time.Time
+	ViewCountA      int       `gorm:"column:view_count_a;not null"`
+	ViewCountB      int       `gorm:"column:view_count_b;not null"`
+	Strength        float64   `gorm:"not null"`
+	DecayedAt       time.Time `gorm:"not null"`
+	CreatedAt       time.Time `gorm:"not null"`
+	UpdatedAt       time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM.
+func (Relationship) TableName() string {
+	return "relationships"
+}
+
+// ToDomain converts the database model to a domain model.
+func (r *Relationship) ToDomain() *domain.Relationship {
+	return &domain.Relationship{
+		UserAID:         r.UserAID,
+		UserBID:         r.UserBID,
+		ExchangeCount:   r.ExchangeCount,
+		LastExchangedAt: r.LastExchangedAt,
+		ViewCountA:      r.ViewCountA,
+		ViewCountB:      r.ViewCountB,
+		Strength:        r.Strength,
+		DecayedAt:       r.DecayedAt,
+		CreatedAt:       r.CreatedAt,
+	}
+}
+
+// FromDomainRelationship creates a database model from a domain model.
+func FromDomainRelationship(d *domain.Relationship) *Relationship {
+	return &Relationship{
+		UserAID:         d.UserAID,
+		UserBID:         d.UserBID,
+		ExchangeCount:   d.ExchangeCount,
+		LastExchangedAt: d.LastExchangedAt,
+		ViewCountA:      d.ViewCountA,
+		ViewCountB:      d.ViewCountB,
+		Strength:        d.Strength,
+		DecayedAt:       d.DecayedAt,
+		CreatedAt:       d.CreatedAt,
+		UpdatedAt:       time.Now(),
+	}
+}
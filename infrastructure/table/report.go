@@ -0,0 +1,52 @@
+package table
+
+import (
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// Report is the GORM database model for reports.
+// This is separate from the domain model to maintain clean architecture.
+type Report struct {
+	ID             string    `gorm:"type:char(36);primaryKey"`
+	ReporterID     string    `gorm:"type:char(36);not null"`
+	ReportedID     string    `gorm:"type:char(36);not null;index"`
+	Reason         string    `gorm:"type:varchar(32);not null"`
+	Details        string    `gorm:"type:text;not null"`
+	Status         string    `gorm:"type:varchar(20);not null"`
+	ResolutionNote *string   `gorm:"type:text"`
+	CreatedAt      time.Time `gorm:"not null"`
+	ClosedAt       *time.Time
+	UpdatedAt      time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM.
+func (Report) TableName() string {
+	return "reports"
+}
+
+// ToDomain converts the database model to a domain model.
+func (r *Report) ToDomain() *domain.Report {
+	report := domain.NewReport(r.ID, r.ReporterID, r.ReportedID, domain.ReportReason(r.Reason), r.Details, r.CreatedAt)
+	report.Status = domain.ReportStatus(r.Status)
+	report.ResolutionNote = r.ResolutionNote
+	report.ClosedAt = r.ClosedAt
+	return report
+}
+
+// FromDomainReport creates a database model from a domain model.
+func FromDomainReport(d *domain.Report) *Report {
+	return &Report{
+		ID:             d.ID,
+		ReporterID:     d.ReporterID,
+		ReportedID:     d.ReportedID,
+		Reason:         string(d.Reason),
+		Details:        d.Details,
+		Status:         string(d.Status),
+		ResolutionNote: d.ResolutionNote,
+		CreatedAt:      d.CreatedAt,
+		ClosedAt:       d.ClosedAt,
+		UpdatedAt:      time.Now(),
+	}
+}
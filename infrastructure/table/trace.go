@@ -0,0 +1,42 @@
+package table
+
+import (
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// Trace is the GORM database model for traces.
+// This is separate from the domain model to maintain clean architecture.
+type Trace struct {
+	ID        string    `gorm:"type:char(36);primaryKey"`
+	AuthorID  string    `gorm:"type:char(36);not null;index"`
+	CircleID  *string   `gorm:"type:char(36);index"`
+	Body      string    `gorm:"type:text;not null"`
+	MediaURL  *string   `gorm:"type:varchar(2048)"`
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM.
+func (Trace) TableName() string {
+	return "traces"
+}
+
+// ToDomain converts the database model to a domain model.
+func (t *Trace) ToDomain() *domain.Trace {
+	return domain.NewTrace(t.ID, t.AuthorID, t.CircleID, t.Body, t.MediaURL, t.CreatedAt)
+}
+
+// FromDomainTrace creates a database model from a domain model.
+func FromDomainTrace(d *domain.Trace) *Trace {
+	return &Trace{
+		ID:        d.ID,
+		AuthorID:  d.AuthorID,
+		CircleID:  d.CircleID,
+		Body:      d.Body,
+		MediaURL:  d.MediaURL,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: time.Now(),
+	}
+}
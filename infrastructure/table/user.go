@@ -13,6 +13,9 @@ type User struct {
 	LineUserID    string  `gorm:"type:varchar(255);uniqueIndex;not null"`
 	DisplayName   string  `gorm:"type:varchar(255);not null"`
 	WalletAddress *string `gorm:"type:varchar(255)"`
+	Locale        string  `gorm:"type:varchar(16);not null;default:ja"`
+	Bio           string  `gorm:"type:varchar(2000);not null;default:''"`
+	AvatarURL     *string `gorm:"type:varchar(2048)"`
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -24,12 +27,16 @@ func (User) TableName() string {
 
 // ToDomain converts the database model to a domain model.
 func (u *User) ToDomain() *domain.User {
-	return domain.NewUser(
+	user := domain.NewUser(
 		u.ID,
 		u.LineUserID,
 		u.DisplayName,
 		u.WalletAddress,
 	)
+	user.Locale = domain.ParseLocale(u.Locale)
+	user.Bio = u.Bio
+	user.AvatarURL = u.AvatarURL
+	return user
 }
 
 // FromDomainUser creates a database model from a domain model.
@@ -39,5 +46,8 @@ func FromDomainUser(d *domain.User) *User {
 		LineUserID:    d.LineUserID,
 		DisplayName:   d.DisplayName,
 		WalletAddress: d.WalletAddress,
+		Locale:        string(d.Locale),
+		Bio:           d.Bio,
+		AvatarURL:     d.AvatarURL,
 	}
 }
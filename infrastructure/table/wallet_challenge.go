@@ -0,0 +1,45 @@
+package table
+
+import (
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// WalletChallenge is the GORM database model for wallet link challenges.
+// This is separate from the domain model to maintain clean architecture.
+type WalletChallenge struct {
+	UserID        string    `gorm:"type:char(36);primaryKey"`
+	WalletAddress string    `gorm:"type:varchar(42);not null"`
+	Nonce         string    `gorm:"type:varchar(64);not null"`
+	Message       string    `gorm:"type:text;not null"`
+	ExpiresAt     time.Time `gorm:"not null"`
+	CreatedAt     time.Time
+}
+
+// TableName specifies the table name for GORM.
+func (WalletChallenge) TableName() string {
+	return "wallet_challenges"
+}
+
+// ToDomain converts the database model to a domain model.
+func (c *WalletChallenge) ToDomain() *domain.WalletChallenge {
+	return &domain.WalletChallenge{
+		UserID:        c.UserID,
+		WalletAddress: c.WalletAddress,
+		Nonce:         c.Nonce,
+		Message:       c.Message,
+		ExpiresAt:     c.ExpiresAt,
+	}
+}
+
+// FromDomainWalletChallenge creates a database model from a domain model.
+func FromDomainWalletChallenge(d *domain.WalletChallenge) *WalletChallenge {
+	return &WalletChallenge{
+		UserID:        d.UserID,
+		WalletAddress: d.WalletAddress,
+		Nonce:         d.Nonce,
+		Message:       d.Message,
+		ExpiresAt:     d.ExpiresAt,
+	}
+}
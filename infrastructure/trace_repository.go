@@ -0,0 +1,76 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure/table"
+	"github.com/dkpcb/pet/repository"
+)
+
+// TraceRepository is the GORM implementation of repository.TraceRepository.
+type TraceRepository struct {
+	db *gorm.DB
+}
+
+// NewTraceRepository creates a new TraceRepository.
+func NewTraceRepository(db *gorm.DB) repository.TraceRepository {
+	return &TraceRepository{db: db}
+}
+
+// Save persists a new trace.
+func (r *TraceRepository) Save(ctx context.Context, trace *domain.Trace) error {
+	row := table.FromDomainTrace(trace)
+	if err := r.db.WithContext(ctx).Create(row).Error; err != nil {
+		return fmt.Errorf("failed to save trace: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a trace by its ID.
+func (r *TraceRepository) FindByID(ctx context.Context, id string) (*domain.Trace, error) {
+	var row table.Trace
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&row).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find trace by ID: %w", err)
+	}
+	return row.ToDomain(), nil
+}
+
+// FindByCircleID retrieves the traces posted to a circle, newest first.
+func (r *TraceRepository) FindByCircleID(ctx context.Context, circleID string) ([]*domain.Trace, error) {
+	var rows []table.Trace
+	if err := r.db.WithContext(ctx).Where("circle_id = ?", circleID).Order("created_at DESC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find traces by circle ID: %w", err)
+	}
+
+	result := make([]*domain.Trace, len(rows))
+	for i, row := range rows {
+		result[i] = row.ToDomain()
+	}
+	return result, nil
+}
+
+// FindByAuthorID retrieves up to limit unscoped traces posted by a user, newest first.
+func (r *TraceRepository) FindByAuthorID(ctx context.Context, authorID string, limit int) ([]*domain.Trace, error) {
+	var rows []table.Trace
+	err := r.db.WithContext(ctx).
+		Where("author_id = ? AND circle_id IS NULL", authorID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find traces by author ID: %w", err)
+	}
+
+	result := make([]*domain.Trace, len(rows))
+	for i, row := range rows {
+		result[i] = row.ToDomain()
+	}
+	return result, nil
+}
@@ -2,6 +2,7 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"gorm.io/gorm"
@@ -54,10 +55,43 @@ func (r *UserRepository) FindByLineUserID(ctx context.Context, lineUserID string
 	return row.ToDomain(), nil
 }
 
+// FindByWalletAddress retrieves a user by their wallet address.
+func (r *UserRepository) FindByWalletAddress(ctx context.Context, walletAddress string) (*domain.User, error) {
+	var row table.User
+	if err := r.db.WithContext(ctx).Where("wallet_address = ?", walletAddress).First(&row).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find user by wallet address: %w", err)
+	}
+	return row.ToDomain(), nil
+}
+
+// List retrieves users ordered by creation time, oldest first.
+func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	var rows []table.User
+	if err := r.db.WithContext(ctx).Order("created_at, id").Limit(limit).Offset(offset).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	result := make([]*domain.User, len(rows))
+	for i, row := range rows {
+		result[i] = row.ToDomain()
+	}
+	return result, nil
+}
+
 // Update updates an existing user.
 func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 	row := table.FromDomainUser(user)
-	if err := r.db.WithContext(ctx).Save(row).Error; err != nil {
+	// The domain model does not carry created_at, so it must not be overwritten.
+	err := r.db.WithContext(ctx).Omit("created_at").Save(row).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		// The only unique column an update can change is the wallet address,
+		// linked to another user between the check and this update.
+		return domain.NewError(domain.ErrConflict, "wallet address is already linked to another user")
+	}
+	if err != nil {
 		return fmt.Errorf("failed to update user: %w", err)
 	}
 	return nil
@@ -0,0 +1,45 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+)
+
+func TestUserRepositoryUpdateWalletConflict(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	saveUsers(t, db, requesterID, approverID)
+	users := infrastructure.NewUserRepository(db)
+	const wallet = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+
+	owner, err := users.FindByID(ctx, requesterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner.LinkWallet(wallet)
+	if err := users.Update(ctx, owner); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	// Another user links the same wallet after passing the availability
+	// check, as in a race between two link requests.
+	other, err := users.FindByID(ctx, approverID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other.LinkWallet(wallet)
+	if err := users.Update(ctx, other); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("Update = %v, want ErrConflict", err)
+	}
+	found, err := users.FindByWalletAddress(ctx, wallet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found == nil || found.ID != requesterID {
+		t.Errorf("wallet owner = %+v, want %s", found, requesterID)
+	}
+}
@@ -0,0 +1,52 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure/table"
+	"github.com/dkpcb/pet/repository"
+)
+
+// WalletChallengeRepository is the GORM implementation of repository.WalletChallengeRepository.
+type WalletChallengeRepository struct {
+	db *gorm.DB
+}
+
+// NewWalletChallengeRepository creates a new WalletChallengeRepository.
+func NewWalletChallengeRepository(db *gorm.DB) repository.WalletChallengeRepository {
+	return &WalletChallengeRepository{db: db}
+}
+
+// Save persists a challenge, replacing any existing challenge for the same user.
+func (r *WalletChallengeRepository) Save(ctx context.Context, challenge *domain.WalletChallenge) error {
+	row := table.FromDomainWalletChallenge(challenge)
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(row).Error; err != nil {
+		return fmt.Errorf("failed to save wallet challenge: %w", err)
+	}
+	return nil
+}
+
+// FindByUserID retrieves the outstanding challenge for a user.
+func (r *WalletChallengeRepository) FindByUserID(ctx context.Context, userID string) (*domain.WalletChallenge, error) {
+	var row table.WalletChallenge
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&row).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find wallet challenge by user ID: %w", err)
+	}
+	return row.ToDomain(), nil
+}
+
+// Delete removes the challenge for a user.
+func (r *WalletChallengeRepository) Delete(ctx context.Context, userID string) error {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&table.WalletChallenge{}).Error; err != nil {
+		return fmt.Errorf("failed to delete wallet challenge: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package infrastructure
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dkpcb/pet/repository"
+)
+
+// WalletVerifier is the secp256k1 implementation of repository.WalletVerifier.
+// It works entirely offline; no Ethereum node is involved.
+type WalletVerifier struct{}
+
+// NewWalletVerifier creates a new WalletVerifier.
+func NewWalletVerifier() repository.WalletVerifier {
+	return &WalletVerifier{}
+}
+
+// NormalizeAddress validates a hex wallet address and returns it in EIP-55 checksum form.
+func (v *WalletVerifier) NormalizeAddress(address string) (string, error) {
+	addr, err := parseAddress(strings.TrimSpace(address))
+	if err != nil {
+		return "", err
+	}
+	return checksumAddress(addr), nil
+}
+
+// RecoverAddress returns the address that signed message with EIP-191 personal_sign.
+func (v *WalletVerifier) RecoverAddress(message string, signature string) (string, error) {
+	sig, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signature), "0x"))
+	if err != nil {
+		return "", fmt.Errorf("signature is not hex: %w", err)
+	}
+	addr, err := recoverAddress(personalMessageHash(message), sig)
+	if err != nil {
+		return "", err
+	}
+	return checksumAddress(addr), nil
+}
@@ -0,0 +1,134 @@
+// Package logging sets up the structured logger every layer writes to and
+// carries per-request attributes through context.Context, so a line logged
+// deep inside a usecase can be traced back to the request or LINE event
+// that caused it.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// Attribute keys shared across the layers.
+const (
+	KeyRequestID      = "request_id"
+	KeyWebhookEventID = "webhook_event_id"
+	KeyUserID         = "user_id"
+	KeyLineUserID     = "line_user_id"
+	KeyTraceID        = "trace_id"
+)
+
+// Attribute keys whose values are never written out. Tokens grant access and
+// message text is private conversation.
+const (
+	KeyAccessToken  = "access_token"
+	KeyRefreshToken = "refresh_token"
+	KeyIDToken      = "id_token"
+	KeyReplyToken   = "reply_token"
+	KeyMessageText  = "message_text"
+)
+
+var redactedKeys = map[string]bool{
+	KeyAccessToken:  true,
+	KeyRefreshToken: true,
+	KeyIDToken:      true,
+	KeyReplyToken:   true,
+	KeyMessageText:  true,
+	"authorization": true,
+}
+
+// redacted replaces the value of a sensitive attribute.
+const redacted = "[REDACTED]"
+
+// Format is how log records are written.
+type Format string
+
+const (
+	// FormatJSON writes one JSON object per line, for log collectors.
+	FormatJSON Format = "json"
+	// FormatText writes key=value lines, for reading in a terminal.
+	FormatText Format = "text"
+)
+
+// New creates a logger writing records at level or above to w.
+func New(w io.Writer, format Format, level slog.Level) (*slog.Logger, error) {
+	opts := &slog.HandlerOptions{Level: level, ReplaceAttr: redact}
+	var handler slog.Handler
+	switch format {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q (want json or text)", format)
+	}
+	return slog.New(contextHandler{handler}), nil
+}
+
+// ParseLevel parses a level name such as "debug", "info", "warn" or "error".
+func ParseLevel(name string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(name)); err != nil {
+		return 0, fmt.Errorf("unknown log level %q", name)
+	}
+	return level, nil
+}
+
+func redact(groups []string, a slog.Attr) slog.Attr {
+	if redactedKeys[strings.ToLower(a.Key)] {
+		return slog.String(a.Key, redacted)
+	}
+	return a
+}
+
+type attrsKey struct{}
+
+type loggerKey struct{}
+
+// With returns a context whose log records carry attrs, in addition to those
+// ctx already carries. Attributes are only picked up by the *Context methods
+// of the logger, such as InfoContext.
+func With(ctx context.Context, attrs ...slog.Attr) context.Context {
+	existing, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	merged := make([]slog.Attr, 0, len(existing)+len(attrs))
+	merged = append(merged, existing...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, attrsKey{}, merged)
+}
+
+// NewContext returns a context carrying logger, for code that has a context
+// but no logger of its own, such as HTTP helpers.
+func NewContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger stored by NewContext, or slog.Default().
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// contextHandler adds the attributes stored by With to every record.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if attrs, ok := ctx.Value(attrsKey{}).([]slog.Attr); ok {
+		record.AddAttrs(attrs...)
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
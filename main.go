@@ -1,14 +1,574 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
+	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"gorm.io/gorm"
+
+	"github.com/dkpcb/pet/cli"
+	"github.com/dkpcb/pet/controller"
+	"github.com/dkpcb/pet/infrastructure"
+	"github.com/dkpcb/pet/logging"
+	"github.com/dkpcb/pet/metrics"
+	"github.com/dkpcb/pet/repository"
+	"github.com/dkpcb/pet/tracing"
+	"github.com/dkpcb/pet/usecase"
 )
 
 func main() {
-	// TODO: Implement DI container and server startup
-	// This is a placeholder for the application entry point
-	fmt.Println("TraceRiver API - Entry Point")
-	fmt.Println("TODO: Implement server initialization")
-	os.Exit(0)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cmd, args := "serve", []string(nil)
+	if len(os.Args) > 1 {
+		cmd, args = os.Args[1], os.Args[2:]
+	}
+
+	logger, err := newLogger(loadLogConfig())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", cmd, err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger)
+
+	switch cmd {
+	case "serve":
+		err = runServe(ctx, logger)
+	case "migrate":
+		err = runMigrate(ctx, args, os.Stdout, logger)
+	case "admin":
+		err = runAdmin(ctx, args, logger)
+	case "attestation":
+		err = runAttestation(ctx, args)
+	case "replay":
+		err = runReplay(ctx, args, logger)
+	default:
+		err = fmt.Errorf("unknown command %q (want serve, migrate, admin, attestation or replay)", cmd)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", cmd, err)
+		os.Exit(1)
+	}
+}
+
+// app is the API wired to its dependencies.
+type app struct {
+	handler   http.Handler
+	tracer    *tracing.Tracer
+	readiness *usecase.CheckReadinessUsecase
+	jobs      []job
+}
+
+// job is a background job the server runs every interval.
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+}
+
+// runServe wires the HTTP server and runs it until ctx is cancelled.
+func runServe(ctx context.Context, logger *slog.Logger) error {
+	api, err := newApp(logger, true)
+	if err != nil {
+		return err
+	}
+	defer api.shutdown(logger)
+
+	// Check the dependencies once before taking traffic. A degraded server
+	// still starts: readiness keeps it out of rotation until it recovers.
+	readiness, err := api.readiness.Execute(ctx)
+	if err != nil {
+		return err
+	}
+	for _, c := range readiness.Components {
+		if !c.OK {
+			logger.Warn("component not ready", "component", c.Name, "detail", c.Detail, "error", c.Err)
+		}
+	}
+
+	// Background jobs
+	for _, j := range api.jobs {
+		go runEvery(ctx, logger, api.tracer, j.interval, j.name, j.run)
+	}
+
+	addr := loadServerConfig().Addr
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           api.handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		logger.Info("TraceRiver API listening", "addr", addr)
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// newApp wires the API. recordWebhooks enables recording LINE webhook
+// requests as configured; replaying turns it off so replays are not
+// recorded again. Call shutdown when done with the app.
+func newApp(logger *slog.Logger, recordWebhooks bool) (*app, error) {
+	serverCfg := loadServerConfig()
+	authCfg, err := loadAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	relationshipCfg, err := loadRelationshipConfig()
+	if err != nil {
+		return nil, err
+	}
+	rateLimitCfg, err := loadRateLimitConfig()
+	if err != nil {
+		return nil, err
+	}
+	dbCfg := loadDatabaseConfig()
+	db, err := infrastructure.OpenDatabase(dbCfg.Dialect, dbCfg.DSN, logger)
+	if err != nil {
+		return nil, err
+	}
+	attestationSigner, err := newAttestationSigner(serverCfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	rateLimiter, err := newRateLimiter(rateLimitCfg, db)
+	if err != nil {
+		return nil, err
+	}
+	sessionSigner, err := newSessionTokenSigner(authCfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	lineCfg := loadLineConfig()
+	if lineCfg.ChannelSecret == "" {
+		logger.Warn("LINE_CHANNEL_SECRET not set, webhook requests are refused")
+	}
+	if authCfg.LineLogin.ChannelID == "" {
+		logger.Warn("LINE_LOGIN_CHANNEL_ID not set, signing in with LINE is disabled")
+	}
+
+	// Metrics and tracing: repository and LINE calls are timed and traced,
+	// other external calls only traced. LINE messages are sent inline rather
+	// than queued, so there is no outbox backlog to report; failed sends show
+	// up as errors of the LINE service calls.
+	registry := metrics.NewRegistry()
+	observeDB := infrastructure.Observers(
+		infrastructure.ObserveDurations(registry.Histogram(
+			"traceriver_db_query_duration_seconds",
+			"Time spent in repository calls, by repository method and outcome.",
+			metrics.DefaultBuckets, "method", "outcome",
+		)),
+		infrastructure.ObserveSpans(),
+	)
+	observeLine := infrastructure.Observers(
+		infrastructure.ObserveDurations(registry.Histogram(
+			"traceriver_line_service_call_duration_seconds",
+			"Time spent in LINE Messaging API calls, by method and outcome.",
+			metrics.DefaultBuckets, "method", "outcome",
+		)),
+		infrastructure.ObserveSpans(),
+	)
+	observeCalls := infrastructure.ObserveSpans()
+	lineTransport := infrastructure.NewMetricsTransport(nil, registry.Histogram(
+		"traceriver_line_api_request_duration_seconds",
+		"LINE API requests sent over HTTP, by endpoint and status code. LINE Login, profile lookups and the channel access token check go over HTTP; Messaging API sends are timed in traceriver_line_service_call_duration_seconds.",
+		metrics.DefaultBuckets, "endpoint", "code",
+	))
+
+	// Repositories
+	userRepo := infrastructure.NewInstrumentedUserRepository(infrastructure.NewUserRepository(db), observeDB)
+	interactionRepo := infrastructure.NewInstrumentedInteractionRepository(infrastructure.NewInteractionRepository(db), observeDB)
+	lineService := infrastructure.NewInstrumentedLineService(infrastructure.NewLineService(lineCfg.ChannelAccessToken, lineCfg.APIURL, lineTransport, logger), observeLine)
+	circleRepo := infrastructure.NewInstrumentedCircleRepository(infrastructure.NewCircleRepository(db), observeDB)
+	traceRepo := infrastructure.NewInstrumentedTraceRepository(infrastructure.NewTraceRepository(db), observeDB)
+	exchangeRepo := infrastructure.NewInstrumentedExchangeRepository(infrastructure.NewExchangeRepository(db), observeDB)
+	relationshipRepo := infrastructure.NewInstrumentedRelationshipRepository(infrastructure.NewRelationshipRepository(db), observeDB)
+	blockRepo := infrastructure.NewInstrumentedBlockRepository(infrastructure.NewBlockRepository(db), observeDB)
+	reportRepo := infrastructure.NewInstrumentedReportRepository(infrastructure.NewReportRepository(db), observeDB)
+	refreshTokenRepo := infrastructure.NewInstrumentedRefreshTokenRepository(infrastructure.NewRefreshTokenRepository(db), observeDB)
+	walletChallengeRepo := infrastructure.NewInstrumentedWalletChallengeRepository(infrastructure.NewWalletChallengeRepository(db), observeDB)
+	databaseProbe, err := infrastructure.NewDatabaseProbe(db)
+	if err != nil {
+		return nil, err
+	}
+	lineLoginVerifier := infrastructure.NewInstrumentedLineLoginVerifier(infrastructure.NewLineLoginVerifier(authCfg.LineLogin, lineTransport, logger), observeCalls)
+	rateLimiter = infrastructure.NewInstrumentedRateLimiter(rateLimiter, observeCalls)
+
+	// Usecases
+	requestInteractionUsecase := usecase.NewRequestInteractionUsecase(
+		interactionRepo,
+		userRepo,
+		blockRepo,
+		lineService,
+		rateLimiter,
+		rateLimitCfg.Requests,
+		logger,
+	)
+	approveInteractionUsecase := usecase.NewApproveInteractionUsecase(
+		interactionRepo,
+		userRepo,
+		lineService,
+		attestationSigner,
+		relationshipRepo,
+		relationshipCfg.Policy,
+		logger,
+	)
+	rejectInteractionUsecase := usecase.NewRejectInteractionUsecase(interactionRepo, userRepo)
+	getInteractionAttestationUsecase := usecase.NewGetInteractionAttestationUsecase(interactionRepo)
+	createCircleUsecase := usecase.NewCreateCircleUsecase(circleRepo, userRepo)
+	joinCircleUsecase := usecase.NewJoinCircleUsecase(circleRepo, userRepo, relationshipRepo, blockRepo, relationshipCfg.Policy)
+	leaveCircleUsecase := usecase.NewLeaveCircleUsecase(circleRepo, userRepo)
+	postTraceUsecase := usecase.NewPostTraceUsecase(traceRepo, circleRepo, userRepo)
+	listCircleTracesUsecase := usecase.NewListCircleTracesUsecase(traceRepo, circleRepo, userRepo, blockRepo)
+	offerExchangeUsecase := usecase.NewOfferExchangeUsecase(exchangeRepo, traceRepo, userRepo, blockRepo, lineService, logger)
+	completeExchangeUsecase := usecase.NewCompleteExchangeUsecase(exchangeRepo, traceRepo, relationshipRepo, relationshipCfg.Policy, userRepo, lineService, logger)
+	declineExchangeUsecase := usecase.NewDeclineExchangeUsecase(exchangeRepo, userRepo)
+	viewTraceUsecase := usecase.NewViewTraceUsecase(traceRepo, circleRepo, userRepo, relationshipRepo, blockRepo, relationshipCfg.Policy, logger)
+	decayRelationshipsUsecase := usecase.NewDecayRelationshipsUsecase(relationshipRepo, relationshipCfg.Policy)
+	listConnectionsUsecase := usecase.NewListConnectionsUsecase(relationshipRepo, userRepo, relationshipCfg.Policy)
+	releaseConnectionUsecase := usecase.NewReleaseConnectionUsecase(relationshipRepo, userRepo, relationshipCfg.Policy)
+	blockUserUsecase := usecase.NewBlockUserUsecase(blockRepo, userRepo)
+	unblockUserUsecase := usecase.NewUnblockUserUsecase(blockRepo, userRepo)
+	listBlocksUsecase := usecase.NewListBlocksUsecase(blockRepo, userRepo)
+	reportUserUsecase := usecase.NewReportUserUsecase(reportRepo, userRepo)
+	getProfileUsecase := usecase.NewGetProfileUsecase(userRepo, relationshipRepo, blockRepo, relationshipCfg.Policy)
+	updateProfileUsecase := usecase.NewUpdateProfileUsecase(userRepo)
+	linkWalletUsecase := usecase.NewLinkWalletUsecase(userRepo, walletChallengeRepo, infrastructure.NewWalletVerifier())
+	listInteractionsUsecase := usecase.NewListInteractionsUsecase(interactionRepo, userRepo)
+	throttleLineEventUsecase := usecase.NewThrottleLineEventUsecase(rateLimiter, rateLimitCfg.LineEvents)
+	explainFailureUsecase := usecase.NewExplainFailureUsecase(userRepo, lineService, logger)
+	syncLineProfileUsecase := usecase.NewSyncLineProfileUsecase(userRepo, lineService)
+	loginWithLineUsecase := usecase.NewLoginWithLineUsecase(lineLoginVerifier, userRepo, sessionSigner, refreshTokenRepo, authCfg.Session)
+	loginWithLiffUsecase := usecase.NewLoginWithLiffUsecase(lineLoginVerifier, userRepo, sessionSigner, refreshTokenRepo, authCfg.Session)
+	refreshSessionUsecase := usecase.NewRefreshSessionUsecase(refreshTokenRepo, userRepo, sessionSigner, authCfg.Session)
+	authenticateSessionUsecase := usecase.NewAuthenticateSessionUsecase(sessionSigner)
+	countInteractionsUsecase := usecase.NewCountInteractionsUsecase(interactionRepo)
+	checkReadinessUsecase := usecase.NewCheckReadinessUsecase(databaseProbe, lineService)
+	var recordLineWebhookUsecase *usecase.RecordLineWebhookUsecase
+	if recordCfg := loadWebhookRecordConfig(); recordWebhooks && recordCfg.File != "" {
+		redaction, err := parseWebhookRedaction(recordCfg.Redact)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("recording LINE webhooks", "file", recordCfg.File, "redact", strings.Join(recordCfg.Redact, ","))
+		recordRepo := infrastructure.NewInstrumentedLineWebhookRecordRepository(infrastructure.NewLineWebhookRecordRepository(recordCfg.File), observeCalls)
+		recordLineWebhookUsecase = usecase.NewRecordLineWebhookUsecase(recordRepo, redaction)
+	}
+
+	// Controllers
+	var static http.Handler
+	if serverCfg.StaticDir != "" {
+		if info, err := os.Stat(serverCfg.StaticDir); err != nil || !info.IsDir() {
+			return nil, fmt.Errorf("STATIC_DIR %q is not a directory", serverCfg.StaticDir)
+		}
+		static = controller.NewStaticHandler(serverCfg.StaticDir)
+	}
+	controllerMetrics := controller.NewMetrics(registry)
+	handler := controller.NewRouter(&controller.Controllers{
+		HealthController: controller.NewHealthController(checkReadinessUsecase),
+		WebhookController: controller.NewWebhookController(
+			requestInteractionUsecase,
+			joinCircleUsecase,
+			leaveCircleUsecase,
+			completeExchangeUsecase,
+			declineExchangeUsecase,
+			approveInteractionUsecase,
+			rejectInteractionUsecase,
+			blockUserUsecase,
+			throttleLineEventUsecase,
+			explainFailureUsecase,
+			syncLineProfileUsecase,
+			recordLineWebhookUsecase,
+			lineCfg.ChannelSecret,
+			controllerMetrics,
+		),
+		AttestationController: controller.NewAttestationController(getInteractionAttestationUsecase),
+		CircleController: controller.NewCircleController(
+			createCircleUsecase,
+			joinCircleUsecase,
+			leaveCircleUsecase,
+			postTraceUsecase,
+			listCircleTracesUsecase,
+		),
+		TraceController: controller.NewTraceController(postTraceUsecase, viewTraceUsecase),
+		ExchangeController: controller.NewExchangeController(
+			offerExchangeUsecase,
+			completeExchangeUsecase,
+			declineExchangeUsecase,
+		),
+		ConnectionController: controller.NewConnectionController(listConnectionsUsecase, releaseConnectionUsecase),
+		BlockController:      controller.NewBlockController(blockUserUsecase, unblockUserUsecase, listBlocksUsecase),
+		ReportController:     controller.NewReportController(reportUserUsecase),
+		UserController:       controller.NewUserController(getProfileUsecase, updateProfileUsecase, linkWalletUsecase),
+		InteractionController: controller.NewInteractionController(
+			listInteractionsUsecase,
+			requestInteractionUsecase,
+			approveInteractionUsecase,
+			rejectInteractionUsecase,
+		),
+		AuthController:    controller.NewAuthController(loginWithLineUsecase, loginWithLiffUsecase, refreshSessionUsecase),
+		MetricsController: controller.NewMetricsController(registry, countInteractionsUsecase),
+		Static:            static,
+	}, controllerMetrics)
+	handler = controller.SessionAuth(authenticateSessionUsecase)(handler)
+	// CORS goes outermost so preflights and error responses carry its headers.
+	handler = controller.CORS(serverCfg.CORSAllowedOrigins)(handler)
+	handler = controller.RequestLog(logger)(handler)
+	tracer, err := newTracer(loadTracingConfig(), logger)
+	if err != nil {
+		return nil, err
+	}
+	handler = controller.Tracing(tracer)(handler)
+
+	// Background jobs
+	var jobs []job
+	if relationshipCfg.DecayInterval > 0 {
+		jobs = append(jobs, job{"decay relationships", relationshipCfg.DecayInterval, func(ctx context.Context) error {
+			_, err := decayRelationshipsUsecase.Execute(ctx)
+			return err
+		}})
+	}
+
+	return &app{
+		handler:   handler,
+		tracer:    tracer,
+		readiness: checkReadinessUsecase,
+		jobs:      jobs,
+	}, nil
+}
+
+// shutdown exports the spans still buffered.
+func (a *app) shutdown(logger *slog.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := a.tracer.Shutdown(ctx); err != nil {
+		logger.Warn("failed to export remaining spans", "error", err)
+	}
+}
+
+// runAdmin wires the admin command and runs it.
+func runAdmin(ctx context.Context, args []string, logger *slog.Logger) error {
+	relationshipCfg, err := loadRelationshipConfig()
+	if err != nil {
+		return err
+	}
+	dbCfg := loadDatabaseConfig()
+	db, err := infrastructure.OpenDatabase(dbCfg.Dialect, dbCfg.DSN, logger)
+	if err != nil {
+		return err
+	}
+	attestationSigner, err := newAttestationSigner(loadServerConfig(), logger)
+	if err != nil {
+		return err
+	}
+
+	// Repositories
+	userRepo := infrastructure.NewUserRepository(db)
+	interactionRepo := infrastructure.NewInteractionRepository(db)
+	lineCfg := loadLineConfig()
+	lineService := infrastructure.NewLineService(lineCfg.ChannelAccessToken, lineCfg.APIURL, nil, logger)
+	walletVerifier := infrastructure.NewWalletVerifier()
+	relationshipRepo := infrastructure.NewRelationshipRepository(db)
+	reportRepo := infrastructure.NewReportRepository(db)
+
+	// Usecases
+	admin := cli.NewAdminCommand(
+		usecase.NewListUsersUsecase(userRepo),
+		usecase.NewFindUserUsecase(userRepo, walletVerifier),
+		usecase.NewListUserInteractionsUsecase(interactionRepo, userRepo),
+		usecase.NewOverrideInteractionStatusUsecase(
+			interactionRepo,
+			userRepo,
+			attestationSigner,
+			relationshipRepo,
+			relationshipCfg.Policy,
+		),
+		usecase.NewResendInteractionNotificationUsecase(interactionRepo, userRepo, lineService),
+		usecase.NewListReportsUsecase(reportRepo),
+		usecase.NewCloseReportUsecase(reportRepo),
+	)
+	return admin.Run(ctx, args, os.Stdout)
+}
+
+// runAttestation wires the offline attestation command and runs it.
+func runAttestation(ctx context.Context, args []string) error {
+	verify := usecase.NewVerifyAttestationUsecase(
+		infrastructure.NewAttestationVerifier(),
+		infrastructure.NewWalletVerifier(),
+	)
+	return cli.NewAttestationCommand(verify, os.Stdin).Run(ctx, args, os.Stdout)
+}
+
+// newAttestationSigner loads the configured signing key. Without one, an
+// ephemeral key is generated so local development works, but attestations
+// signed with it cannot be tied to this deployment after a restart.
+func newAttestationSigner(cfg serverConfig, logger *slog.Logger) (repository.AttestationSigner, error) {
+	if cfg.AttestationSigningKey != "" {
+		key, err := infrastructure.ParseSigningKey(cfg.AttestationSigningKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ATTESTATION_SIGNING_KEY: %w", err)
+		}
+		return infrastructure.NewAttestationSigner(key), nil
+	}
+
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate attestation signing key: %w", err)
+	}
+	logger.Warn("ATTESTATION_SIGNING_KEY not set, signing attestations with an ephemeral key", "signer", infrastructure.AddressOf(key))
+	return infrastructure.NewAttestationSigner(key), nil
+}
+
+// newSessionTokenSigner loads the access token secret. Without one, an
+// ephemeral secret is generated so local development works, but every
+// access token becomes invalid on restart and replicas reject each other's.
+func newSessionTokenSigner(cfg authConfig, logger *slog.Logger) (repository.SessionTokenSigner, error) {
+	if cfg.SessionSecret != "" {
+		if len(cfg.SessionSecret) < infrastructure.MinSessionSecretLength {
+			return nil, fmt.Errorf("SESSION_SECRET must be at least %d bytes", infrastructure.MinSessionSecretLength)
+		}
+		return infrastructure.NewSessionTokenSigner([]byte(cfg.SessionSecret)), nil
+	}
+
+	secret := make([]byte, infrastructure.MinSessionSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate session secret: %w", err)
+	}
+	logger.Warn("SESSION_SECRET not set, signing access tokens with an ephemeral secret")
+	return infrastructure.NewSessionTokenSigner(secret), nil
+}
+
+// newTracer picks where spans are exported. Without an exporter, trace
+// context is still passed along but no span is recorded.
+func newTracer(cfg tracingConfig, logger *slog.Logger) (*tracing.Tracer, error) {
+	switch cfg.Exporter {
+	case "none":
+		return tracing.NewTracer(nil, logger), nil
+	case "otlp":
+		logger.Info("exporting traces", "endpoint", cfg.Endpoint)
+		return tracing.NewTracer(tracing.NewOTLPExporter(cfg.Endpoint, cfg.Headers, cfg.ServiceName), logger), nil
+	default:
+		return nil, fmt.Errorf("unknown OTEL_TRACES_EXPORTER %q (want otlp or none)", cfg.Exporter)
+	}
+}
+
+// newLogger builds the logger every command writes its diagnostics to.
+// Logs go to stderr so command output on stdout stays clean.
+func newLogger(cfg logConfig) (*slog.Logger, error) {
+	level, err := logging.ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LOG_LEVEL: %w", err)
+	}
+	logger, err := logging.New(os.Stderr, cfg.Format, level)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LOG_FORMAT: %w", err)
+	}
+	return logger, nil
+}
+
+// newRateLimiter picks where token buckets are kept. The in-memory store is
+// enough for a single server; replicas need the shared SQL store.
+func newRateLimiter(cfg rateLimitConfig, db *gorm.DB) (repository.RateLimiter, error) {
+	switch cfg.Store {
+	case "memory":
+		return infrastructure.NewMemoryRateLimiter(), nil
+	case "sql":
+		return infrastructure.NewSQLRateLimiter(db), nil
+	default:
+		return nil, fmt.Errorf("unknown RATE_LIMIT_STORE %q (want memory or sql)", cfg.Store)
+	}
+}
+
+// runEvery calls job every interval until ctx is cancelled, each run in a
+// trace of its own. Errors are reported and the job is tried again on the
+// next tick.
+func runEvery(ctx context.Context, logger *slog.Logger, tracer *tracing.Tracer, interval time.Duration, name string, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			runCtx, span := tracer.Start(ctx, name, tracing.KindInternal)
+			runCtx = logging.With(runCtx, slog.String(logging.KeyTraceID, span.SpanContext().TraceID.String()))
+			if err := job(runCtx); err != nil {
+				span.SetError(err)
+				logger.ErrorContext(runCtx, "background job failed", "job", name, "error", err)
+			}
+			span.End()
+		}
+	}
+}
+
+// runReplay wires the replay command and runs it. Without -url, records are
+// fed to the API in-process, against the configured database.
+func runReplay(ctx context.Context, args []string, logger *slog.Logger) error {
+	var local *app
+	defer func() {
+		if local != nil {
+			local.shutdown(logger)
+		}
+	}()
+
+	replay := cli.NewReplayCommand(
+		func(path string) *usecase.ListLineWebhookRecordsUsecase {
+			return usecase.NewListLineWebhookRecordsUsecase(infrastructure.NewLineWebhookRecordRepository(path))
+		},
+		func() (http.Handler, error) {
+			a, err := newApp(logger, false)
+			if err != nil {
+				return nil, err
+			}
+			local = a
+			return a.handler, nil
+		},
+		loadLineConfig().ChannelSecret,
+	)
+	return replay.Run(ctx, args, os.Stdout)
+}
+
+// parseWebhookRedaction reads WEBHOOK_RECORD_REDACT options.
+func parseWebhookRedaction(names []string) (usecase.WebhookRedaction, error) {
+	var redaction usecase.WebhookRedaction
+	for _, name := range names {
+		switch name {
+		case "user_ids":
+			redaction.UserIDs = true
+		case "text":
+			redaction.Text = true
+		case "reply_tokens":
+			redaction.ReplyTokens = true
+		case "none":
+		default:
+			return usecase.WebhookRedaction{}, fmt.Errorf("unknown WEBHOOK_RECORD_REDACT option %q (want user_ids, text, reply_tokens or none)", name)
+		}
+	}
+	return redaction, nil
 }
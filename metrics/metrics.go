@@ -0,0 +1,330 @@
+// Package metrics keeps counters and histograms in memory and writes them in
+// the Prometheus text exposition format, so any Prometheus-compatible
+// scraper can collect them from the /metrics endpoint.
+package metrics
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets suit latencies of database queries and API calls, in seconds.
+var DefaultBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+var namePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Registry holds every metric the process exposes.
+type Registry struct {
+	mu       sync.Mutex
+	families map[string]family
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{families: map[string]family{}}
+}
+
+// family is one named metric with all its label combinations.
+type family interface {
+	write(ctx context.Context, w *bufio.Writer) error
+}
+
+// register adds f under name. Metric names are fixed in code, so a bad or
+// duplicate one is a programming error and panics.
+func (r *Registry) register(name string, labels []string, f family) {
+	if !namePattern.MatchString(name) {
+		panic(fmt.Sprintf("metrics: invalid metric name %q", name))
+	}
+	for _, label := range labels {
+		if !namePattern.MatchString(label) || strings.HasPrefix(label, "__") || label == "le" {
+			panic(fmt.Sprintf("metrics: invalid label name %q for %s", label, name))
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.families[name]; ok {
+		panic(fmt.Sprintf("metrics: %s registered twice", name))
+	}
+	r.families[name] = f
+}
+
+// WriteText writes every metric in the text exposition format, sorted by
+// name. Gauges whose collection fails are left out and their errors
+// returned, so one broken source does not hide the rest.
+func (r *Registry) WriteText(ctx context.Context, w io.Writer) error {
+	r.mu.Lock()
+	names := make([]string, 0, len(r.families))
+	for name := range r.families {
+		names = append(names, name)
+	}
+	families := make([]family, len(names))
+	sort.Strings(names)
+	for i, name := range names {
+		families[i] = r.families[name]
+	}
+	r.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+	var errs []error
+	for i, f := range families {
+		if err := f.write(ctx, bw); err != nil {
+			errs = append(errs, fmt.Errorf("failed to collect %s: %w", names[i], err))
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+// series holds the values of one metric keyed by its label values.
+type series[T any] struct {
+	mu     sync.Mutex
+	labels []string
+	values map[string]*T
+}
+
+func newSeries[T any](labels []string) *series[T] {
+	return &series[T]{labels: labels, values: map[string]*T{}}
+}
+
+// get returns the value for labelValues, creating it with init.
+func (s *series[T]) get(labelValues []string, init func() *T) *T {
+	if len(labelValues) != len(s.labels) {
+		panic(fmt.Sprintf("metrics: got %d label values for %d labels", len(labelValues), len(s.labels)))
+	}
+	key := strings.Join(labelValues, "\xff")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[key]
+	if !ok {
+		v = init()
+		s.values[key] = v
+	}
+	return v
+}
+
+// each calls fn for every label combination in a stable order.
+func (s *series[T]) each(fn func(labelValues []string, v *T)) {
+	s.mu.Lock()
+	keys := make([]string, 0, len(s.values))
+	for key := range s.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	values := make([]*T, len(keys))
+	for i, key := range keys {
+		values[i] = s.values[key]
+	}
+	s.mu.Unlock()
+
+	for i, key := range keys {
+		var labelValues []string
+		if len(s.labels) > 0 {
+			labelValues = strings.Split(key, "\xff")
+		}
+		fn(labelValues, values[i])
+	}
+}
+
+// CounterVec counts events, split by label values.
+type CounterVec struct {
+	name, help string
+	series     *series[counter]
+}
+
+type counter struct {
+	mu    sync.Mutex
+	value float64
+}
+
+// Counter registers a counter named name with the given labels.
+func (r *Registry) Counter(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{name: name, help: help, series: newSeries[counter](labels)}
+	r.register(name, labels, c)
+	return c
+}
+
+// Inc adds one to the counter for labelValues.
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds v, which must not be negative, to the counter for labelValues.
+func (c *CounterVec) Add(v float64, labelValues ...string) {
+	if v < 0 {
+		panic("metrics: counters cannot decrease")
+	}
+	ctr := c.series.get(labelValues, func() *counter { return &counter{} })
+	ctr.mu.Lock()
+	ctr.value += v
+	ctr.mu.Unlock()
+}
+
+func (c *CounterVec) write(_ context.Context, w *bufio.Writer) error {
+	writeHeader(w, c.name, c.help, "counter")
+	c.series.each(func(labelValues []string, ctr *counter) {
+		ctr.mu.Lock()
+		v := ctr.value
+		ctr.mu.Unlock()
+		writeSample(w, c.name, c.series.labels, labelValues, "", "", v)
+	})
+	return nil
+}
+
+// HistogramVec counts observations into buckets, split by label values.
+type HistogramVec struct {
+	name, help string
+	buckets    []float64
+	series     *series[histogram]
+}
+
+type histogram struct {
+	mu     sync.Mutex
+	counts []uint64 // per bucket, not cumulative
+	count  uint64
+	sum    float64
+}
+
+// Histogram registers a histogram named name with the given upper bucket
+// bounds, in increasing order, and labels.
+func (r *Registry) Histogram(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if !sort.Float64sAreSorted(buckets) {
+		panic(fmt.Sprintf("metrics: buckets of %s are not sorted", name))
+	}
+	h := &HistogramVec{name: name, help: help, buckets: buckets, series: newSeries[histogram](labels)}
+	r.register(name, labels, h)
+	return h
+}
+
+// Observe records v in the histogram for labelValues.
+func (h *HistogramVec) Observe(v float64, labelValues ...string) {
+	hist := h.series.get(labelValues, func() *histogram {
+		return &histogram{counts: make([]uint64, len(h.buckets))}
+	})
+	i := sort.SearchFloat64s(h.buckets, v)
+	hist.mu.Lock()
+	if i < len(h.buckets) {
+		hist.counts[i]++
+	}
+	hist.count++
+	hist.sum += v
+	hist.mu.Unlock()
+}
+
+func (h *HistogramVec) write(_ context.Context, w *bufio.Writer) error {
+	writeHeader(w, h.name, h.help, "histogram")
+	h.series.each(func(labelValues []string, hist *histogram) {
+		hist.mu.Lock()
+		counts := append([]uint64(nil), hist.counts...)
+		count, sum := hist.count, hist.sum
+		hist.mu.Unlock()
+
+		var cumulative uint64
+		for i, bound := range h.buckets {
+			cumulative += counts[i]
+			writeSample(w, h.name+"_bucket", h.series.labels, labelValues, "le", formatFloat(bound), float64(cumulative))
+		}
+		writeSample(w, h.name+"_bucket", h.series.labels, labelValues, "le", "+Inf", float64(count))
+		writeSample(w, h.name+"_sum", h.series.labels, labelValues, "", "", sum)
+		writeSample(w, h.name+"_count", h.series.labels, labelValues, "", "", float64(count))
+	})
+	return nil
+}
+
+// Sample is one value of a gauge, with its label values in the order the
+// gauge's labels were registered.
+type Sample struct {
+	LabelValues []string
+	Value       float64
+}
+
+// gaugeFunc asks for its values at scrape time, for quantities that live
+// elsewhere, such as row counts in the database.
+type gaugeFunc struct {
+	name, help string
+	labels     []string
+	collect    func(ctx context.Context) ([]Sample, error)
+}
+
+// GaugeFunc registers a gauge named name whose samples collect returns each
+// time the metrics are written.
+func (r *Registry) GaugeFunc(name, help string, labels []string, collect func(ctx context.Context) ([]Sample, error)) {
+	r.register(name, labels, &gaugeFunc{name: name, help: help, labels: labels, collect: collect})
+}
+
+func (g *gaugeFunc) write(ctx context.Context, w *bufio.Writer) error {
+	samples, err := g.collect(ctx)
+	if err != nil {
+		return err
+	}
+	for _, s := range samples {
+		if len(s.LabelValues) != len(g.labels) {
+			return fmt.Errorf("got %d label values for %d labels", len(s.LabelValues), len(g.labels))
+		}
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return strings.Join(samples[i].LabelValues, "\xff") < strings.Join(samples[j].LabelValues, "\xff")
+	})
+	writeHeader(w, g.name, g.help, "gauge")
+	for _, s := range samples {
+		writeSample(w, g.name, g.labels, s.LabelValues, "", "", s.Value)
+	}
+	return nil
+}
+
+func writeHeader(w *bufio.Writer, name, help, kind string) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, helpEscaper.Replace(help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, kind)
+}
+
+// writeSample writes one line. extraLabel, if set, is appended after the
+// metric's own labels, as "le" is for histogram buckets.
+func writeSample(w *bufio.Writer, name string, labels, labelValues []string, extraLabel, extraValue string, v float64) {
+	w.WriteString(name)
+	if len(labels) > 0 || extraLabel != "" {
+		w.WriteByte('{')
+		for i, label := range labels {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", label, labelEscaper.Replace(labelValues[i]))
+		}
+		if extraLabel != "" {
+			if len(labels) > 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", extraLabel, extraValue)
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(v))
+	w.WriteByte('\n')
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/dkpcb/pet/infrastructure"
+)
+
+const migrateUsage = `usage: traceriver migrate <command>
+
+commands:
+  up            apply all pending migrations
+  down [N]      revert the last N applied migrations (default 1)
+  status        list migrations and whether they are applied
+  version       print the current schema version
+  baseline N    record migrations up to N as applied without running them,
+                for a database whose schema already matches version N`
+
+// runMigrate implements the "migrate" subcommand.
+func runMigrate(ctx context.Context, args []string, out io.Writer, logger *slog.Logger) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.SetOutput(out)
+	fs.Usage = func() { fmt.Fprintln(out, migrateUsage) }
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("missing migrate command")
+	}
+
+	cfg := loadDatabaseConfig()
+	db, err := infrastructure.OpenDatabase(cfg.Dialect, cfg.DSN, logger)
+	if err != nil {
+		return err
+	}
+	migrator, err := infrastructure.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	switch cmd := fs.Arg(0); cmd {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Fprintf(out, "applied  %03d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(out, "no pending migrations")
+		}
+		return nil
+	case "down":
+		steps := 1
+		if fs.NArg() > 1 {
+			steps, err = strconv.Atoi(fs.Arg(1))
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps: %q", fs.Arg(1))
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, m := range reverted {
+			fmt.Fprintf(out, "reverted %03d_%s\n", m.Version, m.Name)
+		}
+		return err
+	case "baseline":
+		if fs.NArg() != 2 {
+			return fmt.Errorf("baseline needs the version the schema is at")
+		}
+		version, err := strconv.Atoi(fs.Arg(1))
+		if err != nil || version < 1 {
+			return fmt.Errorf("invalid version: %q", fs.Arg(1))
+		}
+		recorded, err := migrator.Baseline(ctx, version)
+		if err != nil {
+			return err
+		}
+		for _, m := range recorded {
+			fmt.Fprintf(out, "recorded %03d_%s\n", m.Version, m.Name)
+		}
+		if len(recorded) == 0 {
+			fmt.Fprintln(out, "nothing to record")
+		}
+		return nil
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.Applied {
+				appliedAt = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(out, "%03d_%-40s %s\n", s.Version, s.Name, appliedAt)
+		}
+		return nil
+	case "version":
+		version, err := migrator.Version(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "%d (latest %d)\n", version, migrator.LatestVersion())
+		return nil
+	default:
+		fs.Usage()
+		return fmt.Errorf("unknown migrate command: %q", cmd)
+	}
+}
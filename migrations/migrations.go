@@ -0,0 +1,24 @@
+// Package migrations embeds the SQL schema files for every supported database dialect.
+// Each dialect has its own directory because the schemas rely on dialect-specific types
+// (ENUM and ON UPDATE in MySQL, CHECK constraints and plain TEXT in SQLite,
+// native UUID and JSONB in PostgreSQL).
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+)
+
+//go:embed mysql/*.sql postgres/*.sql sqlite/*.sql
+var files embed.FS
+
+// FS returns the migration files for the given dialect (e.g. "mysql", "postgres", "sqlite").
+// Each version has an "NNN_description.up.sql" file and a matching
+// "NNN_description.down.sql" file that reverts it.
+func FS(dialect string) (fs.FS, error) {
+	if _, err := fs.Stat(files, dialect); err != nil {
+		return nil, fmt.Errorf("no migrations for dialect %q", dialect)
+	}
+	return fs.Sub(files, dialect)
+}
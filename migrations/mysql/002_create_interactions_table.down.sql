@@ -0,0 +1,2 @@
+-- Drop interactions table
+DROP TABLE interactions;
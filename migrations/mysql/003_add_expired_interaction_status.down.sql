@@ -0,0 +1,4 @@
+-- Disallow expired interactions
+UPDATE interactions SET status = 'rejected' WHERE status = 'expired';
+ALTER TABLE interactions
+    MODIFY status ENUM('pending', 'approved', 'rejected') NOT NULL DEFAULT 'pending' COMMENT 'Current interaction status';
@@ -0,0 +1,3 @@
+-- Allow interactions to expire
+ALTER TABLE interactions
+    MODIFY status ENUM('pending', 'approved', 'rejected', 'expired') NOT NULL DEFAULT 'pending' COMMENT 'Current interaction status';
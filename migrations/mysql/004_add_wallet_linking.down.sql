@@ -0,0 +1,6 @@
+-- Drop wallet_challenges table and allow duplicate wallets again
+ALTER TABLE users
+    DROP INDEX idx_wallet_address,
+    ADD INDEX idx_wallet_address (wallet_address);
+
+DROP TABLE wallet_challenges;
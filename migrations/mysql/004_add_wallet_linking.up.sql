@@ -0,0 +1,14 @@
+-- Create wallet_challenges table and make linked wallets unique
+CREATE TABLE wallet_challenges (
+    user_id VARCHAR(36) PRIMARY KEY COMMENT 'User ID the challenge was issued to',
+    wallet_address VARCHAR(42) NOT NULL COMMENT 'EIP-55 wallet address being linked',
+    nonce VARCHAR(64) NOT NULL COMMENT 'Random nonce embedded in the message',
+    message TEXT NOT NULL COMMENT 'Exact message the wallet must sign',
+    expires_at TIMESTAMP NOT NULL COMMENT 'Challenge expiry',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Outstanding wallet ownership challenges';
+
+ALTER TABLE users
+    DROP INDEX idx_wallet_address,
+    ADD UNIQUE INDEX idx_wallet_address (wallet_address);
@@ -0,0 +1,3 @@
+-- Store the signed attestation of approved interactions
+ALTER TABLE interactions
+    ADD COLUMN attestation JSON NULL COMMENT 'EIP-712 signed attestation, set once approved' AFTER metadata;
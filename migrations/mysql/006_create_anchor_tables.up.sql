@@ -0,0 +1,19 @@
+-- Create anchor batch tables
+CREATE TABLE anchor_batches (
+    epoch BIGINT PRIMARY KEY COMMENT 'Consecutive batch number starting at 1',
+    root CHAR(64) NOT NULL COMMENT 'Hex Merkle root of the batch',
+    leaf_count INT NOT NULL COMMENT 'Number of interactions in the batch',
+    tx_ref VARCHAR(255) NULL COMMENT 'Chain record of the root, NULL until anchored',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+    anchored_at TIMESTAMP NULL COMMENT 'When the root was published on-chain',
+    INDEX idx_anchored_at (anchored_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Merkle roots of approved interactions per epoch';
+
+CREATE TABLE anchor_leaves (
+    interaction_id VARCHAR(36) PRIMARY KEY COMMENT 'Approved interaction included in the batch',
+    epoch BIGINT NOT NULL COMMENT 'Batch the interaction belongs to',
+    position INT NOT NULL COMMENT 'Leaf index within the batch',
+    UNIQUE INDEX idx_epoch_position (epoch, position),
+    FOREIGN KEY (epoch) REFERENCES anchor_batches(epoch) ON DELETE CASCADE,
+    FOREIGN KEY (interaction_id) REFERENCES interactions(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Interactions rolled up into each anchor batch';
@@ -0,0 +1,35 @@
+-- Create circles, circle_members and traces tables
+CREATE TABLE circles (
+    id VARCHAR(36) PRIMARY KEY COMMENT 'UUID format circle identifier',
+    founder_id VARCHAR(36) NOT NULL COMMENT 'User ID of the founder',
+    name VARCHAR(255) NOT NULL COMMENT 'Circle name',
+    manifesto TEXT NOT NULL COMMENT 'What the circle believes in',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'Record update timestamp',
+    INDEX idx_founder_id (founder_id),
+    FOREIGN KEY (founder_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Founder-centred belief circles';
+
+CREATE TABLE circle_members (
+    circle_id VARCHAR(36) NOT NULL COMMENT 'Circle ID',
+    user_id VARCHAR(36) NOT NULL COMMENT 'Member user ID',
+    joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'When the user joined',
+    PRIMARY KEY (circle_id, user_id),
+    INDEX idx_user_id (user_id),
+    FOREIGN KEY (circle_id) REFERENCES circles(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Circle memberships';
+
+CREATE TABLE traces (
+    id VARCHAR(36) PRIMARY KEY COMMENT 'UUID format trace identifier',
+    author_id VARCHAR(36) NOT NULL COMMENT 'User ID of the author',
+    circle_id VARCHAR(36) NULL COMMENT 'Circle the trace is scoped to, if any',
+    body TEXT NOT NULL COMMENT 'Text of the expression',
+    media_url VARCHAR(2048) NULL COMMENT 'Artwork or media location',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'Record update timestamp',
+    INDEX idx_author_id (author_id),
+    INDEX idx_circle_id_created_at (circle_id, created_at),
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (circle_id) REFERENCES circles(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Posted expressions';
@@ -0,0 +1,3 @@
+-- Drop exchanges and relationships tables
+DROP TABLE relationships;
+DROP TABLE exchanges;
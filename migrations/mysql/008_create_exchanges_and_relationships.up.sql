@@ -0,0 +1,32 @@
+-- Create exchanges and relationships tables
+CREATE TABLE exchanges (
+    id VARCHAR(36) PRIMARY KEY COMMENT 'UUID format exchange identifier',
+    offerer_id VARCHAR(36) NOT NULL COMMENT 'User ID of the user offering a trace',
+    recipient_id VARCHAR(36) NOT NULL COMMENT 'User ID of the user the trace is offered to',
+    offered_trace_id VARCHAR(36) NOT NULL COMMENT 'Trace offered by the offerer',
+    response_trace_id VARCHAR(36) NULL COMMENT 'Trace given back by the recipient',
+    status ENUM('pending', 'completed', 'declined') NOT NULL DEFAULT 'pending' COMMENT 'Current exchange status',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+    responded_at TIMESTAMP NULL COMMENT 'When the recipient completed or declined the exchange',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'Record update timestamp',
+    INDEX idx_offerer_id (offerer_id),
+    INDEX idx_recipient_id (recipient_id),
+    FOREIGN KEY (offerer_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (recipient_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (offered_trace_id) REFERENCES traces(id) ON DELETE CASCADE,
+    FOREIGN KEY (response_trace_id) REFERENCES traces(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Reciprocal trace exchanges between users';
+
+CREATE TABLE relationships (
+    user_a_id VARCHAR(36) NOT NULL COMMENT 'Smaller user ID of the pair',
+    user_b_id VARCHAR(36) NOT NULL COMMENT 'Larger user ID of the pair',
+    exchange_count INT NOT NULL DEFAULT 0 COMMENT 'Number of completed exchanges between the pair',
+    last_exchanged_at TIMESTAMP NULL COMMENT 'When the pair last completed an exchange',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'Record update timestamp',
+    PRIMARY KEY (user_a_id, user_b_id),
+    INDEX idx_user_b_id (user_b_id),
+    CONSTRAINT chk_relationships_order CHECK (user_a_id < user_b_id),
+    FOREIGN KEY (user_a_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_b_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Undirected relationship edges between users';
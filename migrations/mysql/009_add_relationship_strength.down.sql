@@ -0,0 +1,7 @@
+-- Remove decaying strength and view counts from relationships
+ALTER TABLE relationships
+    DROP INDEX idx_strength,
+    DROP COLUMN decayed_at,
+    DROP COLUMN strength,
+    DROP COLUMN view_count_b,
+    DROP COLUMN view_count_a;
@@ -0,0 +1,17 @@
+-- Add decaying strength and view counts to relationships
+ALTER TABLE relationships
+    ADD COLUMN view_count_a INT NOT NULL DEFAULT 0 COMMENT 'Times user A viewed traces of user B' AFTER last_exchanged_at,
+    ADD COLUMN view_count_b INT NOT NULL DEFAULT 0 COMMENT 'Times user B viewed traces of user A' AFTER view_count_a,
+    ADD COLUMN strength DOUBLE NOT NULL DEFAULT 0 COMMENT 'Relationship strength, halving every half-life without activity' AFTER view_count_b,
+    ADD COLUMN decayed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'When strength was last brought up to date' AFTER strength,
+    ADD INDEX idx_strength (strength);
+
+-- Existing edges start fresh: exchanges so far, plus one meeting per approved interaction
+UPDATE relationships SET strength = exchange_count * 1.0, decayed_at = CURRENT_TIMESTAMP;
+
+INSERT INTO relationships (user_a_id, user_b_id, strength, decayed_at, created_at, updated_at)
+SELECT LEAST(requester_id, approver_id), GREATEST(requester_id, approver_id), COUNT(*) * 1.0, CURRENT_TIMESTAMP, MIN(created_at), CURRENT_TIMESTAMP
+FROM interactions
+WHERE status = 'approved'
+GROUP BY LEAST(requester_id, approver_id), GREATEST(requester_id, approver_id)
+ON DUPLICATE KEY UPDATE strength = relationships.strength + VALUES(strength);
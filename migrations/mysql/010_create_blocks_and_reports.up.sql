@@ -0,0 +1,28 @@
+-- Create blocks and reports tables
+CREATE TABLE blocks (
+    blocker_id VARCHAR(36) NOT NULL COMMENT 'User ID of the user who blocked',
+    blocked_id VARCHAR(36) NOT NULL COMMENT 'User ID of the blocked user',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'When the block was placed',
+    PRIMARY KEY (blocker_id, blocked_id),
+    INDEX idx_blocked_id (blocked_id),
+    CONSTRAINT chk_blocks_self CHECK (blocker_id <> blocked_id),
+    FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Users who blocked other users';
+
+CREATE TABLE reports (
+    id VARCHAR(36) PRIMARY KEY COMMENT 'UUID format report identifier',
+    reporter_id VARCHAR(36) NOT NULL COMMENT 'User ID of the user filing the report',
+    reported_id VARCHAR(36) NOT NULL COMMENT 'User ID of the reported user',
+    reason ENUM('spam', 'harassment', 'impersonation', 'inappropriate_content', 'other') NOT NULL COMMENT 'Reason category chosen by the reporter',
+    details TEXT NOT NULL COMMENT 'Free-form description from the reporter',
+    status ENUM('open', 'resolved', 'dismissed') NOT NULL DEFAULT 'open' COMMENT 'Moderation status',
+    resolution_note TEXT NULL COMMENT 'Note left by the moderator who closed the report',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'Record creation timestamp',
+    closed_at TIMESTAMP NULL COMMENT 'When a moderator resolved or dismissed the report',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'Record update timestamp',
+    INDEX idx_reports_status_created_at (status, created_at),
+    INDEX idx_reported_id (reported_id),
+    FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (reported_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='User reports awaiting or past moderation';
@@ -0,0 +1,6 @@
+-- Create rate_limit_buckets table
+CREATE TABLE rate_limit_buckets (
+    bucket_key VARCHAR(191) PRIMARY KEY COMMENT 'What is limited and for whom, e.g. interaction_request:{userId}',
+    tokens DOUBLE NOT NULL COMMENT 'Tokens left as of updated_at',
+    updated_at TIMESTAMP(6) NOT NULL COMMENT 'When tokens was last refilled and taken from'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Token buckets shared by every server replica';
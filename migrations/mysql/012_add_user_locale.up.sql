@@ -0,0 +1,3 @@
+-- Add the language LINE messages are sent to each user in
+ALTER TABLE users
+    ADD COLUMN locale VARCHAR(16) NOT NULL DEFAULT 'ja' COMMENT 'Language of LINE messages, from the LINE profile' AFTER display_name;
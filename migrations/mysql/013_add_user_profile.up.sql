@@ -0,0 +1,4 @@
+-- Add a self-written profile to users
+ALTER TABLE users
+    ADD COLUMN bio VARCHAR(2000) NOT NULL DEFAULT '' COMMENT 'Self-introduction shown on the profile' AFTER display_name,
+    ADD COLUMN avatar_url VARCHAR(2048) NULL COMMENT 'Profile image location' AFTER bio;
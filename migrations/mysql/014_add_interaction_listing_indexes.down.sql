@@ -0,0 +1,4 @@
+-- Drop the interaction listing indexes
+ALTER TABLE interactions
+    DROP INDEX idx_approver_created_at,
+    DROP INDEX idx_requester_created_at;
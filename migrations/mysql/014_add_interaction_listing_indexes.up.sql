@@ -0,0 +1,4 @@
+-- Serve newest-first interaction pages per requester and per approver
+ALTER TABLE interactions
+    ADD INDEX idx_requester_created_at (requester_id, created_at, id),
+    ADD INDEX idx_approver_created_at (approver_id, created_at, id);
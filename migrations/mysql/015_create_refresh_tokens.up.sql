@@ -0,0 +1,12 @@
+-- Create refresh_tokens table
+CREATE TABLE refresh_tokens (
+    id VARCHAR(36) PRIMARY KEY COMMENT 'UUID format token identifier',
+    user_id VARCHAR(36) NOT NULL COMMENT 'User the token was issued to',
+    token_hash CHAR(64) NOT NULL COMMENT 'Hex SHA-256 of the token, the token itself is never stored',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'When the token was issued',
+    expires_at TIMESTAMP NOT NULL COMMENT 'When the token stops being accepted',
+    revoked_at TIMESTAMP NULL COMMENT 'When the token was used up or revoked',
+    UNIQUE INDEX idx_refresh_tokens_token_hash (token_hash),
+    INDEX idx_refresh_tokens_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Refresh tokens of REST API sessions';
@@ -0,0 +1,4 @@
+-- Remove full_at from rate_limit_buckets
+ALTER TABLE rate_limit_buckets
+    DROP INDEX idx_rate_limit_buckets_full_at,
+    DROP COLUMN full_at;
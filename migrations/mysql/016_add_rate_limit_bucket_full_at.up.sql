@@ -0,0 +1,7 @@
+-- Record when each rate limit bucket is full again, so full buckets can be swept
+ALTER TABLE rate_limit_buckets
+    ADD COLUMN full_at TIMESTAMP(6) NULL COMMENT 'When tokens is back at the burst and the row can be deleted, NULL if never' AFTER updated_at,
+    ADD INDEX idx_rate_limit_buckets_full_at (full_at);
+
+-- The limit of existing buckets is unknown, and sweeping them early only refills them early
+UPDATE rate_limit_buckets SET full_at = updated_at;
@@ -0,0 +1,18 @@
+-- Create users table
+CREATE TABLE users (
+    id UUID PRIMARY KEY,
+    line_user_id VARCHAR(255) NOT NULL UNIQUE,
+    display_name VARCHAR(255) NOT NULL,
+    wallet_address VARCHAR(255) NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_wallet_address ON users (wallet_address);
+
+COMMENT ON TABLE users IS 'User account information';
+COMMENT ON COLUMN users.id IS 'UUID format user identifier';
+COMMENT ON COLUMN users.line_user_id IS 'LINE user ID';
+COMMENT ON COLUMN users.display_name IS 'User display name';
+COMMENT ON COLUMN users.wallet_address IS 'Blockchain wallet address';
+COMMENT ON COLUMN users.updated_at IS 'Record update timestamp (maintained by GORM)';
@@ -0,0 +1,25 @@
+-- Create interactions table
+CREATE TABLE interactions (
+    id UUID PRIMARY KEY,
+    requester_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    approver_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    metadata JSONB NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_interactions_status CHECK (status IN ('pending', 'approved', 'rejected'))
+);
+
+CREATE INDEX idx_requester_id ON interactions (requester_id);
+CREATE INDEX idx_approver_id ON interactions (approver_id);
+CREATE INDEX idx_status ON interactions (status);
+CREATE INDEX idx_created_at ON interactions (created_at);
+-- jsonb_path_ops keeps the index small and serves the @> containment queries
+-- issued by InteractionRepository.FindByMetadata.
+CREATE INDEX idx_metadata ON interactions USING GIN (metadata jsonb_path_ops);
+
+COMMENT ON TABLE interactions IS 'User interaction records';
+COMMENT ON COLUMN interactions.requester_id IS 'User ID who initiated the interaction';
+COMMENT ON COLUMN interactions.approver_id IS 'User ID who approves the interaction';
+COMMENT ON COLUMN interactions.status IS 'Current interaction status';
+COMMENT ON COLUMN interactions.metadata IS 'Additional metadata for the interaction';
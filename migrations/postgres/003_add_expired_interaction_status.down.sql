@@ -0,0 +1,5 @@
+-- Disallow expired interactions
+UPDATE interactions SET status = 'rejected' WHERE status = 'expired';
+ALTER TABLE interactions DROP CONSTRAINT chk_interactions_status;
+ALTER TABLE interactions
+    ADD CONSTRAINT chk_interactions_status CHECK (status IN ('pending', 'approved', 'rejected'));
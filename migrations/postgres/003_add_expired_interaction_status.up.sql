@@ -0,0 +1,4 @@
+-- Allow interactions to expire
+ALTER TABLE interactions DROP CONSTRAINT chk_interactions_status;
+ALTER TABLE interactions
+    ADD CONSTRAINT chk_interactions_status CHECK (status IN ('pending', 'approved', 'rejected', 'expired'));
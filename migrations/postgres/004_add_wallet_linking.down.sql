@@ -0,0 +1,5 @@
+-- Drop wallet_challenges table and allow duplicate wallets again
+DROP INDEX idx_wallet_address;
+CREATE INDEX idx_wallet_address ON users (wallet_address);
+
+DROP TABLE wallet_challenges;
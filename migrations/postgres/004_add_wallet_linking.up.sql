@@ -0,0 +1,16 @@
+-- Create wallet_challenges table and make linked wallets unique
+CREATE TABLE wallet_challenges (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    wallet_address VARCHAR(42) NOT NULL,
+    nonce VARCHAR(64) NOT NULL,
+    message TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE wallet_challenges IS 'Outstanding wallet ownership challenges';
+COMMENT ON COLUMN wallet_challenges.wallet_address IS 'EIP-55 wallet address being linked';
+COMMENT ON COLUMN wallet_challenges.message IS 'Exact message the wallet must sign';
+
+DROP INDEX idx_wallet_address;
+CREATE UNIQUE INDEX idx_wallet_address ON users (wallet_address);
@@ -0,0 +1,2 @@
+-- Drop interaction attestations
+ALTER TABLE interactions DROP COLUMN attestation;
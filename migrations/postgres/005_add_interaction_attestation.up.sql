@@ -0,0 +1,4 @@
+-- Store the signed attestation of approved interactions
+ALTER TABLE interactions ADD COLUMN attestation JSONB NULL;
+
+COMMENT ON COLUMN interactions.attestation IS 'EIP-712 signed attestation, set once approved';
@@ -0,0 +1,3 @@
+-- Drop anchor batch tables
+DROP TABLE anchor_leaves;
+DROP TABLE anchor_batches;
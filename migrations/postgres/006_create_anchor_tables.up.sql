@@ -0,0 +1,24 @@
+-- Create anchor batch tables
+CREATE TABLE anchor_batches (
+    epoch BIGINT PRIMARY KEY,
+    root CHAR(64) NOT NULL,
+    leaf_count INT NOT NULL,
+    tx_ref VARCHAR(255) NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    anchored_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX idx_anchored_at ON anchor_batches (anchored_at);
+
+COMMENT ON TABLE anchor_batches IS 'Merkle roots of approved interactions per epoch';
+COMMENT ON COLUMN anchor_batches.epoch IS 'Consecutive batch number starting at 1';
+COMMENT ON COLUMN anchor_batches.tx_ref IS 'Chain record of the root, NULL until anchored';
+
+CREATE TABLE anchor_leaves (
+    interaction_id UUID PRIMARY KEY REFERENCES interactions(id) ON DELETE CASCADE,
+    epoch BIGINT NOT NULL REFERENCES anchor_batches(epoch) ON DELETE CASCADE,
+    position INT NOT NULL,
+    CONSTRAINT uq_anchor_leaves_epoch_position UNIQUE (epoch, position)
+);
+
+COMMENT ON TABLE anchor_leaves IS 'Interactions rolled up into each anchor batch';
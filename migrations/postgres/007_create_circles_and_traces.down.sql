@@ -0,0 +1,4 @@
+-- Drop circles, circle_members and traces tables
+DROP TABLE traces;
+DROP TABLE circle_members;
+DROP TABLE circles;
@@ -0,0 +1,41 @@
+-- Create circles, circle_members and traces tables
+CREATE TABLE circles (
+    id UUID PRIMARY KEY,
+    founder_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    manifesto TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_founder_id ON circles (founder_id);
+
+COMMENT ON TABLE circles IS 'Founder-centred belief circles';
+COMMENT ON COLUMN circles.manifesto IS 'What the circle believes in';
+
+CREATE TABLE circle_members (
+    circle_id UUID NOT NULL REFERENCES circles(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (circle_id, user_id)
+);
+
+CREATE INDEX idx_circle_members_user_id ON circle_members (user_id);
+
+COMMENT ON TABLE circle_members IS 'Circle memberships';
+
+CREATE TABLE traces (
+    id UUID PRIMARY KEY,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    circle_id UUID NULL REFERENCES circles(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    media_url VARCHAR(2048) NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_author_id ON traces (author_id);
+CREATE INDEX idx_circle_id_created_at ON traces (circle_id, created_at);
+
+COMMENT ON TABLE traces IS 'Posted expressions';
+COMMENT ON COLUMN traces.circle_id IS 'Circle the trace is scoped to, if any';
@@ -0,0 +1,36 @@
+-- Create exchanges and relationships tables
+CREATE TABLE exchanges (
+    id UUID PRIMARY KEY,
+    offerer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    recipient_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    offered_trace_id UUID NOT NULL REFERENCES traces(id) ON DELETE CASCADE,
+    response_trace_id UUID NULL REFERENCES traces(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    responded_at TIMESTAMPTZ NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_exchanges_status CHECK (status IN ('pending', 'completed', 'declined'))
+);
+
+CREATE INDEX idx_offerer_id ON exchanges (offerer_id);
+CREATE INDEX idx_recipient_id ON exchanges (recipient_id);
+
+COMMENT ON TABLE exchanges IS 'Reciprocal trace exchanges between users';
+COMMENT ON COLUMN exchanges.response_trace_id IS 'Trace given back by the recipient';
+
+CREATE TABLE relationships (
+    user_a_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    user_b_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    exchange_count INTEGER NOT NULL DEFAULT 0,
+    last_exchanged_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_a_id, user_b_id),
+    CONSTRAINT chk_relationships_order CHECK (user_a_id < user_b_id)
+);
+
+CREATE INDEX idx_relationships_user_b_id ON relationships (user_b_id);
+
+COMMENT ON TABLE relationships IS 'Undirected relationship edges between users';
+COMMENT ON COLUMN relationships.user_a_id IS 'Smaller user ID of the pair';
+COMMENT ON COLUMN relationships.exchange_count IS 'Number of completed exchanges between the pair';
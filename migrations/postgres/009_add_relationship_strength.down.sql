@@ -0,0 +1,7 @@
+-- Remove decaying strength and view counts from relationships
+DROP INDEX idx_relationships_strength;
+ALTER TABLE relationships
+    DROP COLUMN decayed_at,
+    DROP COLUMN strength,
+    DROP COLUMN view_count_b,
+    DROP COLUMN view_count_a;
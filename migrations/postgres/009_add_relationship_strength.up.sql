@@ -0,0 +1,23 @@
+-- Add decaying strength and view counts to relationships
+ALTER TABLE relationships
+    ADD COLUMN view_count_a INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN view_count_b INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN strength DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN decayed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX idx_relationships_strength ON relationships (strength);
+
+COMMENT ON COLUMN relationships.view_count_a IS 'Times user A viewed traces of user B';
+COMMENT ON COLUMN relationships.view_count_b IS 'Times user B viewed traces of user A';
+COMMENT ON COLUMN relationships.strength IS 'Relationship strength, halving every half-life without activity';
+COMMENT ON COLUMN relationships.decayed_at IS 'When strength was last brought up to date';
+
+-- Existing edges start fresh: exchanges so far, plus one meeting per approved interaction
+UPDATE relationships SET strength = exchange_count * 1.0, decayed_at = CURRENT_TIMESTAMP;
+
+INSERT INTO relationships (user_a_id, user_b_id, strength, decayed_at, created_at, updated_at)
+SELECT LEAST(requester_id, approver_id), GREATEST(requester_id, approver_id), COUNT(*) * 1.0, CURRENT_TIMESTAMP, MIN(created_at), CURRENT_TIMESTAMP
+FROM interactions
+WHERE status = 'approved'
+GROUP BY LEAST(requester_id, approver_id), GREATEST(requester_id, approver_id)
+ON CONFLICT (user_a_id, user_b_id) DO UPDATE SET strength = relationships.strength + EXCLUDED.strength;
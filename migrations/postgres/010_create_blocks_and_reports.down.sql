@@ -0,0 +1,3 @@
+-- Drop blocks and reports tables
+DROP TABLE reports;
+DROP TABLE blocks;
@@ -0,0 +1,35 @@
+-- Create blocks and reports tables
+CREATE TABLE blocks (
+    blocker_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    blocked_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CONSTRAINT chk_blocks_self CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX idx_blocked_id ON blocks (blocked_id);
+
+COMMENT ON TABLE blocks IS 'Users who blocked other users';
+COMMENT ON COLUMN blocks.blocker_id IS 'User ID of the user who blocked';
+
+CREATE TABLE reports (
+    id UUID PRIMARY KEY,
+    reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reported_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(32) NOT NULL,
+    details TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    resolution_note TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    closed_at TIMESTAMPTZ NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_reports_reason CHECK (reason IN ('spam', 'harassment', 'impersonation', 'inappropriate_content', 'other')),
+    CONSTRAINT chk_reports_status CHECK (status IN ('open', 'resolved', 'dismissed'))
+);
+
+CREATE INDEX idx_reports_status_created_at ON reports (status, created_at);
+CREATE INDEX idx_reported_id ON reports (reported_id);
+
+COMMENT ON TABLE reports IS 'User reports awaiting or past moderation';
+COMMENT ON COLUMN reports.reason IS 'Reason category chosen by the reporter';
+COMMENT ON COLUMN reports.resolution_note IS 'Note left by the moderator who closed the report';
@@ -0,0 +1,10 @@
+-- Create rate_limit_buckets table
+CREATE TABLE rate_limit_buckets (
+    bucket_key VARCHAR(191) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+COMMENT ON TABLE rate_limit_buckets IS 'Token buckets shared by every server replica';
+COMMENT ON COLUMN rate_limit_buckets.bucket_key IS 'What is limited and for whom, e.g. interaction_request:{userId}';
+COMMENT ON COLUMN rate_limit_buckets.tokens IS 'Tokens left as of updated_at';
@@ -0,0 +1,4 @@
+-- Remove the self-written profile from users
+ALTER TABLE users
+    DROP COLUMN avatar_url,
+    DROP COLUMN bio;
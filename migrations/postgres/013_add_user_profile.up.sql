@@ -0,0 +1,7 @@
+-- Add a self-written profile to users
+ALTER TABLE users
+    ADD COLUMN bio VARCHAR(2000) NOT NULL DEFAULT '',
+    ADD COLUMN avatar_url VARCHAR(2048) NULL;
+
+COMMENT ON COLUMN users.bio IS 'Self-introduction shown on the profile';
+COMMENT ON COLUMN users.avatar_url IS 'Profile image location';
@@ -0,0 +1,3 @@
+-- Drop the interaction listing indexes
+DROP INDEX idx_approver_created_at;
+DROP INDEX idx_requester_created_at;
@@ -0,0 +1,16 @@
+-- Create refresh_tokens table
+CREATE TABLE refresh_tokens (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ NULL
+);
+
+CREATE UNIQUE INDEX idx_refresh_tokens_token_hash ON refresh_tokens (token_hash);
+CREATE INDEX idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+
+COMMENT ON TABLE refresh_tokens IS 'Refresh tokens of REST API sessions';
+COMMENT ON COLUMN refresh_tokens.token_hash IS 'Hex SHA-256 of the token, the token itself is never stored';
+COMMENT ON COLUMN refresh_tokens.revoked_at IS 'When the token was used up or revoked';
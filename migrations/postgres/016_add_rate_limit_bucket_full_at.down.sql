@@ -0,0 +1,3 @@
+-- Remove full_at from rate_limit_buckets
+DROP INDEX idx_rate_limit_buckets_full_at;
+ALTER TABLE rate_limit_buckets DROP COLUMN full_at;
@@ -0,0 +1,11 @@
+-- Create users table
+CREATE TABLE users (
+    id VARCHAR(36) PRIMARY KEY, -- UUID format user identifier
+    line_user_id VARCHAR(255) NOT NULL UNIQUE, -- LINE user ID
+    display_name VARCHAR(255) NOT NULL, -- User display name
+    wallet_address VARCHAR(255) NULL, -- Blockchain wallet address
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP -- Record update timestamp (maintained by GORM)
+);
+
+CREATE INDEX idx_wallet_address ON users (wallet_address);
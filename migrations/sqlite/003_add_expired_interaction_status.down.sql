@@ -0,0 +1,23 @@
+-- Disallow expired interactions
+UPDATE interactions SET status = 'rejected' WHERE status = 'expired';
+
+CREATE TABLE interactions_new (
+    id VARCHAR(36) PRIMARY KEY, -- UUID format interaction identifier
+    requester_id VARCHAR(36) NOT NULL, -- User ID who initiated the interaction
+    approver_id VARCHAR(36) NOT NULL, -- User ID who approves the interaction
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')), -- Current interaction status
+    metadata TEXT NULL, -- Additional metadata for the interaction (JSON)
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record update timestamp (maintained by GORM)
+    FOREIGN KEY (requester_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (approver_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+INSERT INTO interactions_new SELECT id, requester_id, approver_id, status, metadata, created_at, updated_at FROM interactions;
+DROP TABLE interactions;
+ALTER TABLE interactions_new RENAME TO interactions;
+
+CREATE INDEX idx_requester_id ON interactions (requester_id);
+CREATE INDEX idx_approver_id ON interactions (approver_id);
+CREATE INDEX idx_status ON interactions (status);
+CREATE INDEX idx_created_at ON interactions (created_at);
@@ -0,0 +1,13 @@
+-- Create wallet_challenges table and make linked wallets unique
+CREATE TABLE wallet_challenges (
+    user_id VARCHAR(36) PRIMARY KEY, -- User ID the challenge was issued to
+    wallet_address VARCHAR(42) NOT NULL, -- EIP-55 wallet address being linked
+    nonce VARCHAR(64) NOT NULL, -- Random nonce embedded in the message
+    message TEXT NOT NULL, -- Exact message the wallet must sign
+    expires_at DATETIME NOT NULL, -- Challenge expiry
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+DROP INDEX idx_wallet_address;
+CREATE UNIQUE INDEX idx_wallet_address ON users (wallet_address);
@@ -0,0 +1,2 @@
+-- Store the signed attestation of approved interactions
+ALTER TABLE interactions ADD COLUMN attestation TEXT NULL; -- EIP-712 signed attestation (JSON), set once approved
@@ -0,0 +1,21 @@
+-- Create anchor batch tables
+CREATE TABLE anchor_batches (
+    epoch BIGINT PRIMARY KEY, -- Consecutive batch number starting at 1
+    root CHAR(64) NOT NULL, -- Hex Merkle root of the batch
+    leaf_count INT NOT NULL, -- Number of interactions in the batch
+    tx_ref VARCHAR(255) NULL, -- Chain record of the root, NULL until anchored
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+    anchored_at DATETIME NULL -- When the root was published on-chain
+);
+
+CREATE INDEX idx_anchored_at ON anchor_batches (anchored_at);
+
+CREATE TABLE anchor_leaves (
+    interaction_id VARCHAR(36) PRIMARY KEY, -- Approved interaction included in the batch
+    epoch BIGINT NOT NULL, -- Batch the interaction belongs to
+    position INT NOT NULL, -- Leaf index within the batch
+    FOREIGN KEY (epoch) REFERENCES anchor_batches(epoch) ON DELETE CASCADE,
+    FOREIGN KEY (interaction_id) REFERENCES interactions(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_epoch_position ON anchor_leaves (epoch, position);
@@ -0,0 +1,38 @@
+-- Create circles, circle_members and traces tables
+CREATE TABLE circles (
+    id VARCHAR(36) PRIMARY KEY, -- UUID format circle identifier
+    founder_id VARCHAR(36) NOT NULL, -- User ID of the founder
+    name VARCHAR(255) NOT NULL, -- Circle name
+    manifesto TEXT NOT NULL, -- What the circle believes in
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record update timestamp (maintained by GORM)
+    FOREIGN KEY (founder_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_founder_id ON circles (founder_id);
+
+CREATE TABLE circle_members (
+    circle_id VARCHAR(36) NOT NULL, -- Circle ID
+    user_id VARCHAR(36) NOT NULL, -- Member user ID
+    joined_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- When the user joined
+    PRIMARY KEY (circle_id, user_id),
+    FOREIGN KEY (circle_id) REFERENCES circles(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_circle_members_user_id ON circle_members (user_id);
+
+CREATE TABLE traces (
+    id VARCHAR(36) PRIMARY KEY, -- UUID format trace identifier
+    author_id VARCHAR(36) NOT NULL, -- User ID of the author
+    circle_id VARCHAR(36) NULL, -- Circle the trace is scoped to, if any
+    body TEXT NOT NULL, -- Text of the expression
+    media_url VARCHAR(2048) NULL, -- Artwork or media location
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record update timestamp (maintained by GORM)
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (circle_id) REFERENCES circles(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_author_id ON traces (author_id);
+CREATE INDEX idx_circle_id_created_at ON traces (circle_id, created_at);
@@ -0,0 +1,34 @@
+-- Create exchanges and relationships tables
+CREATE TABLE exchanges (
+    id VARCHAR(36) PRIMARY KEY, -- UUID format exchange identifier
+    offerer_id VARCHAR(36) NOT NULL, -- User ID of the user offering a trace
+    recipient_id VARCHAR(36) NOT NULL, -- User ID of the user the trace is offered to
+    offered_trace_id VARCHAR(36) NOT NULL, -- Trace offered by the offerer
+    response_trace_id VARCHAR(36) NULL, -- Trace given back by the recipient
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'completed', 'declined')), -- Current exchange status
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+    responded_at DATETIME NULL, -- When the recipient completed or declined the exchange
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record update timestamp (maintained by GORM)
+    FOREIGN KEY (offerer_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (recipient_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (offered_trace_id) REFERENCES traces(id) ON DELETE CASCADE,
+    FOREIGN KEY (response_trace_id) REFERENCES traces(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_offerer_id ON exchanges (offerer_id);
+CREATE INDEX idx_recipient_id ON exchanges (recipient_id);
+
+CREATE TABLE relationships (
+    user_a_id VARCHAR(36) NOT NULL, -- Smaller user ID of the pair
+    user_b_id VARCHAR(36) NOT NULL, -- Larger user ID of the pair
+    exchange_count INTEGER NOT NULL DEFAULT 0, -- Number of completed exchanges between the pair
+    last_exchanged_at DATETIME NULL, -- When the pair last completed an exchange
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record update timestamp (maintained by GORM)
+    PRIMARY KEY (user_a_id, user_b_id),
+    CHECK (user_a_id < user_b_id),
+    FOREIGN KEY (user_a_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_b_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_relationships_user_b_id ON relationships (user_b_id);
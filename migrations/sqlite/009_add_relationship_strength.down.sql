@@ -0,0 +1,6 @@
+-- Remove decaying strength and view counts from relationships
+DROP INDEX idx_relationships_strength;
+ALTER TABLE relationships DROP COLUMN decayed_at;
+ALTER TABLE relationships DROP COLUMN strength;
+ALTER TABLE relationships DROP COLUMN view_count_b;
+ALTER TABLE relationships DROP COLUMN view_count_a;
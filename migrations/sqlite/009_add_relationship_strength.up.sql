@@ -0,0 +1,18 @@
+-- Add decaying strength and view counts to relationships
+-- SQLite only accepts constant defaults in ADD COLUMN, so decayed_at is backfilled below.
+ALTER TABLE relationships ADD COLUMN view_count_a INTEGER NOT NULL DEFAULT 0; -- Times user A viewed traces of user B
+ALTER TABLE relationships ADD COLUMN view_count_b INTEGER NOT NULL DEFAULT 0; -- Times user B viewed traces of user A
+ALTER TABLE relationships ADD COLUMN strength REAL NOT NULL DEFAULT 0; -- Relationship strength, halving every half-life without activity
+ALTER TABLE relationships ADD COLUMN decayed_at DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00'; -- When strength was last brought up to date
+
+CREATE INDEX idx_relationships_strength ON relationships (strength);
+
+-- Existing edges start fresh: exchanges so far, plus one meeting per approved interaction
+UPDATE relationships SET strength = exchange_count * 1.0, decayed_at = CURRENT_TIMESTAMP;
+
+INSERT INTO relationships (user_a_id, user_b_id, strength, decayed_at, created_at, updated_at)
+SELECT MIN(requester_id, approver_id), MAX(requester_id, approver_id), COUNT(*) * 1.0, CURRENT_TIMESTAMP, MIN(created_at), CURRENT_TIMESTAMP
+FROM interactions
+WHERE status = 'approved'
+GROUP BY MIN(requester_id, approver_id), MAX(requester_id, approver_id)
+ON CONFLICT (user_a_id, user_b_id) DO UPDATE SET strength = relationships.strength + excluded.strength;
@@ -0,0 +1,30 @@
+-- Create blocks and reports tables
+CREATE TABLE blocks (
+    blocker_id VARCHAR(36) NOT NULL, -- User ID of the user who blocked
+    blocked_id VARCHAR(36) NOT NULL, -- User ID of the blocked user
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- When the block was placed
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id),
+    FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_blocked_id ON blocks (blocked_id);
+
+CREATE TABLE reports (
+    id VARCHAR(36) PRIMARY KEY, -- UUID format report identifier
+    reporter_id VARCHAR(36) NOT NULL, -- User ID of the user filing the report
+    reported_id VARCHAR(36) NOT NULL, -- User ID of the reported user
+    reason VARCHAR(32) NOT NULL CHECK (reason IN ('spam', 'harassment', 'impersonation', 'inappropriate_content', 'other')), -- Reason category chosen by the reporter
+    details TEXT NOT NULL, -- Free-form description from the reporter
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'resolved', 'dismissed')), -- Moderation status
+    resolution_note TEXT NULL, -- Note left by the moderator who closed the report
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+    closed_at DATETIME NULL, -- When a moderator resolved or dismissed the report
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Record update timestamp (maintained by GORM)
+    FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (reported_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_reports_status_created_at ON reports (status, created_at);
+CREATE INDEX idx_reported_id ON reports (reported_id);
@@ -0,0 +1,2 @@
+-- Drop rate_limit_buckets table
+DROP TABLE rate_limit_buckets;
@@ -0,0 +1,6 @@
+-- Create rate_limit_buckets table
+CREATE TABLE rate_limit_buckets (
+    bucket_key VARCHAR(191) PRIMARY KEY, -- What is limited and for whom, e.g. interaction_request:{userId}
+    tokens REAL NOT NULL, -- Tokens left as of updated_at
+    updated_at DATETIME NOT NULL -- When tokens was last refilled and taken from
+);
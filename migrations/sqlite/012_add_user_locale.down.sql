@@ -0,0 +1,2 @@
+-- Remove the language of LINE messages from users
+ALTER TABLE users DROP COLUMN locale;
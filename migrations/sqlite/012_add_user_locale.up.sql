@@ -0,0 +1,2 @@
+-- Add the language LINE messages are sent to each user in
+ALTER TABLE users ADD COLUMN locale VARCHAR(16) NOT NULL DEFAULT 'ja'; -- Language of LINE messages, from the LINE profile
@@ -0,0 +1,3 @@
+-- Remove the self-written profile from users
+ALTER TABLE users DROP COLUMN avatar_url;
+ALTER TABLE users DROP COLUMN bio;
@@ -0,0 +1,3 @@
+-- Add a self-written profile to users
+ALTER TABLE users ADD COLUMN bio VARCHAR(2000) NOT NULL DEFAULT ''; -- Self-introduction shown on the profile
+ALTER TABLE users ADD COLUMN avatar_url VARCHAR(2048) NULL; -- Profile image location
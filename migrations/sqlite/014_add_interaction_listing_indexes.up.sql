@@ -0,0 +1,3 @@
+-- Serve newest-first interaction pages per requester and per approver
+CREATE INDEX idx_requester_created_at ON interactions (requester_id, created_at, id);
+CREATE INDEX idx_approver_created_at ON interactions (approver_id, created_at, id);
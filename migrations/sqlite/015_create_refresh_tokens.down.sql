@@ -0,0 +1,2 @@
+-- Drop refresh_tokens table
+DROP TABLE refresh_tokens;
@@ -0,0 +1,13 @@
+-- Create refresh_tokens table
+CREATE TABLE refresh_tokens (
+    id VARCHAR(36) PRIMARY KEY, -- UUID format token identifier
+    user_id VARCHAR(36) NOT NULL, -- User the token was issued to
+    token_hash VARCHAR(64) NOT NULL, -- Hex SHA-256 of the token, the token itself is never stored
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- When the token was issued
+    expires_at DATETIME NOT NULL, -- When the token stops being accepted
+    revoked_at DATETIME NULL, -- When the token was used up or revoked
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_refresh_tokens_token_hash ON refresh_tokens (token_hash);
+CREATE INDEX idx_refresh_tokens_user_id ON refresh_tokens (user_id);
@@ -0,0 +1,6 @@
+-- Record when each rate limit bucket is full again, so full buckets can be swept
+ALTER TABLE rate_limit_buckets ADD COLUMN full_at DATETIME NULL; -- When tokens is back at the burst and the row can be deleted, NULL if never
+CREATE INDEX idx_rate_limit_buckets_full_at ON rate_limit_buckets (full_at);
+
+-- The limit of existing buckets is unknown, and sweeping them early only refills them early
+UPDATE rate_limit_buckets SET full_at = updated_at;
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// AnchorRepository defines the persistence interface for AnchorBatch domain objects.
+type AnchorRepository interface {
+	// Save persists a new batch together with its leaves.
+	// Returns an error if any of its interactions already belongs to a batch.
+	Save(ctx context.Context, batch *domain.AnchorBatch) error
+
+	// Update records the anchoring state of an existing batch.
+	Update(ctx context.Context, batch *domain.AnchorBatch) error
+
+	// FindLatest retrieves the batch with the highest epoch.
+	// Returns nil if there are no batches yet.
+	FindLatest(ctx context.Context) (*domain.AnchorBatch, error)
+
+	// FindUnanchored retrieves batches whose root has not been published yet, oldest first.
+	FindUnanchored(ctx context.Context) ([]*domain.AnchorBatch, error)
+
+	// FindByInteractionID retrieves the batch containing an interaction.
+	// Returns nil if the interaction is not part of any batch.
+	FindByInteractionID(ctx context.Context, interactionID string) (*domain.AnchorBatch, error)
+
+	// FindPendingInteractionIDs retrieves up to limit approved interactions
+	// that are not part of any batch yet, oldest first.
+	FindPendingInteractionIDs(ctx context.Context, limit int) ([]string, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import "context"
+
+// AnchorService defines the interface for publishing Merkle roots on a blockchain.
+// This is placed in the repository package as it's an external service abstraction.
+// Implementations may talk to a node over JSON-RPC or, for tests, keep an
+// in-process log; usecases never see the difference. Only the in-process log
+// exists so far, so serve neither anchors interactions nor serves inclusion
+// proofs until a JSON-RPC adapter is written.
+type AnchorService interface {
+	// Anchor publishes root as the root of epoch and returns a reference to
+	// the chain record (e.g. a transaction hash).
+	// Anchoring the same root for the same epoch again returns the original
+	// reference, so a batch can be retried safely after a crash.
+	// Returns an error if a different root was already anchored for epoch.
+	Anchor(ctx context.Context, epoch int64, root []byte) (txRef string, err error)
+
+	// FindRoot retrieves the root anchored for epoch.
+	// Returns nil if nothing was anchored for epoch.
+	FindRoot(ctx context.Context, epoch int64) ([]byte, error)
+}
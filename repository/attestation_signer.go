@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// AttestationSigner defines the interface for signing interaction attestations.
+// This is placed in the repository package as it's an external service abstraction.
+type AttestationSigner interface {
+	// Sign signs the attestation as EIP-712 typed data.
+	// Returns the EIP-55 signer address and the 0x-prefixed hex signature.
+	Sign(ctx context.Context, attestation *domain.Attestation) (signer string, signature string, err error)
+}
+
+// AttestationVerifier defines the interface for checking attestation signatures offline.
+type AttestationVerifier interface {
+	// Verify recovers the signer of the attestation's typed data and returns
+	// an error unless it matches attestation.Signer.
+	Verify(attestation *domain.Attestation) error
+}
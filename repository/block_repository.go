@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// BlockRepository defines the persistence interface for Block domain objects.
+type BlockRepository interface {
+	// SaveAndDisconnect persists a block and, in the same transaction, cuts
+	// the two users off: their relationship is deleted, pending interactions
+	// between them are expired and pending exchanges between them declined,
+	// whichever of them started them. Saving a block that already exists is
+	// not an error.
+	SaveAndDisconnect(ctx context.Context, block *domain.Block) error
+
+	// Delete removes the block blockerID placed against blockedID.
+	// Deleting a missing block is not an error.
+	Delete(ctx context.Context, blockerID, blockedID string) error
+
+	// FindByBlockerID retrieves the blocks placed by blockerID, newest first.
+	FindByBlockerID(ctx context.Context, blockerID string) ([]*domain.Block, error)
+
+	// ExistsBetween returns true if either user has blocked the other.
+	ExistsBetween(ctx context.Context, userID, otherID string) (bool, error)
+
+	// FindBlockedUserIDs retrieves the IDs of users userID has blocked or
+	// been blocked by.
+	FindBlockedUserIDs(ctx context.Context, userID string) ([]string, error)
+}
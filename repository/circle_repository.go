@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// CircleRepository defines the persistence interface for Circle domain objects and their members.
+type CircleRepository interface {
+	// Save persists a new circle.
+	// Returns an error if the circle cannot be saved.
+	Save(ctx context.Context, circle *domain.Circle) error
+
+	// FindByID retrieves a circle by its ID.
+	// Returns nil if the circle is not found.
+	FindByID(ctx context.Context, id string) (*domain.Circle, error)
+
+	// FindByMemberID retrieves all circles a user is a member of.
+	FindByMemberID(ctx context.Context, userID string) ([]*domain.Circle, error)
+
+	// AddMember persists a new membership.
+	// Returns an error if the user is already a member.
+	AddMember(ctx context.Context, member *domain.CircleMember) error
+
+	// RemoveMember deletes a membership. Removing a non-member is not an error.
+	RemoveMember(ctx context.Context, circleID, userID string) error
+
+	// FindMember retrieves a user's membership of a circle.
+	// Returns nil if the user is not a member.
+	FindMember(ctx context.Context, circleID, userID string) (*domain.CircleMember, error)
+
+	// FindMembers retrieves all members of a circle, in joining order.
+	FindMembers(ctx context.Context, circleID string) ([]*domain.CircleMember, error)
+}
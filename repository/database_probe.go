@@ -0,0 +1,14 @@
+package repository
+
+import "context"
+
+// DatabaseProbe reports on the database itself rather than on its records,
+// for readiness checks.
+type DatabaseProbe interface {
+	// Ping checks that the database answers.
+	Ping(ctx context.Context) error
+
+	// SchemaVersion returns the schema version the database is at and the
+	// one this build of the server expects.
+	SchemaVersion(ctx context.Context) (current, expected int, err error)
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// ExchangeRepository defines the persistence interface for Exchange domain objects.
+type ExchangeRepository interface {
+	// Save persists a new exchange.
+	// Returns an error if the exchange cannot be saved.
+	Save(ctx context.Context, exchange *domain.Exchange) error
+
+	// FindByID retrieves an exchange by its ID.
+	// Returns nil if the exchange is not found.
+	FindByID(ctx context.Context, id string) (*domain.Exchange, error)
+
+	// Respond persists the recipient's response to an exchange, but only if it
+	// is still pending in storage. Returns false if it had already been
+	// responded to, so concurrent responses cannot both succeed.
+	Respond(ctx context.Context, exchange *domain.Exchange) (bool, error)
+
+	// RespondAndConnect is Respond for a response that connects the two
+	// users, such as completing the exchange. The relationship between them
+	// is updated as by RelationshipRepository.Connect in the same
+	// transaction. The response is saved even if either user is at
+	// capacity: then only the relationship is left as it was, and the ID of
+	// the user at capacity is returned.
+	RespondAndConnect(ctx context.Context, exchange *domain.Exchange, policy domain.RelationshipPolicy, fn func(*domain.Relationship)) (full string, ok bool, err error)
+}
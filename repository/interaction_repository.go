@@ -17,13 +17,45 @@ type InteractionRepository interface {
 	// Returns nil if the interaction is not found.
 	FindByID(ctx context.Context, id string) (*domain.Interaction, error)
 
-	// FindByRequesterID retrieves all interactions requested by a specific user.
-	FindByRequesterID(ctx context.Context, requesterID string) ([]*domain.Interaction, error)
+	// FindByRequesterID retrieves the interactions requested by a specific user
+	// that match query, newest first.
+	FindByRequesterID(ctx context.Context, requesterID string, query domain.InteractionQuery) ([]*domain.Interaction, error)
 
-	// FindByApproverID retrieves all interactions where a specific user is the approver.
-	FindByApproverID(ctx context.Context, approverID string) ([]*domain.Interaction, error)
+	// FindByApproverID retrieves the interactions where a specific user is the
+	// approver that match query, newest first.
+	FindByApproverID(ctx context.Context, approverID string, query domain.InteractionQuery) ([]*domain.Interaction, error)
+
+	// CountPendingByApproverID counts the interactions waiting on a specific approver.
+	CountPendingByApproverID(ctx context.Context, approverID string) (int, error)
+
+	// CountByStatus counts all interactions per status. Statuses no
+	// interaction is in are left out.
+	CountByStatus(ctx context.Context) (map[domain.InteractionStatus]int, error)
+
+	// FindByMetadata retrieves all interactions whose metadata has the given
+	// top-level key set to value (e.g. key "location", value "Tokyo").
+	FindByMetadata(ctx context.Context, key string, value interface{}) ([]*domain.Interaction, error)
 
 	// Update updates an existing interaction.
 	// Returns an error if the interaction cannot be updated.
 	Update(ctx context.Context, interaction *domain.Interaction) error
+
+	// Transition saves the interaction's status and attestation if its stored
+	// status is still from. Returns false, saving nothing, if a concurrent
+	// update changed the status first.
+	Transition(ctx context.Context, interaction *domain.Interaction, from domain.InteractionStatus) (bool, error)
+
+	// TransitionAndConnect is Transition for a change that connects the two
+	// users, such as an approval. The relationship between them is updated
+	// as by RelationshipRepository.Connect in the same transaction, so the
+	// status is only saved together with the connection. Returns the ID of
+	// the user at capacity, with nothing saved, as Connect does.
+	TransitionAndConnect(ctx context.Context, interaction *domain.Interaction, from domain.InteractionStatus, policy domain.RelationshipPolicy, fn func(*domain.Relationship)) (full string, ok bool, err error)
+
+	// TransitionAndModify is Transition for a change that takes back what an
+	// earlier transition added to the relationship between the two users,
+	// such as overriding an approval. fn is applied as by
+	// RelationshipRepository.Modify in the same transaction, and not at all
+	// if the users have no relationship.
+	TransitionAndModify(ctx context.Context, interaction *domain.Interaction, from domain.InteractionStatus, fn func(*domain.Relationship)) (bool, error)
 }
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// LineLoginVerifier defines the interface for checking LINE Login ID tokens.
+// This is placed in the repository package as it's an external service abstraction.
+type LineLoginVerifier interface {
+	// VerifyIDToken checks the token's signature, issuer, audience and expiry.
+	// Tokens that fail any check return an error of kind domain.ErrUnauthenticated;
+	// other errors mean the token could not be checked at all.
+	VerifyIDToken(ctx context.Context, idToken string) (*domain.LineIdentity, error)
+
+	// VerifyAccessToken asks LINE whether the access token is live and was
+	// issued for this service's channel, then returns whose it is. LIFF apps
+	// hold an access token rather than an ID token. Rejected tokens return an
+	// error of kind domain.ErrUnauthenticated.
+	VerifyAccessToken(ctx context.Context, accessToken string) (*domain.LineIdentity, error)
+}
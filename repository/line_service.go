@@ -1,6 +1,10 @@
 package repository
 
-import "context"
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
 
 // LineService defines the interface for LINE messaging operations.
 // This is placed in the repository package as it's an external service abstraction.
@@ -16,4 +20,20 @@ type LineService interface {
 	// flexMessage is the JSON representation of the Flex Message.
 	// Returns an error if the message cannot be sent.
 	SendFlexMessage(ctx context.Context, userID string, flexMessage string) error
+
+	// ReplyMessage answers a webhook event with a text message.
+	// replyToken is the token LINE sent with the event; it can be used once,
+	// shortly after the event arrives.
+	// Returns an error if the message cannot be sent.
+	ReplyMessage(ctx context.Context, replyToken string, message string) error
+
+	// GetProfile retrieves the LINE profile of a user.
+	// userID is the LINE user ID of the user.
+	// Returns an error if the profile cannot be retrieved.
+	GetProfile(ctx context.Context, userID string) (*domain.LineProfile, error)
+
+	// VerifyCredentials checks that LINE accepts the channel access token.
+	// Returns an error of kind domain.ErrUnauthenticated if it is missing or
+	// rejected, and another error if it cannot be checked.
+	VerifyCredentials(ctx context.Context) error
 }
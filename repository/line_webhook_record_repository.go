@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// LineWebhookRecordRepository keeps recorded LINE webhook requests.
+// Implementations must be safe for concurrent use.
+type LineWebhookRecordRepository interface {
+	// Save appends a record.
+	Save(ctx context.Context, record *domain.LineWebhookRecord) error
+
+	// List retrieves every record in the order they were saved.
+	List(ctx context.Context) ([]*domain.LineWebhookRecord, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// RateLimiter keeps one token bucket per key.
+// Implementations must be safe for concurrent use.
+type RateLimiter interface {
+	// Take removes a token from the bucket for key, creating a full bucket
+	// for limit if there is none yet. Returns false if the bucket is empty,
+	// along with how long until a token is available (zero if unknown).
+	Take(ctx context.Context, key string, limit domain.RateLimit) (bool, time.Duration, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// RefreshTokenRepository defines the persistence interface for RefreshToken domain objects.
+type RefreshTokenRepository interface {
+	// Save persists a new refresh token.
+	Save(ctx context.Context, token *domain.RefreshToken) error
+
+	// FindByHash retrieves a refresh token by the hash of its value.
+	// Returns nil if no token has that hash.
+	FindByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+
+	// Revoke marks the token revoked at the given time unless it already is.
+	// Returns false if it was already revoked, so of two concurrent uses of
+	// the same token only one succeeds.
+	Revoke(ctx context.Context, id string, at time.Time) (bool, error)
+
+	// RevokeByUserID revokes every token of the user that is not revoked yet.
+	RevokeByUserID(ctx context.Context, userID string, at time.Time) error
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// RelationshipRepository defines the persistence interface for Relationship domain objects.
+type RelationshipRepository interface {
+	// FindBetween retrieves the relationship between two users, in either order.
+	// Returns nil if the users have no relationship yet.
+	FindBetween(ctx context.Context, userID, otherID string) (*domain.Relationship, error)
+
+	// Modify applies fn to the existing relationship between two users and
+	// saves it. The relationship is locked for the duration, so concurrent
+	// modifications are applied one by one. Returns false, without calling
+	// fn, if the users have no relationship, so one deleted meanwhile is not
+	// brought back.
+	Modify(ctx context.Context, userID, otherID string, fn func(*domain.Relationship)) (bool, error)
+
+	// Connect is like Modify for activity that may newly connect two users, and
+	// creates the relationship if needed. Unless
+	// the pair is already active under policy, both users are locked and their
+	// active relationships counted first; if either has no room left, nothing
+	// is saved. Returns the ID of the user at capacity, or "" on success.
+	Connect(ctx context.Context, userID, otherID string, policy domain.RelationshipPolicy, fn func(*domain.Relationship)) (string, error)
+
+	// Delete removes the relationship between two users.
+	// Deleting a missing relationship is not an error.
+	Delete(ctx context.Context, userID, otherID string) error
+
+	// FindByUserID retrieves the relationships of userID with at least
+	// minStrength, strongest first.
+	FindByUserID(ctx context.Context, userID string, minStrength float64) ([]*domain.Relationship, error)
+
+	// List retrieves relationships ordered by user pair, for batch processing.
+	List(ctx context.Context, limit, offset int) ([]*domain.Relationship, error)
+
+	// FindConnectedUserIDs retrieves the IDs of users whose relationship with
+	// userID has at least minStrength.
+	FindConnectedUserIDs(ctx context.Context, userID string, minStrength float64) ([]string, error)
+}
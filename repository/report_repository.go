@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// ReportRepository defines the persistence interface for Report domain objects.
+type ReportRepository interface {
+	// Save persists a new report.
+	// Returns an error if the report cannot be saved.
+	Save(ctx context.Context, report *domain.Report) error
+
+	// FindByID retrieves a report by its ID.
+	// Returns nil if the report is not found.
+	FindByID(ctx context.Context, id string) (*domain.Report, error)
+
+	// FindByStatus retrieves reports with the given status, oldest first,
+	// so the moderation queue is worked in the order reports arrived.
+	FindByStatus(ctx context.Context, status domain.ReportStatus, limit, offset int) ([]*domain.Report, error)
+
+	// Close persists a moderator's decision, but only if the report is still
+	// open in storage. Returns false if another moderator closed it first.
+	Close(ctx context.Context, report *domain.Report) (bool, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import "github.com/dkpcb/pet/domain"
+
+// SessionTokenSigner defines the interface for issuing and checking the
+// access tokens this service hands out after sign-in.
+type SessionTokenSigner interface {
+	// Sign encodes the claims as a signed token.
+	Sign(claims *domain.SessionClaims) (string, error)
+
+	// Verify checks the token's signature and expiry and returns its claims.
+	// Invalid or expired tokens return an error of kind domain.ErrUnauthenticated.
+	Verify(token string) (*domain.SessionClaims, error)
+}
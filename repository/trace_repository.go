@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// TraceRepository defines the persistence interface for Trace domain objects.
+type TraceRepository interface {
+	// Save persists a new trace.
+	// Returns an error if the trace cannot be saved.
+	Save(ctx context.Context, trace *domain.Trace) error
+
+	// FindByID retrieves a trace by its ID.
+	// Returns nil if the trace is not found.
+	FindByID(ctx context.Context, id string) (*domain.Trace, error)
+
+	// FindByCircleID retrieves the traces posted to a circle, newest first.
+	FindByCircleID(ctx context.Context, circleID string) ([]*domain.Trace, error)
+
+	// FindByAuthorID retrieves up to limit traces posted by a user outside any
+	// circle, newest first.
+	FindByAuthorID(ctx context.Context, authorID string, limit int) ([]*domain.Trace, error)
+}
@@ -20,7 +20,16 @@ type UserRepository interface {
 	// Returns nil if the user is not found.
 	FindByLineUserID(ctx context.Context, lineUserID string) (*domain.User, error)
 
+	// FindByWalletAddress retrieves a user by their wallet address.
+	// Returns nil if the user is not found.
+	FindByWalletAddress(ctx context.Context, walletAddress string) (*domain.User, error)
+
+	// List retrieves users ordered by creation time, oldest first.
+	List(ctx context.Context, limit, offset int) ([]*domain.User, error)
+
 	// Update updates an existing user.
-	// Returns an error if the user cannot be updated.
+	// Returns a domain.ErrConflict error if the user's wallet address is
+	// already linked to another user, or an error if the user cannot be
+	// updated.
 	Update(ctx context.Context, user *domain.User) error
 }
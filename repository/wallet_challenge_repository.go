@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// WalletChallengeRepository defines the persistence interface for WalletChallenge domain objects.
+// A user has at most one outstanding challenge.
+type WalletChallengeRepository interface {
+	// Save persists a challenge, replacing any existing challenge for the same user.
+	Save(ctx context.Context, challenge *domain.WalletChallenge) error
+
+	// FindByUserID retrieves the outstanding challenge for a user.
+	// Returns nil if the user has no challenge.
+	FindByUserID(ctx context.Context, userID string) (*domain.WalletChallenge, error)
+
+	// Delete removes the challenge for a user. Deleting a missing challenge is not an error.
+	Delete(ctx context.Context, userID string) error
+}
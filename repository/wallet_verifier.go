@@ -0,0 +1,14 @@
+package repository
+
+// WalletVerifier defines the interface for wallet signature cryptography.
+// This is placed in the repository package as it's an external service abstraction.
+type WalletVerifier interface {
+	// NormalizeAddress validates a hex wallet address and returns it in EIP-55 checksum form.
+	// Returns an error if the address is malformed or has an invalid mixed-case checksum.
+	NormalizeAddress(address string) (string, error)
+
+	// RecoverAddress returns the EIP-55 checksummed address that produced signature
+	// over message using EIP-191 personal_sign.
+	// signature is the 65-byte r||s||v signature encoded as 0x-prefixed hex.
+	RecoverAddress(message string, signature string) (string, error)
+}
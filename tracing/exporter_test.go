@@ -0,0 +1,201 @@
+package tracing_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/dkpcb/pet/tracing"
+)
+
+// otlpRequest is the part of an OTLP/JSON ExportTraceServiceRequest the
+// tests look at, decoded independently of the exporter's own types.
+type otlpRequest struct {
+	ResourceSpans []struct {
+		Resource struct {
+			Attributes []otlpAttribute `json:"attributes"`
+		} `json:"resource"`
+		ScopeSpans []struct {
+			Scope struct {
+				Name string `json:"name"`
+			} `json:"scope"`
+			Spans []struct {
+				TraceID           string          `json:"traceId"`
+				SpanID            string          `json:"spanId"`
+				ParentSpanID      string          `json:"parentSpanId"`
+				Name              string          `json:"name"`
+				Kind              int             `json:"kind"`
+				StartTimeUnixNano string          `json:"startTimeUnixNano"`
+				EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+				Attributes        []otlpAttribute `json:"attributes"`
+				Status            struct {
+					Code    int    `json:"code"`
+					Message string `json:"message"`
+				} `json:"status"`
+			} `json:"spans"`
+		} `json:"scopeSpans"`
+	} `json:"resourceSpans"`
+}
+
+type otlpAttribute struct {
+	Key   string `json:"key"`
+	Value struct {
+		StringValue string `json:"stringValue"`
+	} `json:"value"`
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var (
+		got         otlpRequest
+		contentType string
+		apiKey      string
+		path        string
+	)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		contentType = r.Header.Get("Content-Type")
+		apiKey = r.Header.Get("X-Api-Key")
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("collector could not decode request: %v", err)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	start := time.Unix(1700000000, 123)
+	parent := tracing.SpanID{1, 2, 3, 4, 5, 6, 7, 8}
+	span := tracing.SpanData{
+		Name: "UserRepository.FindByID",
+		Kind: tracing.KindClient,
+		Context: tracing.SpanContext{
+			TraceID: tracing.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+			SpanID:  tracing.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+			Sampled: true,
+		},
+		Parent:     parent,
+		Start:      start,
+		End:        start.Add(time.Millisecond),
+		Attributes: []tracing.Attr{tracing.String("db.system", "sqlite")},
+		Err:        "record not found",
+	}
+
+	exporter := tracing.NewOTLPExporter(collector.URL+"/v1/traces", map[string]string{"X-Api-Key": "secret"}, "traceriver-test")
+	if err := exporter.Export(context.Background(), []tracing.SpanData{span}); err != nil {
+		t.Fatalf("Export() = %v", err)
+	}
+
+	if path != "/v1/traces" {
+		t.Errorf("path = %q, want /v1/traces", path)
+	}
+	if contentType != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", contentType)
+	}
+	if apiKey != "secret" {
+		t.Errorf("X-Api-Key = %q, want the configured header", apiKey)
+	}
+	if len(got.ResourceSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans[0].Spans) != 1 {
+		t.Fatalf("request = %+v, want one resource, scope and span", got)
+	}
+	resource := got.ResourceSpans[0].Resource
+	if len(resource.Attributes) != 1 || resource.Attributes[0].Key != "service.name" || resource.Attributes[0].Value.StringValue != "traceriver-test" {
+		t.Errorf("resource attributes = %+v, want service.name=traceriver-test", resource.Attributes)
+	}
+	if name := got.ResourceSpans[0].ScopeSpans[0].Scope.Name; name == "" {
+		t.Error("scope has no name")
+	}
+
+	s := got.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if s.TraceID != "4bf92f3577b34da6a3ce929d0e0e4736" || s.SpanID != "00f067aa0ba902b7" || s.ParentSpanID != parent.String() {
+		t.Errorf("IDs = %s/%s/%s, want hex trace, span and parent IDs", s.TraceID, s.SpanID, s.ParentSpanID)
+	}
+	if s.Name != span.Name || s.Kind != int(tracing.KindClient) {
+		t.Errorf("span = %q kind %d, want %q kind %d", s.Name, s.Kind, span.Name, tracing.KindClient)
+	}
+	if s.StartTimeUnixNano != strconv.FormatInt(start.UnixNano(), 10) || s.EndTimeUnixNano != strconv.FormatInt(span.End.UnixNano(), 10) {
+		t.Errorf("times = %s..%s, want nanoseconds as strings", s.StartTimeUnixNano, s.EndTimeUnixNano)
+	}
+	if len(s.Attributes) != 1 || s.Attributes[0].Key != "db.system" || s.Attributes[0].Value.StringValue != "sqlite" {
+		t.Errorf("attributes = %+v, want db.system=sqlite", s.Attributes)
+	}
+	if s.Status.Code != 2 || s.Status.Message != "record not found" {
+		t.Errorf("status = %+v, want STATUS_CODE_ERROR with the error text", s.Status)
+	}
+}
+
+func TestOTLPExporterRootSpan(t *testing.T) {
+	var raw map[string]interface{}
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&raw)
+	}))
+	defer collector.Close()
+
+	span := tracing.SpanData{
+		Name:    "GET /health",
+		Kind:    tracing.KindServer,
+		Context: tracing.SpanContext{TraceID: tracing.TraceID{1}, SpanID: tracing.SpanID{1}, Sampled: true},
+	}
+	if err := tracing.NewOTLPExporter(collector.URL, nil, "traceriver").Export(context.Background(), []tracing.SpanData{span}); err != nil {
+		t.Fatalf("Export() = %v", err)
+	}
+	spans := raw["resourceSpans"].([]interface{})[0].(map[string]interface{})["scopeSpans"].([]interface{})[0].(map[string]interface{})["spans"].([]interface{})
+	s := spans[0].(map[string]interface{})
+	if _, ok := s["parentSpanId"]; ok {
+		t.Errorf("root span has parentSpanId %v, want it omitted", s["parentSpanId"])
+	}
+	if status := s["status"].(map[string]interface{}); len(status) != 0 {
+		t.Errorf("status = %v, want unset for a span that did not fail", status)
+	}
+}
+
+func TestOTLPExporterCollectorError(t *testing.T) {
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		http.Error(w, "overloaded", http.StatusServiceUnavailable)
+	}))
+	defer collector.Close()
+
+	err := tracing.NewOTLPExporter(collector.URL, nil, "traceriver").Export(context.Background(), []tracing.SpanData{{Name: "x"}})
+	if err == nil {
+		t.Fatal("Export() = nil, want an error for a 503")
+	}
+}
+
+func TestTracerExportsThroughOTLP(t *testing.T) {
+	received := make(chan otlpRequest, 1)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req otlpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("collector could not decode request: %v", err)
+		}
+		received <- req
+	}))
+	defer collector.Close()
+
+	tracer := tracing.NewTracer(tracing.NewOTLPExporter(collector.URL, nil, "traceriver"), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx, root := tracer.Start(context.Background(), "root", tracing.KindServer)
+	_, child := tracing.Start(ctx, "child", tracing.KindClient)
+	child.End()
+	root.End()
+	if err := tracer.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() = %v", err)
+	}
+
+	select {
+	case req := <-received:
+		spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+		if len(spans) != 2 {
+			t.Fatalf("collector got %d spans, want 2", len(spans))
+		}
+		if spans[0].ParentSpanID != spans[1].SpanID || spans[0].TraceID != spans[1].TraceID {
+			t.Errorf("child %+v is not a child of root %+v", spans[0], spans[1])
+		}
+	default:
+		t.Fatal("collector got nothing before Shutdown returned")
+	}
+}
@@ -0,0 +1,76 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/dkpcb/pet/tracing"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name        string
+		traceparent string
+		wantOK      bool
+		wantSampled bool
+	}{
+		{name: "sampled", traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", wantOK: true, wantSampled: true},
+		{name: "not sampled", traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00", wantOK: true},
+		{name: "future version with extra field", traceparent: "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", wantOK: true, wantSampled: true},
+		{name: "missing", traceparent: ""},
+		{name: "invalid version", traceparent: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		{name: "version 00 with extra field", traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra"},
+		{name: "uppercase", traceparent: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01"},
+		{name: "zero trace ID", traceparent: "00-00000000000000000000000000000000-00f067aa0ba902b7-01"},
+		{name: "zero span ID", traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01"},
+		{name: "short span ID", traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902-01"},
+		{name: "not hex", traceparent: "00-4bf92f3577b34da6a3ce929d0e0e473g-00f067aa0ba902b7-01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set(tracing.TraceparentHeader, tt.traceparent)
+			sc, ok := tracing.Extract(header)
+			if ok != tt.wantOK {
+				t.Fatalf("Extract(%q) ok = %v, want %v", tt.traceparent, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if got := sc.TraceID.String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+				t.Errorf("trace = %s", got)
+			}
+			if got := sc.SpanID.String(); got != "00f067aa0ba902b7" {
+				t.Errorf("span = %s", got)
+			}
+			if sc.Sampled != tt.wantSampled {
+				t.Errorf("sampled = %v, want %v", sc.Sampled, tt.wantSampled)
+			}
+		})
+	}
+}
+
+func TestInjectExtract(t *testing.T) {
+	tracer, _ := newTestTracer(t)
+	ctx, span := tracer.Start(context.Background(), "client", tracing.KindClient)
+	defer span.End()
+
+	header := http.Header{}
+	tracing.Inject(ctx, header)
+	sc, ok := tracing.Extract(header)
+	if !ok {
+		t.Fatalf("Extract(%q) failed", header.Get(tracing.TraceparentHeader))
+	}
+	if sc != span.SpanContext() {
+		t.Errorf("round trip = %+v, want %+v", sc, span.SpanContext())
+	}
+}
+
+func TestInjectWithoutSpan(t *testing.T) {
+	header := http.Header{}
+	tracing.Inject(context.Background(), header)
+	if got := header.Get(tracing.TraceparentHeader); got != "" {
+		t.Errorf("traceparent = %q, want none", got)
+	}
+}
@@ -0,0 +1,371 @@
+// Package tracing records spans of work (an API request, a LINE event, a
+// repository call) and exports them in the OpenTelemetry data model, so a
+// slow request can be broken down into the calls that made it slow.
+//
+// Spans are started from a Tracer, or with Start as children of the span
+// already in a context. A context without a span, or a Tracer without an
+// exporter, yields spans that record nothing, so code can be instrumented
+// unconditionally.
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// TraceID identifies a trace: every span caused by one request.
+type TraceID [16]byte
+
+// String returns the ID in lowercase hex, as in traceparent headers.
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+
+// IsValid reports whether id is not all zeros.
+func (id TraceID) IsValid() bool { return id != TraceID{} }
+
+// SpanID identifies a span within a trace.
+type SpanID [8]byte
+
+// String returns the ID in lowercase hex, as in traceparent headers.
+func (id SpanID) String() string { return hex.EncodeToString(id[:]) }
+
+// IsValid reports whether id is not all zeros.
+func (id SpanID) IsValid() bool { return id != SpanID{} }
+
+// SpanContext is what identifies a span across process boundaries.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	// Sampled is set when the span is recorded, so downstream services
+	// should record theirs too.
+	Sampled bool
+}
+
+// IsValid reports whether both IDs are set.
+func (sc SpanContext) IsValid() bool { return sc.TraceID.IsValid() && sc.SpanID.IsValid() }
+
+// Kind tells the role of a span, numbered as in OTLP.
+type Kind int
+
+const (
+	KindInternal Kind = 1
+	KindServer   Kind = 2
+	KindClient   Kind = 3
+)
+
+// Attr is a key/value annotation of a span.
+type Attr struct {
+	Key   string
+	Value string
+}
+
+// String creates an Attr.
+func String(key, value string) Attr { return Attr{Key: key, Value: value} }
+
+// SpanData is a finished span, as handed to an Exporter.
+type SpanData struct {
+	Name       string
+	Kind       Kind
+	Context    SpanContext
+	Parent     SpanID
+	Start, End time.Time
+	Attributes []Attr
+	// Err is the error text the span failed with, empty if it did not fail.
+	Err string
+}
+
+// Exporter sends finished spans somewhere.
+type Exporter interface {
+	Export(ctx context.Context, spans []SpanData) error
+}
+
+// Batching limits of a Tracer.
+const (
+	queueSize     = 2048
+	batchSize     = 512
+	flushInterval = 5 * time.Second
+)
+
+// Tracer starts spans and exports them in batches in the background.
+type Tracer struct {
+	exporter Exporter
+	logger   *slog.Logger
+	queue    chan SpanData
+	flush    chan chan struct{}
+	stop     chan struct{}
+	stopped  sync.WaitGroup
+	closing  sync.Once
+}
+
+// NewTracer creates a Tracer exporting to exporter. With a nil exporter
+// spans are only propagated, never recorded. Export failures are logged to
+// logger. Call Shutdown to export what is still queued.
+func NewTracer(exporter Exporter, logger *slog.Logger) *Tracer {
+	t := &Tracer{exporter: exporter, logger: logger}
+	if exporter == nil {
+		return t
+	}
+	t.queue = make(chan SpanData, queueSize)
+	t.flush = make(chan chan struct{})
+	t.stop = make(chan struct{})
+	t.stopped.Add(1)
+	go t.run()
+	return t
+}
+
+// Start starts a span named name, as a child of the span or remote parent
+// in ctx if there is one. The returned context carries the new span.
+func (t *Tracer) Start(ctx context.Context, name string, kind Kind, attrs ...Attr) (context.Context, *Span) {
+	parent := SpanContextFromContext(ctx)
+	sc := SpanContext{TraceID: parent.TraceID, SpanID: newSpanID(), Sampled: true}
+	if !parent.TraceID.IsValid() {
+		sc.TraceID = newTraceID()
+	} else {
+		// An upstream service that decided not to record the trace wins.
+		sc.Sampled = parent.Sampled
+	}
+
+	span := &Span{
+		tracer: t,
+		data: SpanData{
+			Name:       name,
+			Kind:       kind,
+			Context:    sc,
+			Parent:     parent.SpanID,
+			Start:      time.Now(),
+			Attributes: attrs,
+		},
+	}
+	span.recording = t != nil && t.exporter != nil && sc.Sampled
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// Start starts a child of the span in ctx with the same Tracer. Without a
+// span in ctx the returned span records nothing.
+func Start(ctx context.Context, name string, kind Kind, attrs ...Attr) (context.Context, *Span) {
+	parent := SpanFromContext(ctx)
+	if parent == nil {
+		return ctx, nil
+	}
+	return parent.tracer.Start(ctx, name, kind, attrs...)
+}
+
+// Shutdown exports the spans still queued and stops the Tracer.
+func (t *Tracer) Shutdown(ctx context.Context) error {
+	if t == nil || t.exporter == nil {
+		return nil
+	}
+	t.closing.Do(func() { close(t.stop) })
+	done := make(chan struct{})
+	go func() {
+		t.stopped.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ForceFlush exports the spans queued so far, for tests that read an
+// InMemoryExporter.
+func (t *Tracer) ForceFlush(ctx context.Context) {
+	if t == nil || t.exporter == nil {
+		return
+	}
+	done := make(chan struct{})
+	select {
+	case t.flush <- done:
+	case <-ctx.Done():
+		return
+	case <-t.stop:
+		return
+	}
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+func (t *Tracer) enqueue(span SpanData) {
+	select {
+	case t.queue <- span:
+	default:
+		// Tracing must never slow down the work it observes.
+		t.logger.Debug("span queue full, dropping span", "span", span.Name)
+	}
+}
+
+func (t *Tracer) run() {
+	defer t.stopped.Done()
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]SpanData, 0, batchSize)
+	export := func() {
+		if len(batch) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := t.exporter.Export(ctx, batch); err != nil {
+			t.logger.Warn("failed to export spans", "spans", len(batch), "error", err)
+		}
+		batch = make([]SpanData, 0, batchSize)
+	}
+	drain := func() {
+		for {
+			select {
+			case span := <-t.queue:
+				batch = append(batch, span)
+				if len(batch) == batchSize {
+					export()
+				}
+			default:
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case span := <-t.queue:
+			batch = append(batch, span)
+			if len(batch) == batchSize {
+				export()
+			}
+		case <-ticker.C:
+			export()
+		case done := <-t.flush:
+			drain()
+			export()
+			close(done)
+		case <-t.stop:
+			drain()
+			export()
+			return
+		}
+	}
+}
+
+// Span is one piece of work being timed. Its methods are safe to call on a
+// nil Span, which is what Start returns when nothing is being traced.
+type Span struct {
+	tracer    *Tracer
+	recording bool
+
+	mu    sync.Mutex
+	data  SpanData
+	ended bool
+}
+
+// SpanContext returns the IDs of the span.
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.data.Context
+}
+
+// SetName renames the span, for when a better name is only known later,
+// such as the route a request matched.
+func (s *Span) SetName(name string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.data.Name = name
+	s.mu.Unlock()
+}
+
+// SetAttributes adds attrs to the span.
+func (s *Span) SetAttributes(attrs ...Attr) {
+	if s == nil || !s.recording {
+		return
+	}
+	s.mu.Lock()
+	s.data.Attributes = append(s.data.Attributes, attrs...)
+	s.mu.Unlock()
+}
+
+// SetError marks the span as failed with err. A nil err is ignored.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	s.data.Err = err.Error()
+	s.mu.Unlock()
+}
+
+// End finishes the span and queues it for export. Only the first call counts.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.End = time.Now()
+	data := s.data
+	s.mu.Unlock()
+
+	if s.recording {
+		s.tracer.enqueue(data)
+	}
+}
+
+type spanKey struct{}
+
+type remoteKey struct{}
+
+// SpanFromContext returns the span stored in ctx by Start, or nil.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// ContextWithRemoteParent returns a context whose next span continues the
+// trace of sc, received from another service.
+func ContextWithRemoteParent(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, remoteKey{}, sc)
+}
+
+// SpanContextFromContext returns the IDs of the span in ctx, or of the
+// remote parent set with ContextWithRemoteParent.
+func SpanContextFromContext(ctx context.Context) SpanContext {
+	if span := SpanFromContext(ctx); span != nil {
+		return span.SpanContext()
+	}
+	sc, _ := ctx.Value(remoteKey{}).(SpanContext)
+	return sc
+}
+
+// IDs only need to be unique, not unpredictable.
+func newTraceID() TraceID {
+	var id TraceID
+	for !id.IsValid() {
+		for i := range id {
+			id[i] = byte(rand.Uint32())
+		}
+	}
+	return id
+}
+
+func newSpanID() SpanID {
+	var id SpanID
+	for !id.IsValid() {
+		for i := range id {
+			id[i] = byte(rand.Uint32())
+		}
+	}
+	return id
+}
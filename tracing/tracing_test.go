@@ -0,0 +1,157 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/dkpcb/pet/tracing"
+)
+
+func newTestTracer(t *testing.T) (*tracing.Tracer, *tracing.InMemoryExporter) {
+	t.Helper()
+	exporter := tracing.NewInMemoryExporter()
+	tracer := tracing.NewTracer(exporter, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	t.Cleanup(func() { tracer.Shutdown(context.Background()) })
+	return tracer, exporter
+}
+
+func TestTracerStartEnd(t *testing.T) {
+	tracer, exporter := newTestTracer(t)
+
+	ctx, root := tracer.Start(context.Background(), "GET /users/{id}", tracing.KindServer, tracing.String("http.method", "GET"))
+	_, child := tracing.Start(ctx, "UserRepository.FindByID", tracing.KindClient)
+	child.SetError(errors.New("boom"))
+	child.End()
+	root.SetAttributes(tracing.String("http.status_code", "500"))
+	root.End()
+	root.End()
+
+	tracer.ForceFlush(context.Background())
+	spans := exporter.Spans()
+	if len(spans) != 2 {
+		t.Fatalf("exported %d spans, want 2", len(spans))
+	}
+	gotChild, gotRoot := spans[0], spans[1]
+
+	if gotRoot.Name != "GET /users/{id}" || gotRoot.Kind != tracing.KindServer {
+		t.Errorf("root = %q kind %d, want GET /users/{id} kind %d", gotRoot.Name, gotRoot.Kind, tracing.KindServer)
+	}
+	if gotRoot.Parent.IsValid() {
+		t.Errorf("root has parent %s, want none", gotRoot.Parent)
+	}
+	if !gotRoot.Context.IsValid() || !gotRoot.Context.Sampled {
+		t.Errorf("root context = %+v, want valid and sampled", gotRoot.Context)
+	}
+	if len(gotRoot.Attributes) != 2 {
+		t.Errorf("root attributes = %v, want 2", gotRoot.Attributes)
+	}
+	if gotRoot.End.Before(gotRoot.Start) {
+		t.Errorf("root ended at %v, before its start at %v", gotRoot.End, gotRoot.Start)
+	}
+
+	if gotChild.Context.TraceID != gotRoot.Context.TraceID {
+		t.Errorf("child trace = %s, want %s", gotChild.Context.TraceID, gotRoot.Context.TraceID)
+	}
+	if gotChild.Parent != gotRoot.Context.SpanID {
+		t.Errorf("child parent = %s, want %s", gotChild.Parent, gotRoot.Context.SpanID)
+	}
+	if gotChild.Context.SpanID == gotRoot.Context.SpanID {
+		t.Error("child and root share a span ID")
+	}
+	if gotChild.Err != "boom" {
+		t.Errorf("child error = %q, want boom", gotChild.Err)
+	}
+}
+
+func TestStartWithoutSpan(t *testing.T) {
+	ctx, span := tracing.Start(context.Background(), "orphan", tracing.KindInternal)
+	if span != nil {
+		t.Fatalf("Start without a span in ctx = %v, want nil", span)
+	}
+	if tracing.SpanFromContext(ctx) != nil {
+		t.Error("context carries a span")
+	}
+	// A nil span must be safe to use.
+	span.SetName("renamed")
+	span.SetAttributes(tracing.String("k", "v"))
+	span.SetError(errors.New("boom"))
+	span.End()
+	if span.SpanContext().IsValid() {
+		t.Error("nil span has a valid span context")
+	}
+}
+
+func TestTracerWithoutExporter(t *testing.T) {
+	tracer := tracing.NewTracer(nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx, span := tracer.Start(context.Background(), "root", tracing.KindServer)
+	if !span.SpanContext().IsValid() {
+		t.Error("span without exporter has no IDs; trace context would not propagate")
+	}
+	if _, child := tracing.Start(ctx, "child", tracing.KindClient); child.SpanContext().TraceID != span.SpanContext().TraceID {
+		t.Error("child of a non-recording span started a new trace")
+	}
+	span.End()
+	if err := tracer.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() = %v", err)
+	}
+}
+
+func TestTracerRemoteParent(t *testing.T) {
+	remote := tracing.SpanContext{
+		TraceID: tracing.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:  tracing.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+	}
+
+	tests := []struct {
+		name    string
+		sampled bool
+		want    int
+	}{
+		{name: "sampled", sampled: true, want: 1},
+		{name: "not sampled", sampled: false, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracer, exporter := newTestTracer(t)
+			remote := remote
+			remote.Sampled = tt.sampled
+
+			_, span := tracer.Start(tracing.ContextWithRemoteParent(context.Background(), remote), "POST /webhook/line", tracing.KindServer)
+			if got := span.SpanContext().TraceID; got != remote.TraceID {
+				t.Errorf("trace = %s, want the remote %s", got, remote.TraceID)
+			}
+			span.End()
+
+			tracer.ForceFlush(context.Background())
+			spans := exporter.Spans()
+			if len(spans) != tt.want {
+				t.Fatalf("exported %d spans, want %d", len(spans), tt.want)
+			}
+			if tt.want > 0 && spans[0].Parent != remote.SpanID {
+				t.Errorf("parent = %s, want the remote %s", spans[0].Parent, remote.SpanID)
+			}
+		})
+	}
+}
+
+func TestTracerShutdownExportsQueued(t *testing.T) {
+	exporter := tracing.NewInMemoryExporter()
+	tracer := tracing.NewTracer(exporter, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	for i := 0; i < 3; i++ {
+		_, span := tracer.Start(context.Background(), "job", tracing.KindInternal)
+		span.End()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := tracer.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown() = %v", err)
+	}
+	if got := len(exporter.Spans()); got != 3 {
+		t.Errorf("exported %d spans, want 3", got)
+	}
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ErrUnknownActor is returned when the acting user does not exist.
+var ErrUnknownActor = domain.NewError(domain.ErrUnauthenticated, "acting user not found")
+
+// Actor identifies the user performing an action. REST callers are known by
+// their user ID, LINE webhook senders by their LINE user ID; set exactly one.
+type Actor struct {
+	UserID     string
+	LineUserID string
+}
+
+// resolveActor loads the user behind actor.
+func resolveActor(ctx context.Context, userRepo repository.UserRepository, actor Actor) (*domain.User, error) {
+	var (
+		user *domain.User
+		err  error
+	)
+	switch {
+	case actor.UserID != "":
+		user, err = userRepo.FindByID(ctx, actor.UserID)
+	case actor.LineUserID != "":
+		user, err = userRepo.FindByLineUserID(ctx, actor.LineUserID)
+	default:
+		return nil, ErrUnknownActor
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find acting user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %s%s", ErrUnknownActor, actor.UserID, actor.LineUserID)
+	}
+	return user, nil
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// maxAnchorBatchSize bounds the number of interactions rolled into one epoch.
+const maxAnchorBatchSize = 1024
+
+// AnchorInteractionsOutput represents the output of an anchoring run.
+type AnchorInteractionsOutput struct {
+	// Batches are the batches anchored during this run, including retried ones.
+	Batches []*domain.AnchorBatch
+}
+
+// AnchorInteractionsUsecase rolls newly approved interactions up into a Merkle
+// root per epoch and publishes the root through the AnchorService.
+// It is meant to run periodically.
+type AnchorInteractionsUsecase struct {
+	anchorRepo    repository.AnchorRepository
+	anchorService repository.AnchorService
+}
+
+// NewAnchorInteractionsUsecase creates a new AnchorInteractionsUsecase.
+func NewAnchorInteractionsUsecase(
+	anchorRepo repository.AnchorRepository,
+	anchorService repository.AnchorService,
+) *AnchorInteractionsUsecase {
+	return &AnchorInteractionsUsecase{
+		anchorRepo:    anchorRepo,
+		anchorService: anchorService,
+	}
+}
+
+// Execute publishes batches left unanchored by an earlier run, then creates
+// and publishes a batch of newly approved interactions, if there are any.
+// A batch is saved before it is published so that a crash in between leaves
+// a batch to retry rather than a root on-chain that nobody can prove against.
+func (u *AnchorInteractionsUsecase) Execute(ctx context.Context) (*AnchorInteractionsOutput, error) {
+	output := &AnchorInteractionsOutput{}
+
+	// 1. Retry batches that were saved but not published
+	unanchored, err := u.anchorRepo.FindUnanchored(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unanchored batches: %w", err)
+	}
+	for _, batch := range unanchored {
+		if err := u.publish(ctx, batch); err != nil {
+			return output, err
+		}
+		output.Batches = append(output.Batches, batch)
+	}
+
+	// 2. Roll up newly approved interactions
+	ids, err := u.anchorRepo.FindPendingInteractionIDs(ctx, maxAnchorBatchSize)
+	if err != nil {
+		return output, fmt.Errorf("failed to find interactions to anchor: %w", err)
+	}
+	if len(ids) == 0 {
+		return output, nil
+	}
+
+	latest, err := u.anchorRepo.FindLatest(ctx)
+	if err != nil {
+		return output, fmt.Errorf("failed to find latest batch: %w", err)
+	}
+	epoch := int64(1)
+	if latest != nil {
+		epoch = latest.Epoch + 1
+	}
+
+	batch := domain.NewAnchorBatch(epoch, ids, time.Now())
+	if err := u.anchorRepo.Save(ctx, batch); err != nil {
+		return output, fmt.Errorf("failed to save batch: %w", err)
+	}
+	if err := u.publish(ctx, batch); err != nil {
+		return output, err
+	}
+	output.Batches = append(output.Batches, batch)
+	return output, nil
+}
+
+// publish anchors the batch root and records the chain reference.
+func (u *AnchorInteractionsUsecase) publish(ctx context.Context, batch *domain.AnchorBatch) error {
+	txRef, err := u.anchorService.Anchor(ctx, batch.Epoch, batch.Root)
+	if err != nil {
+		return fmt.Errorf("failed to anchor epoch %d: %w", batch.Epoch, err)
+	}
+	batch.MarkAnchored(txRef, time.Now())
+	if err := u.anchorRepo.Update(ctx, batch); err != nil {
+		return fmt.Errorf("failed to record anchor of epoch %d: %w", batch.Epoch, err)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+	"github.com/dkpcb/pet/usecase"
+)
+
+// memoryAnchorRepository keeps batches in memory, with a fixed set of
+// approved interactions waiting to be anchored.
+type memoryAnchorRepository struct {
+	pending []string
+	batches map[int64]*domain.AnchorBatch
+}
+
+func newMemoryAnchorRepository(pending ...string) *memoryAnchorRepository {
+	return &memoryAnchorRepository{pending: pending, batches: map[int64]*domain.AnchorBatch{}}
+}
+
+func (r *memoryAnchorRepository) Save(ctx context.Context, batch *domain.AnchorBatch) error {
+	if _, ok := r.batches[batch.Epoch]; ok {
+		return fmt.Errorf("epoch %d already saved", batch.Epoch)
+	}
+	stored := *batch
+	r.batches[batch.Epoch] = &stored
+	r.pending = nil
+	return nil
+}
+
+func (r *memoryAnchorRepository) Update(ctx context.Context, batch *domain.AnchorBatch) error {
+	stored := *batch
+	r.batches[batch.Epoch] = &stored
+	return nil
+}
+
+func (r *memoryAnchorRepository) FindLatest(ctx context.Context) (*domain.AnchorBatch, error) {
+	var latest *domain.AnchorBatch
+	for _, b := range r.batches {
+		if latest == nil || b.Epoch > latest.Epoch {
+			latest = b
+		}
+	}
+	return latest, nil
+}
+
+func (r *memoryAnchorRepository) FindUnanchored(ctx context.Context) ([]*domain.AnchorBatch, error) {
+	var batches []*domain.AnchorBatch
+	for _, b := range r.batches {
+		if !b.IsAnchored() {
+			batches = append(batches, b)
+		}
+	}
+	sort.Slice(batches, func(i, j int) bool { return batches[i].Epoch < batches[j].Epoch })
+	return batches, nil
+}
+
+func (r *memoryAnchorRepository) FindByInteractionID(ctx context.Context, interactionID string) (*domain.AnchorBatch, error) {
+	for _, b := range r.batches {
+		for _, id := range b.InteractionIDs {
+			if id == interactionID {
+				return b, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryAnchorRepository) FindPendingInteractionIDs(ctx context.Context, limit int) ([]string, error) {
+	if len(r.pending) > limit {
+		return r.pending[:limit], nil
+	}
+	return r.pending, nil
+}
+
+func TestGetInclusionProof(t *testing.T) {
+	ctx := context.Background()
+	ids := []string{"i1", "i2", "i3"}
+	anchorRepo := newMemoryAnchorRepository(ids...)
+	chain := infrastructure.NewFakeChain()
+
+	if _, err := usecase.NewAnchorInteractionsUsecase(anchorRepo, chain).Execute(ctx); err != nil {
+		t.Fatalf("anchoring: %v", err)
+	}
+
+	getProof := usecase.NewGetInclusionProofUsecase(anchorRepo, chain)
+	for _, id := range ids {
+		output, err := getProof.Execute(ctx, &usecase.GetInclusionProofInput{InteractionID: id})
+		if err != nil {
+			t.Fatalf("proof of %s: %v", id, err)
+		}
+		if !domain.VerifyMerkleProof(output.Root, id, output.Proof) {
+			t.Errorf("proof of %s does not verify against the root", id)
+		}
+		if output.Epoch != 1 || output.TxRef == "" {
+			t.Errorf("proof of %s = epoch %d, txRef %q; want epoch 1 with a reference", id, output.Epoch, output.TxRef)
+		}
+	}
+
+	_, err := getProof.Execute(ctx, &usecase.GetInclusionProofInput{InteractionID: "unknown"})
+	if !errors.Is(err, usecase.ErrInclusionProofNotFound) || !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("proof of an unanchored interaction = %v, want ErrInclusionProofNotFound", err)
+	}
+}
+
+func TestGetInclusionProofRootMismatch(t *testing.T) {
+	ctx := context.Background()
+	anchorRepo := newMemoryAnchorRepository("i1", "i2")
+	if _, err := usecase.NewAnchorInteractionsUsecase(anchorRepo, infrastructure.NewFakeChain()).Execute(ctx); err != nil {
+		t.Fatalf("anchoring: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		chain func(t *testing.T) *infrastructure.FakeChain
+	}{
+		{
+			// What an in-memory chain looks like after a restart.
+			name:  "root missing",
+			chain: func(t *testing.T) *infrastructure.FakeChain { return infrastructure.NewFakeChain() },
+		},
+		{
+			name: "different root",
+			chain: func(t *testing.T) *infrastructure.FakeChain {
+				chain := infrastructure.NewFakeChain()
+				if _, err := chain.Anchor(ctx, 1, domain.NewMerkleTree([]string{"other"}).Root()); err != nil {
+					t.Fatal(err)
+				}
+				return chain
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := usecase.NewGetInclusionProofUsecase(anchorRepo, tt.chain(t)).
+				Execute(ctx, &usecase.GetInclusionProofInput{InteractionID: "i1"})
+			if !errors.Is(err, usecase.ErrAnchoredRootMismatch) || !errors.Is(err, domain.ErrConflict) {
+				t.Errorf("Execute() = %v, want ErrAnchoredRootMismatch", err)
+			}
+		})
+	}
+}
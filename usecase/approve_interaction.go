@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ApproveInteractionInput represents the input for approving an interaction.
+type ApproveInteractionInput struct {
+	Approver      Actor
+	InteractionID string
+}
+
+// ApproveInteractionOutput represents the output of approving an interaction.
+type ApproveInteractionOutput struct {
+	Interaction *domain.Interaction
+}
+
+// ApproveInteractionUsecase lets the approver accept a meeting request.
+// Approval connects the two users, so it is refused while either of them is
+// at the connection limit.
+type ApproveInteractionUsecase struct {
+	interactionRepo repository.InteractionRepository
+	userRepo        repository.UserRepository
+	lineService     repository.LineService
+	attester        *interactionAttester
+	relationships   *relationshipRecorder
+	logger          *slog.Logger
+}
+
+// NewApproveInteractionUsecase creates a new ApproveInteractionUsecase.
+func NewApproveInteractionUsecase(
+	interactionRepo repository.InteractionRepository,
+	userRepo repository.UserRepository,
+	lineService repository.LineService,
+	attestationSigner repository.AttestationSigner,
+	relationshipRepo repository.RelationshipRepository,
+	policy domain.RelationshipPolicy,
+	logger *slog.Logger,
+) *ApproveInteractionUsecase {
+	return &ApproveInteractionUsecase{
+		interactionRepo: interactionRepo,
+		userRepo:        userRepo,
+		lineService:     lineService,
+		attester:        &interactionAttester{userRepo: userRepo, signer: attestationSigner},
+		relationships:   &relationshipRecorder{relationshipRepo: relationshipRepo, policy: policy},
+		logger:          logger,
+	}
+}
+
+// Execute approves the interaction.
+func (u *ApproveInteractionUsecase) Execute(ctx context.Context, input *ApproveInteractionInput) (*ApproveInteractionOutput, error) {
+	// 1. Resolve the approver and the pending interaction
+	approver, err := resolveActor(ctx, u.userRepo, input.Approver)
+	if err != nil {
+		return nil, err
+	}
+	interaction, err := findPendingInteraction(ctx, u.interactionRepo, input.InteractionID, approver.ID)
+	if err != nil {
+		return nil, err
+	}
+	requester, err := u.userRepo.FindByID(ctx, interaction.RequesterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find requester: %w", err)
+	}
+	if requester == nil {
+		return nil, fmt.Errorf("requester user not found: %s", interaction.RequesterID)
+	}
+
+	// 2. Approve and sign before connecting, so nothing is recorded if signing fails
+	interaction.Approve()
+	if err := u.attester.attest(ctx, interaction); err != nil {
+		return nil, err
+	}
+
+	// 3. Save the approval and connect the users together, unless the
+	// interaction was answered meanwhile or either user is at the
+	// connection limit
+	full, ok, err := u.interactionRepo.TransitionAndConnect(ctx, interaction, domain.InteractionStatusPending,
+		u.relationships.policy, u.relationships.meeting(time.Now()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve interaction: %w", err)
+	}
+	if full != "" {
+		limitErr := &ConnectionLimitError{UserID: full, Limit: u.relationships.policy.MaxDegree}
+		if full == requester.ID {
+			limitErr.Partner = requester
+		}
+		return nil, limitErr
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInteractionNotPending, interaction.ID)
+	}
+
+	// 4. Tell the requester
+	if err := u.lineService.SendMessage(ctx, requester.LineUserID, interactionApprovedMessage(requester.Locale, approver)); err != nil {
+		u.logger.WarnContext(ctx, "failed to send LINE notification", "recipient_id", requester.ID, "error", err)
+	}
+
+	return &ApproveInteractionOutput{Interaction: interaction}, nil
+}
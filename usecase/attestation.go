@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// interactionAttester issues the signed attestation of an approved interaction.
+// Every flow that approves an interaction goes through it so no approved
+// interaction is left without proof.
+type interactionAttester struct {
+	userRepo repository.UserRepository
+	signer   repository.AttestationSigner
+}
+
+// attest signs an attestation for interaction and attaches it.
+// The caller is responsible for persisting the interaction.
+func (a *interactionAttester) attest(ctx context.Context, interaction *domain.Interaction) error {
+	requester, err := a.userRepo.FindByID(ctx, interaction.RequesterID)
+	if err != nil {
+		return fmt.Errorf("failed to find requester: %w", err)
+	}
+	if requester == nil {
+		return fmt.Errorf("requester user not found: %s", interaction.RequesterID)
+	}
+	approver, err := a.userRepo.FindByID(ctx, interaction.ApproverID)
+	if err != nil {
+		return fmt.Errorf("failed to find approver: %w", err)
+	}
+	if approver == nil {
+		return fmt.Errorf("approver user not found: %s", interaction.ApproverID)
+	}
+
+	attestation := domain.NewAttestation(interaction, requester, approver, time.Now())
+	signer, signature, err := a.signer.Sign(ctx, attestation)
+	if err != nil {
+		return fmt.Errorf("failed to sign attestation: %w", err)
+	}
+	attestation.SetSignature(signer, signature)
+	interaction.Attest(attestation)
+	return nil
+}
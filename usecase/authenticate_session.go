@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// AuthenticateSessionInput represents the input for authenticating a request.
+type AuthenticateSessionInput struct {
+	AccessToken string
+}
+
+// AuthenticateSessionOutput represents the authenticated caller.
+type AuthenticateSessionOutput struct {
+	Actor Actor
+}
+
+// AuthenticateSessionUsecase identifies the caller of a REST request by the
+// access token they were issued at sign-in.
+type AuthenticateSessionUsecase struct {
+	signer repository.SessionTokenSigner
+}
+
+// NewAuthenticateSessionUsecase creates a new AuthenticateSessionUsecase.
+func NewAuthenticateSessionUsecase(signer repository.SessionTokenSigner) *AuthenticateSessionUsecase {
+	return &AuthenticateSessionUsecase{signer: signer}
+}
+
+// Execute checks the access token. It does not look the user up; usecases
+// acting for the caller do that anyway.
+func (u *AuthenticateSessionUsecase) Execute(ctx context.Context, input *AuthenticateSessionInput) (*AuthenticateSessionOutput, error) {
+	claims, err := u.signer.Verify(input.AccessToken)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthenticated) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidAccessToken, err)
+		}
+		return nil, fmt.Errorf("failed to verify access token: %w", err)
+	}
+	return &AuthenticateSessionOutput{Actor: Actor{UserID: claims.UserID}}, nil
+}
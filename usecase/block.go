@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+var (
+	// ErrUserNotFound is returned when the user an action targets does not exist.
+	ErrUserNotFound = domain.NewError(domain.ErrNotFound, "user not found")
+	// ErrCannotBlockSelf is returned when a user tries to block themselves.
+	ErrCannotBlockSelf = domain.NewError(domain.ErrInvalidInput, "cannot block yourself")
+)
+
+// PostbackActionBlockUser is carried by the block button on request
+// notifications, along with the userId to block.
+const PostbackActionBlockUser = "user_block"
+
+// isBlocked reports whether either user has blocked the other.
+func isBlocked(ctx context.Context, blockRepo repository.BlockRepository, userID, otherID string) (bool, error) {
+	if userID == otherID {
+		return false, nil
+	}
+	blocked, err := blockRepo.ExistsBetween(ctx, userID, otherID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check block: %w", err)
+	}
+	return blocked, nil
+}
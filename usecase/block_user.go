@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// BlockUserInput represents the input for blocking a user.
+type BlockUserInput struct {
+	Blocker   Actor
+	BlockedID string
+}
+
+// BlockUserOutput represents the output of blocking a user.
+type BlockUserOutput struct {
+	Block *domain.Block
+}
+
+// BlockUserUsecase lets a user cut another out of their network. The blocked
+// user is not told: their requests are dropped and traces hidden both ways.
+type BlockUserUsecase struct {
+	blockRepo repository.BlockRepository
+	userRepo  repository.UserRepository
+}
+
+// NewBlockUserUsecase creates a new BlockUserUsecase.
+func NewBlockUserUsecase(
+	blockRepo repository.BlockRepository,
+	userRepo repository.UserRepository,
+) *BlockUserUsecase {
+	return &BlockUserUsecase{
+		blockRepo: blockRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute blocks the user and, in the same transaction, removes any
+// relationship between the two and closes what is pending between them.
+// Blocking someone already blocked is not an error.
+func (u *BlockUserUsecase) Execute(ctx context.Context, input *BlockUserInput) (*BlockUserOutput, error) {
+	// 1. Resolve both users
+	blocker, err := resolveActor(ctx, u.userRepo, input.Blocker)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := u.userRepo.FindByID(ctx, input.BlockedID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blocked user: %w", err)
+	}
+	if blocked == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, input.BlockedID)
+	}
+	if blocked.ID == blocker.ID {
+		return nil, ErrCannotBlockSelf
+	}
+
+	// 2. Save the block. Dropping the edge keeps it from counting toward
+	// either user's connections or anyone else's 2-hop paths; expiring
+	// requests and declining exchanges keeps either user from completing
+	// one that was pending
+	block := domain.NewBlock(blocker.ID, blocked.ID, time.Now())
+	if err := u.blockRepo.SaveAndDisconnect(ctx, block); err != nil {
+		return nil, fmt.Errorf("failed to save block: %w", err)
+	}
+
+	return &BlockUserOutput{Block: block}, nil
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// messageKey names a user-facing text in the catalog.
+type messageKey string
+
+// Catalog keys. Texts may contain {name} placeholders, filled from messageArgs.
+const (
+	msgInteractionRequestReceived    messageKey = "interaction.request.received"
+	msgInteractionRequestSent        messageKey = "interaction.request.sent"
+	msgInteractionApproveButton      messageKey = "interaction.button.approve"
+	msgInteractionRejectButton       messageKey = "interaction.button.reject"
+	msgBlockButton                   messageKey = "interaction.button.block"
+	msgInteractionApproved           messageKey = "interaction.approved"
+	msgConnectionLimitReached        messageKey = "connection.limit_reached"
+	msgPartnerConnectionLimitReached messageKey = "connection.partner_limit_reached"
+	msgExchangeOfferReceived         messageKey = "exchange.offer.received"
+	msgExchangeOfferOwnTrace         messageKey = "exchange.offer.own_trace"
+	msgExchangeOfferNoTraces         messageKey = "exchange.offer.no_traces"
+	msgExchangeDeclineButton         messageKey = "exchange.button.decline"
+	msgExchangeCompleteButton        messageKey = "exchange.button.complete"
+	msgExchangeCompleted             messageKey = "exchange.completed"
+	msgExchangeWithoutConnection     messageKey = "exchange.completed_without_connection"
+	msgFailureInvalidMeetCommand     messageKey = "failure.invalid_meet_command"
+	msgFailureApproverNotFound       messageKey = "failure.approver_not_found"
+	msgFailureSelfInteraction        messageKey = "failure.self_interaction"
+	msgFailureUnknownActor           messageKey = "failure.unknown_actor"
+	msgFailureCircleNotFound         messageKey = "failure.circle_not_found"
+	msgFailureCircleOutOfReach       messageKey = "failure.circle_out_of_reach"
+	msgFailureFounderCannotLeave     messageKey = "failure.founder_cannot_leave"
+	msgFailureAlreadyHandled         messageKey = "failure.already_handled"
+	msgFailureNotFound               messageKey = "failure.not_found"
+	msgFailureForbidden              messageKey = "failure.forbidden"
+	msgFailureConflict               messageKey = "failure.conflict"
+	msgFailureInvalidInput           messageKey = "failure.invalid_input"
+	msgRateLimitedRequester          messageKey = "rate_limited.requester"
+	msgRateLimitedRequesterRetryIn   messageKey = "rate_limited.requester_retry_in"
+	msgRateLimitedApprover           messageKey = "rate_limited.approver"
+)
+
+// message is a text in one locale. Texts that depend on a number have a
+// form per plural category; One is used for a count of 1 in locales that
+// distinguish it, Other everywhere else.
+type message struct {
+	One   string
+	Other string
+}
+
+// messageArgs fills the placeholders of a text. The "count" argument, if
+// present, also selects the plural form.
+type messageArgs map[string]interface{}
+
+// catalog holds every user-facing text in every supported locale.
+var catalog = map[domain.Locale]map[messageKey]message{
+	domain.LocaleJapanese: {
+		msgInteractionRequestReceived:    {Other: "{name} さんから交流申請が届きました。"},
+		msgInteractionRequestSent:        {Other: "{name} さんに交流申請を送りました。"},
+		msgInteractionApproveButton:      {Other: "承認する"},
+		msgInteractionRejectButton:       {Other: "見送る"},
+		msgBlockButton:                   {Other: "ブロックする"},
+		msgInteractionApproved:           {Other: "{name} さんが交流申請を承認しました。"},
+		msgConnectionLimitReached:        {Other: "つながりが上限の {count} 人に達しているため承認できませんでした。今あるつながりを解放すると承認できます。"},
+		msgPartnerConnectionLimitReached: {Other: "{name} さんのつながりが上限に達しているため承認できませんでした。"},
+		msgExchangeOfferReceived:         {Other: "{name} さんから表現が届きました"},
+		msgExchangeOfferOwnTrace:         {Other: "あなたの表現"},
+		msgExchangeOfferNoTraces:         {Other: "返せる表現がまだありません。表現を投稿してから交換しましょう。"},
+		msgExchangeDeclineButton:         {Other: "受け取らない"},
+		msgExchangeCompleteButton:        {Other: "この表現を返す"},
+		msgExchangeCompleted:             {Other: "{name} さんが表現を返してくれました。交換が成立しました。"},
+		msgExchangeWithoutConnection:     {Other: "交換は成立しましたが、つながりが上限に達しているため新しいつながりはできませんでした。"},
+		msgFailureInvalidMeetCommand:     {Other: "交流申請を送るには「meet_」に続けてお相手の ID を送ってください。"},
+		msgFailureApproverNotFound:       {Other: "その ID のユーザーは見つかりませんでした。ID をご確認ください。"},
+		msgFailureSelfInteraction:        {Other: "自分自身に交流申請を送ることはできません。"},
+		msgFailureUnknownActor:           {Other: "ユーザー登録が見つからないため、操作できませんでした。"},
+		msgFailureCircleNotFound:         {Other: "そのサークルは見つかりませんでした。"},
+		msgFailureCircleOutOfReach:       {Other: "サークルの創設者から {count} 人以内のつながりがないため参加できません。"},
+		msgFailureFounderCannotLeave:     {Other: "創設者は自分のサークルを抜けられません。"},
+		msgFailureAlreadyHandled:         {Other: "すでに対応済みです。"},
+		msgFailureNotFound:               {Other: "対象が見つかりませんでした。"},
+		msgFailureForbidden:              {Other: "この操作は許可されていません。"},
+		msgFailureConflict:               {Other: "現在の状態ではこの操作はできません。"},
+		msgFailureInvalidInput:           {Other: "内容に誤りがあるため受け付けられませんでした。"},
+		msgRateLimitedRequester:          {Other: "申請が続いているため受け付けられませんでした。時間をおいてからお試しください。"},
+		msgRateLimitedRequesterRetryIn:   {Other: "申請が続いているため受け付けられませんでした。{count} 分ほど時間をおいてからお試しください。"},
+		msgRateLimitedApprover:           {Other: "お相手に届いている申請が多いため、今は申請を送れません。時間をおいてからお試しください。"},
+	},
+	domain.LocaleEnglish: {
+		msgInteractionRequestReceived: {Other: "{name} sent you a request to connect."},
+		msgInteractionRequestSent:     {Other: "Your request was sent to {name}."},
+		msgInteractionApproveButton:   {Other: "Approve"},
+		msgInteractionRejectButton:    {Other: "Not now"},
+		msgBlockButton:                {Other: "Block"},
+		msgInteractionApproved:        {Other: "{name} approved your request."},
+		msgConnectionLimitReached: {
+			One:   "You already have the maximum of {count} connection, so the request was not approved. Release a connection to approve it.",
+			Other: "You already have the maximum of {count} connections, so the request was not approved. Release a connection to approve it.",
+		},
+		msgPartnerConnectionLimitReached: {Other: "{name} has reached their connection limit, so the request was not approved."},
+		msgExchangeOfferReceived:         {Other: "{name} sent you a trace"},
+		msgExchangeOfferOwnTrace:         {Other: "Your trace"},
+		msgExchangeOfferNoTraces:         {Other: "You have no traces to send back yet. Post one, then exchange."},
+		msgExchangeDeclineButton:         {Other: "Decline"},
+		msgExchangeCompleteButton:        {Other: "Send this back"},
+		msgExchangeCompleted:             {Other: "{name} answered with a trace of their own. The exchange is complete."},
+		msgExchangeWithoutConnection:     {Other: "The exchange is complete, but you have reached your connection limit, so no new connection was made."},
+		msgFailureInvalidMeetCommand:     {Other: "To send a request, send \"meet_\" followed by the other person's ID."},
+		msgFailureApproverNotFound:       {Other: "No user has that ID. Please check it and try again."},
+		msgFailureSelfInteraction:        {Other: "You cannot send a request to yourself."},
+		msgFailureUnknownActor:           {Other: "You are not registered, so this could not be done."},
+		msgFailureCircleNotFound:         {Other: "That circle was not found."},
+		msgFailureCircleOutOfReach: {
+			One:   "You cannot join because you are not within {count} connection of the circle's founder.",
+			Other: "You cannot join because you are not within {count} connections of the circle's founder.",
+		},
+		msgFailureFounderCannotLeave: {Other: "Founders cannot leave their own circle."},
+		msgFailureAlreadyHandled:     {Other: "This has already been handled."},
+		msgFailureNotFound:           {Other: "It was not found."},
+		msgFailureForbidden:          {Other: "You are not allowed to do this."},
+		msgFailureConflict:           {Other: "This cannot be done right now."},
+		msgFailureInvalidInput:       {Other: "Something in your message was not right, so it was not accepted."},
+		msgRateLimitedRequester:      {Other: "You have sent too many requests. Please wait a while and try again."},
+		msgRateLimitedRequesterRetryIn: {
+			One:   "You have sent too many requests. Please try again in about {count} minute.",
+			Other: "You have sent too many requests. Please try again in about {count} minutes.",
+		},
+		msgRateLimitedApprover: {Other: "They have too many requests waiting right now. Please try again later."},
+	},
+}
+
+// localize renders the text for key in locale, falling back to
+// domain.DefaultLocale for unsupported locales.
+func localize(locale domain.Locale, key messageKey, args messageArgs) string {
+	messages, ok := catalog[locale]
+	if !ok {
+		locale, messages = domain.DefaultLocale, catalog[domain.DefaultLocale]
+	}
+	msg := messages[key]
+
+	text := msg.Other
+	if count, ok := args["count"].(int); ok && count == 1 && hasSingular(locale) && msg.One != "" {
+		text = msg.One
+	}
+
+	if len(args) == 0 {
+		return text
+	}
+	pairs := make([]string, 0, 2*len(args))
+	for name, value := range args {
+		pairs = append(pairs, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
+
+// hasSingular reports whether locale uses a separate form for a count of 1.
+// Japanese does not inflect for number.
+func hasSingular(locale domain.Locale) bool {
+	return locale != domain.LocaleJapanese
+}
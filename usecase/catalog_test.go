@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// catalogKeys returns every key used in any locale of the catalog.
+func catalogKeys() []messageKey {
+	seen := map[messageKey]bool{}
+	for _, messages := range catalog {
+		for key := range messages {
+			seen[key] = true
+		}
+	}
+	keys := make([]messageKey, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func TestCatalogHasEveryKeyInEveryLocale(t *testing.T) {
+	for _, locale := range domain.SupportedLocales {
+		messages, ok := catalog[locale]
+		if !ok {
+			t.Errorf("catalog has no texts for supported locale %s", locale)
+			continue
+		}
+		for _, key := range catalogKeys() {
+			if messages[key].Other == "" {
+				t.Errorf("catalog is missing %s/%s", locale, key)
+			}
+		}
+	}
+}
+
+var placeholderPattern = regexp.MustCompile(`\{[a-z_]+\}`)
+
+// placeholders returns the placeholders of both forms of msg, sorted.
+func placeholders(msg message) string {
+	seen := map[string]bool{}
+	for _, p := range placeholderPattern.FindAllString(msg.One+msg.Other, -1) {
+		seen[p] = true
+	}
+	list := make([]string, 0, len(seen))
+	for p := range seen {
+		list = append(list, p)
+	}
+	sort.Strings(list)
+	return strings.Join(list, " ")
+}
+
+func TestCatalogPlaceholdersMatchAcrossLocales(t *testing.T) {
+	for _, key := range catalogKeys() {
+		want := placeholders(catalog[domain.DefaultLocale][key])
+		for _, locale := range domain.SupportedLocales {
+			if got := placeholders(catalog[locale][key]); got != want {
+				t.Errorf("%s/%s uses placeholders %q, %s uses %q", locale, key, got, domain.DefaultLocale, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// Components checked for readiness.
+const (
+	ComponentDatabase        = "database"
+	ComponentSchema          = "schema"
+	ComponentLineCredentials = "line_credentials"
+)
+
+// readinessCheckTimeout bounds each probe, so a hung database fails the
+// check instead of hanging the load balancer's request.
+const readinessCheckTimeout = 2 * time.Second
+
+// ComponentStatus is how one dependency of the server is doing.
+type ComponentStatus struct {
+	Name string
+	OK   bool
+	// Detail explains the status in terms safe to show to anyone.
+	Detail string
+	// Err is the underlying failure, for logs only. It is set when OK is not.
+	Err error
+}
+
+// CheckReadinessOutput represents the output of a readiness check.
+type CheckReadinessOutput struct {
+	// Ready is set when every component is OK.
+	Ready      bool
+	Components []ComponentStatus
+}
+
+// CheckReadinessUsecase checks whether the server can do its work: the
+// database answers, its schema is the one this build expects, and LINE
+// accepts the channel credentials.
+type CheckReadinessUsecase struct {
+	databaseProbe repository.DatabaseProbe
+	lineService   repository.LineService
+
+	// LINE credentials only change with the configuration, so once LINE
+	// has accepted or rejected them the answer is kept. Failures to ask
+	// are retried on the next check.
+	lineMu      sync.Mutex
+	lineChecked bool
+	lineStatus  ComponentStatus
+}
+
+// NewCheckReadinessUsecase creates a new CheckReadinessUsecase.
+func NewCheckReadinessUsecase(
+	databaseProbe repository.DatabaseProbe,
+	lineService repository.LineService,
+) *CheckReadinessUsecase {
+	return &CheckReadinessUsecase{
+		databaseProbe: databaseProbe,
+		lineService:   lineService,
+	}
+}
+
+// Execute checks every component. Failing components are reported in the
+// output, not as an error.
+func (u *CheckReadinessUsecase) Execute(ctx context.Context) (*CheckReadinessOutput, error) {
+	// 1. Check that the database answers
+	database := u.checkDatabase(ctx)
+
+	// 2. Check the schema, unless the database is already known to be down
+	schema := ComponentStatus{Name: ComponentSchema, Detail: "database unreachable", Err: database.Err}
+	if database.OK {
+		schema = u.checkSchema(ctx)
+	}
+
+	// 3. Check the LINE credentials until LINE answers
+	line := u.lineCredentials(ctx)
+
+	components := []ComponentStatus{database, schema, line}
+	ready := true
+	for _, c := range components {
+		ready = ready && c.OK
+	}
+	return &CheckReadinessOutput{Ready: ready, Components: components}, nil
+}
+
+func (u *CheckReadinessUsecase) checkDatabase(ctx context.Context) ComponentStatus {
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+	if err := u.databaseProbe.Ping(ctx); err != nil {
+		return ComponentStatus{Name: ComponentDatabase, Detail: "unreachable", Err: err}
+	}
+	return ComponentStatus{Name: ComponentDatabase, OK: true}
+}
+
+func (u *CheckReadinessUsecase) checkSchema(ctx context.Context) ComponentStatus {
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+	current, expected, err := u.databaseProbe.SchemaVersion(ctx)
+	if err != nil {
+		return ComponentStatus{Name: ComponentSchema, Detail: "version unknown", Err: err}
+	}
+	detail := fmt.Sprintf("version %d, expected %d", current, expected)
+	// A newer schema than expected means this build is older than the
+	// database; it may not understand what it reads.
+	if current != expected {
+		err := fmt.Errorf("schema is at version %d but this build expects %d", current, expected)
+		return ComponentStatus{Name: ComponentSchema, Detail: detail, Err: err}
+	}
+	return ComponentStatus{Name: ComponentSchema, OK: true, Detail: detail}
+}
+
+// lineCredentials returns the kept LINE credentials status, checking them
+// first if LINE has not answered yet.
+func (u *CheckReadinessUsecase) lineCredentials(ctx context.Context) ComponentStatus {
+	u.lineMu.Lock()
+	defer u.lineMu.Unlock()
+	if !u.lineChecked {
+		u.lineStatus, u.lineChecked = u.checkLineCredentials(ctx)
+	}
+	return u.lineStatus
+}
+
+// checkLineCredentials asks LINE about the credentials. It reports whether
+// the answer is final: LINE accepted or rejected them, rather than not
+// being reachable.
+func (u *CheckReadinessUsecase) checkLineCredentials(ctx context.Context) (ComponentStatus, bool) {
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+	err := u.lineService.VerifyCredentials(ctx)
+	switch {
+	case errors.Is(err, domain.ErrUnauthenticated):
+		return ComponentStatus{Name: ComponentLineCredentials, Detail: "rejected or not configured", Err: err}, true
+	case err != nil:
+		return ComponentStatus{Name: ComponentLineCredentials, Detail: "LINE unreachable", Err: err}, false
+	}
+	return ComponentStatus{Name: ComponentLineCredentials, OK: true}, true
+}
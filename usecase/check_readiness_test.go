@@ -0,0 +1,76 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+	"github.com/dkpcb/pet/usecase"
+)
+
+// healthyDatabase answers every probe with a database at the expected schema.
+type healthyDatabase struct{}
+
+func (healthyDatabase) Ping(ctx context.Context) error { return nil }
+
+func (healthyDatabase) SchemaVersion(ctx context.Context) (int, int, error) { return 1, 1, nil }
+
+// scriptedLineService answers VerifyCredentials with the next error in answers.
+type scriptedLineService struct {
+	repository.LineService
+	answers []error
+	calls   int
+}
+
+func (s *scriptedLineService) VerifyCredentials(ctx context.Context) error {
+	err := s.answers[s.calls]
+	s.calls++
+	return err
+}
+
+func lineStatus(t *testing.T, u *usecase.CheckReadinessUsecase) usecase.ComponentStatus {
+	t.Helper()
+	output, err := u.Execute(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range output.Components {
+		if c.Name == usecase.ComponentLineCredentials {
+			return c
+		}
+	}
+	t.Fatal("no LINE credentials status")
+	return usecase.ComponentStatus{}
+}
+
+func TestCheckReadinessRetriesUnreachableLine(t *testing.T) {
+	line := &scriptedLineService{answers: []error{errors.New("connection refused"), nil}}
+	u := usecase.NewCheckReadinessUsecase(healthyDatabase{}, line)
+
+	if status := lineStatus(t, u); status.OK {
+		t.Error("LINE credentials OK while LINE was unreachable")
+	}
+	if status := lineStatus(t, u); !status.OK {
+		t.Errorf("LINE credentials not OK once LINE accepted them: %+v", status)
+	}
+	lineStatus(t, u)
+	if line.calls != 2 {
+		t.Errorf("LINE asked %d times, want 2", line.calls)
+	}
+}
+
+func TestCheckReadinessKeepsRejectedCredentials(t *testing.T) {
+	line := &scriptedLineService{answers: []error{domain.NewError(domain.ErrUnauthenticated, "rejected")}}
+	u := usecase.NewCheckReadinessUsecase(healthyDatabase{}, line)
+
+	for i := 0; i < 2; i++ {
+		if status := lineStatus(t, u); status.OK {
+			t.Error("LINE credentials OK after LINE rejected them")
+		}
+	}
+	if line.calls != 1 {
+		t.Errorf("LINE asked %d times, want 1", line.calls)
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+var (
+	// ErrCircleNotFound is returned when a circle does not exist.
+	ErrCircleNotFound = domain.NewError(domain.ErrNotFound, "circle not found")
+	// ErrNotCircleMember is returned when a non-member tries to read or post circle traces.
+	ErrNotCircleMember = domain.NewError(domain.ErrForbidden, "not a member of the circle")
+	// ErrCircleOutOfReach is returned when a user is too far from a circle's founder to join it.
+	ErrCircleOutOfReach = domain.Errorf(domain.ErrForbidden, "more than %d hops away from the founder", domain.MaxHops)
+	// ErrFounderCannotLeave is returned when a founder tries to leave their own circle.
+	ErrFounderCannotLeave = domain.NewError(domain.ErrConflict, "the founder cannot leave their own circle")
+)
+
+// findCircle loads a circle, returning ErrCircleNotFound if it does not exist.
+func findCircle(ctx context.Context, circleRepo repository.CircleRepository, id string) (*domain.Circle, error) {
+	circle, err := circleRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find circle: %w", err)
+	}
+	if circle == nil {
+		return nil, fmt.Errorf("%w: %s", ErrCircleNotFound, id)
+	}
+	return circle, nil
+}
+
+// requireMember returns ErrNotCircleMember unless userID belongs to the circle.
+func requireMember(ctx context.Context, circleRepo repository.CircleRepository, circleID, userID string) error {
+	member, err := circleRepo.FindMember(ctx, circleID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to find membership: %w", err)
+	}
+	if member == nil {
+		return fmt.Errorf("%w: %s", ErrNotCircleMember, circleID)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// CloseReportInput represents the input for a moderator's decision on a report.
+type CloseReportInput struct {
+	ReportID string
+	// Status is the outcome: resolved or dismissed.
+	Status domain.ReportStatus
+	Note   string
+}
+
+// CloseReportOutput represents the output of closing a report.
+type CloseReportOutput struct {
+	Report *domain.Report
+}
+
+// CloseReportUsecase takes a report out of the moderation queue. Acting on
+// the reported user, such as removing their traces, is done separately.
+type CloseReportUsecase struct {
+	reportRepo repository.ReportRepository
+}
+
+// NewCloseReportUsecase creates a new CloseReportUsecase.
+func NewCloseReportUsecase(reportRepo repository.ReportRepository) *CloseReportUsecase {
+	return &CloseReportUsecase{reportRepo: reportRepo}
+}
+
+// Execute records the outcome. Only open reports can be closed.
+func (u *CloseReportUsecase) Execute(ctx context.Context, input *CloseReportInput) (*CloseReportOutput, error) {
+	if input.Status != domain.ReportStatusResolved && input.Status != domain.ReportStatusDismissed {
+		return nil, domain.Errorf(domain.ErrInvalidInput, "cannot close a report as %q", input.Status)
+	}
+
+	report, err := u.reportRepo.FindByID(ctx, input.ReportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find report: %w", err)
+	}
+	if report == nil {
+		return nil, fmt.Errorf("%w: %s", ErrReportNotFound, input.ReportID)
+	}
+	if !report.IsOpen() {
+		return nil, fmt.Errorf("%w: %s is %s", ErrReportNotOpen, report.ID, report.Status)
+	}
+
+	report.Close(input.Status, input.Note, time.Now())
+	closed, err := u.reportRepo.Close(ctx, report)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close report: %w", err)
+	}
+	if !closed {
+		return nil, fmt.Errorf("%w: %s", ErrReportNotOpen, report.ID)
+	}
+	return &CloseReportOutput{Report: report}, nil
+}
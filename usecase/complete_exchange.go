@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// CompleteExchangeInput represents the input for answering an exchange.
+type CompleteExchangeInput struct {
+	Recipient  Actor
+	ExchangeID string
+	TraceID    string
+}
+
+// CompleteExchangeOutput represents the output of answering an exchange.
+type CompleteExchangeOutput struct {
+	Exchange *domain.Exchange
+}
+
+// CompleteExchangeUsecase lets the recipient answer an offer with one of their
+// own traces, which completes the exchange and strengthens the relationship.
+type CompleteExchangeUsecase struct {
+	exchangeRepo  repository.ExchangeRepository
+	traceRepo     repository.TraceRepository
+	relationships *relationshipRecorder
+	userRepo      repository.UserRepository
+	lineService   repository.LineService
+	logger        *slog.Logger
+}
+
+// NewCompleteExchangeUsecase creates a new CompleteExchangeUsecase.
+func NewCompleteExchangeUsecase(
+	exchangeRepo repository.ExchangeRepository,
+	traceRepo repository.TraceRepository,
+	relationshipRepo repository.RelationshipRepository,
+	policy domain.RelationshipPolicy,
+	userRepo repository.UserRepository,
+	lineService repository.LineService,
+	logger *slog.Logger,
+) *CompleteExchangeUsecase {
+	return &CompleteExchangeUsecase{
+		exchangeRepo:  exchangeRepo,
+		traceRepo:     traceRepo,
+		relationships: &relationshipRecorder{relationshipRepo: relationshipRepo, policy: policy},
+		userRepo:      userRepo,
+		lineService:   lineService,
+		logger:        logger,
+	}
+}
+
+// Execute completes the exchange.
+func (u *CompleteExchangeUsecase) Execute(ctx context.Context, input *CompleteExchangeInput) (*CompleteExchangeOutput, error) {
+	// 1. Resolve the recipient, the exchange and the answering trace
+	recipient, err := resolveActor(ctx, u.userRepo, input.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	exchange, err := findPendingExchange(ctx, u.exchangeRepo, input.ExchangeID, recipient.ID)
+	if err != nil {
+		return nil, err
+	}
+	trace, err := findExchangeTrace(ctx, u.traceRepo, input.TraceID, recipient.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Complete the exchange and strengthen the relationship together,
+	// unless a concurrent response won. The traces have been given either
+	// way, but a pair that is not yet connected only becomes so if both
+	// have room.
+	now := time.Now()
+	exchange.Complete(trace.ID, now)
+	full, ok, err := u.exchangeRepo.RespondAndConnect(ctx, exchange, u.relationships.policy, u.relationships.exchange(now))
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete exchange: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrExchangeNotPending, exchange.ID)
+	}
+	if full != "" {
+		if err := u.lineService.SendMessage(ctx, recipient.LineUserID, exchangeWithoutConnectionMessage(recipient.Locale)); err != nil {
+			u.logger.WarnContext(ctx, "failed to send LINE notification", "recipient_id", recipient.ID, "error", err)
+		}
+	}
+
+	// 3. Tell the offerer
+	offerer, err := u.userRepo.FindByID(ctx, exchange.OffererID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find offerer: %w", err)
+	}
+	if offerer != nil {
+		if err := u.lineService.SendMessage(ctx, offerer.LineUserID, exchangeCompletedMessage(offerer.Locale, recipient)); err != nil {
+			u.logger.WarnContext(ctx, "failed to send LINE notification", "recipient_id", offerer.ID, "error", err)
+		}
+	}
+
+	return &CompleteExchangeOutput{Exchange: exchange}, nil
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// CountInteractionsOutput represents the output of counting interactions.
+type CountInteractionsOutput struct {
+	// ByStatus has an entry for every status, zero if no interaction is in it.
+	ByStatus map[domain.InteractionStatus]int
+}
+
+// CountInteractionsUsecase counts all interactions per status, for
+// monitoring.
+type CountInteractionsUsecase struct {
+	interactionRepo repository.InteractionRepository
+}
+
+// NewCountInteractionsUsecase creates a new CountInteractionsUsecase.
+func NewCountInteractionsUsecase(interactionRepo repository.InteractionRepository) *CountInteractionsUsecase {
+	return &CountInteractionsUsecase{interactionRepo: interactionRepo}
+}
+
+// Execute counts the interactions in each status.
+func (u *CountInteractionsUsecase) Execute(ctx context.Context) (*CountInteractionsOutput, error) {
+	counts, err := u.interactionRepo.CountByStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count interactions: %w", err)
+	}
+
+	// Report every status, so a status dropping to zero shows as 0 rather
+	// than disappearing.
+	byStatus := map[domain.InteractionStatus]int{
+		domain.InteractionStatusPending:  0,
+		domain.InteractionStatusApproved: 0,
+		domain.InteractionStatusRejected: 0,
+		domain.InteractionStatusExpired:  0,
+	}
+	for status, count := range counts {
+		byStatus[status] = count
+	}
+	return &CountInteractionsOutput{ByStatus: byStatus}, nil
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// CreateCircleInput represents the input for founding a circle.
+type CreateCircleInput struct {
+	Founder   Actor
+	Name      string
+	Manifesto string
+}
+
+// CreateCircleOutput represents the output of founding a circle.
+type CreateCircleOutput struct {
+	Circle *domain.Circle
+}
+
+// CreateCircleUsecase lets a user found a circle. The founder becomes its first member.
+type CreateCircleUsecase struct {
+	circleRepo repository.CircleRepository
+	userRepo   repository.UserRepository
+}
+
+// NewCreateCircleUsecase creates a new CreateCircleUsecase.
+func NewCreateCircleUsecase(
+	circleRepo repository.CircleRepository,
+	userRepo repository.UserRepository,
+) *CreateCircleUsecase {
+	return &CreateCircleUsecase{
+		circleRepo: circleRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Execute founds the circle.
+func (u *CreateCircleUsecase) Execute(ctx context.Context, input *CreateCircleInput) (*CreateCircleOutput, error) {
+	founder, err := resolveActor(ctx, u.userRepo, input.Founder)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, domain.NewError(domain.ErrInvalidInput, "circle name must not be empty")
+	}
+
+	now := time.Now()
+	circle := domain.NewCircle(uuid.New().String(), founder.ID, name, strings.TrimSpace(input.Manifesto), now)
+	if err := u.circleRepo.Save(ctx, circle); err != nil {
+		return nil, fmt.Errorf("failed to save circle: %w", err)
+	}
+	if err := u.circleRepo.AddMember(ctx, domain.NewCircleMember(circle.ID, founder.ID, now)); err != nil {
+		return nil, fmt.Errorf("failed to add founder to circle: %w", err)
+	}
+
+	return &CreateCircleOutput{Circle: circle}, nil
+}
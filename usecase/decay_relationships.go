@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// decayBatchSize is how many relationships are read per page.
+const decayBatchSize = 500
+
+// DecayRelationshipsOutput represents the output of a decay run.
+type DecayRelationshipsOutput struct {
+	// Decayed is the number of relationships brought up to date.
+	Decayed int
+	// Deactivated is the number that fell below the policy's minimum strength
+	// during this run and so no longer count for reachability.
+	Deactivated int
+}
+
+// DecayRelationshipsUsecase fades every relationship by the time since it was
+// last updated. It runs periodically so that stored strengths, which
+// reachability filters on, track inactivity.
+type DecayRelationshipsUsecase struct {
+	relationshipRepo repository.RelationshipRepository
+	policy           domain.RelationshipPolicy
+}
+
+// NewDecayRelationshipsUsecase creates a new DecayRelationshipsUsecase.
+func NewDecayRelationshipsUsecase(
+	relationshipRepo repository.RelationshipRepository,
+	policy domain.RelationshipPolicy,
+) *DecayRelationshipsUsecase {
+	return &DecayRelationshipsUsecase{
+		relationshipRepo: relationshipRepo,
+		policy:           policy,
+	}
+}
+
+// Execute decays all relationships to the current time.
+func (u *DecayRelationshipsUsecase) Execute(ctx context.Context) (*DecayRelationshipsOutput, error) {
+	now := time.Now()
+	output := &DecayRelationshipsOutput{}
+
+	// Strength changes do not affect the pair ordering, so offset paging is stable
+	for offset := 0; ; offset += decayBatchSize {
+		page, err := u.relationshipRepo.List(ctx, decayBatchSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list relationships: %w", err)
+		}
+
+		for _, listed := range page {
+			// Re-read under lock so concurrent activity is not overwritten,
+			// skipping relationships deleted since the page was read
+			var wasActive, isActive bool
+			found, err := u.relationshipRepo.Modify(ctx, listed.UserAID, listed.UserBID, func(rel *domain.Relationship) {
+				wasActive = u.policy.IsActive(rel)
+				u.policy.Decay(rel, now)
+				isActive = u.policy.IsActive(rel)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to decay relationship: %w", err)
+			}
+			if !found {
+				continue
+			}
+			output.Decayed++
+			if wasActive && !isActive {
+				output.Deactivated++
+			}
+		}
+
+		if len(page) < decayBatchSize {
+			return output, nil
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// DeclineExchangeInput represents the input for declining an exchange.
+type DeclineExchangeInput struct {
+	Recipient  Actor
+	ExchangeID string
+}
+
+// DeclineExchangeOutput represents the output of declining an exchange.
+type DeclineExchangeOutput struct {
+	Exchange *domain.Exchange
+}
+
+// DeclineExchangeUsecase lets the recipient turn down an offer.
+// The offerer is not notified, so declining carries no social cost.
+type DeclineExchangeUsecase struct {
+	exchangeRepo repository.ExchangeRepository
+	userRepo     repository.UserRepository
+}
+
+// NewDeclineExchangeUsecase creates a new DeclineExchangeUsecase.
+func NewDeclineExchangeUsecase(
+	exchangeRepo repository.ExchangeRepository,
+	userRepo repository.UserRepository,
+) *DeclineExchangeUsecase {
+	return &DeclineExchangeUsecase{
+		exchangeRepo: exchangeRepo,
+		userRepo:     userRepo,
+	}
+}
+
+// Execute declines the exchange.
+func (u *DeclineExchangeUsecase) Execute(ctx context.Context, input *DeclineExchangeInput) (*DeclineExchangeOutput, error) {
+	recipient, err := resolveActor(ctx, u.userRepo, input.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	exchange, err := findPendingExchange(ctx, u.exchangeRepo, input.ExchangeID, recipient.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	exchange.Decline(time.Now())
+	ok, err := u.exchangeRepo.Respond(ctx, exchange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decline exchange: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrExchangeNotPending, exchange.ID)
+	}
+
+	return &DeclineExchangeOutput{Exchange: exchange}, nil
+}
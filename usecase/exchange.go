@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+var (
+	// ErrExchangeNotFound is returned when an exchange does not exist.
+	ErrExchangeNotFound = domain.NewError(domain.ErrNotFound, "exchange not found")
+	// ErrNotExchangeRecipient is returned when someone other than the recipient responds to an exchange.
+	ErrNotExchangeRecipient = domain.NewError(domain.ErrForbidden, "only the recipient can respond to the exchange")
+	// ErrExchangeNotPending is returned when an exchange has already been completed or declined.
+	ErrExchangeNotPending = domain.NewError(domain.ErrConflict, "exchange has already been responded to")
+	// ErrTraceNotExchangeable is returned when a trace is not the user's own unscoped trace.
+	ErrTraceNotExchangeable = domain.NewError(domain.ErrInvalidInput, "only your own traces outside circles can be exchanged")
+	// ErrRecipientNotFound is returned when the user a trace is offered to does not exist.
+	ErrRecipientNotFound = domain.NewError(domain.ErrNotFound, "recipient not found")
+)
+
+// Postback actions carried by the exchange Flex Message buttons.
+const (
+	PostbackActionCompleteExchange = "exchange_complete"
+	PostbackActionDeclineExchange  = "exchange_decline"
+)
+
+// maxExchangeOptions is how many of the recipient's traces are offered as
+// replies; a Flex carousel holds at most 12 bubbles, one is the offer itself.
+const maxExchangeOptions = 9
+
+// findExchangeTrace loads a trace the user may give in an exchange.
+func findExchangeTrace(ctx context.Context, traceRepo repository.TraceRepository, traceID, userID string) (*domain.Trace, error) {
+	trace, err := traceRepo.FindByID(ctx, traceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find trace: %w", err)
+	}
+	if trace == nil || trace.AuthorID != userID || trace.IsCircleScoped() {
+		return nil, fmt.Errorf("%w: %s", ErrTraceNotExchangeable, traceID)
+	}
+	return trace, nil
+}
+
+// findPendingExchange loads an exchange the user is expected to respond to.
+func findPendingExchange(ctx context.Context, exchangeRepo repository.ExchangeRepository, exchangeID, userID string) (*domain.Exchange, error) {
+	exchange, err := exchangeRepo.FindByID(ctx, exchangeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find exchange: %w", err)
+	}
+	if exchange == nil {
+		return nil, fmt.Errorf("%w: %s", ErrExchangeNotFound, exchangeID)
+	}
+	if !exchange.IsRecipient(userID) {
+		return nil, ErrNotExchangeRecipient
+	}
+	if !exchange.IsPending() {
+		return nil, fmt.Errorf("%w: %s", ErrExchangeNotPending, exchangeID)
+	}
+	return exchange, nil
+}
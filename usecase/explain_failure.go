@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ExplainFailureInput represents the input for telling a LINE user why their
+// message was not acted on.
+type ExplainFailureInput struct {
+	LineUserID string
+	// ReplyToken, if set, is used to answer the message directly; otherwise
+	// the explanation is pushed to LineUserID.
+	ReplyToken string
+	Err        error
+}
+
+// ExplainFailureUsecase turns the errors a LINE user can do something about
+// into a message to them. Other errors are left to the logs.
+type ExplainFailureUsecase struct {
+	userRepo    repository.UserRepository
+	lineService repository.LineService
+	logger      *slog.Logger
+}
+
+// NewExplainFailureUsecase creates a new ExplainFailureUsecase.
+func NewExplainFailureUsecase(userRepo repository.UserRepository, lineService repository.LineService, logger *slog.Logger) *ExplainFailureUsecase {
+	return &ExplainFailureUsecase{userRepo: userRepo, lineService: lineService, logger: logger}
+}
+
+// Execute sends the explanation in the sender's language. It returns false
+// if Err is not one the user is told about.
+func (u *ExplainFailureUsecase) Execute(ctx context.Context, input *ExplainFailureInput) (bool, error) {
+	text := failureMessage(u.locale(ctx, input.LineUserID), input.Err)
+	if text == "" {
+		return false, nil
+	}
+
+	var err error
+	switch {
+	case input.ReplyToken != "":
+		err = u.lineService.ReplyMessage(ctx, input.ReplyToken, text)
+	case input.LineUserID != "":
+		err = u.lineService.SendMessage(ctx, input.LineUserID, text)
+	default:
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to send explanation: %w", err)
+	}
+	return true, nil
+}
+
+// locale is the language of the LINE user, or domain.DefaultLocale for
+// senders who are not registered.
+func (u *ExplainFailureUsecase) locale(ctx context.Context, lineUserID string) domain.Locale {
+	if lineUserID == "" {
+		return domain.DefaultLocale
+	}
+	user, err := u.userRepo.FindByLineUserID(ctx, lineUserID)
+	if err != nil {
+		u.logger.WarnContext(ctx, "failed to find user for explanation", "error", err)
+		return domain.DefaultLocale
+	}
+	if user == nil {
+		return domain.DefaultLocale
+	}
+	return user.Locale
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// FindUserInput represents the input for finding a single user.
+// Exactly one of the fields must be set.
+type FindUserInput struct {
+	ID            string
+	LineUserID    string
+	WalletAddress string
+}
+
+// FindUserOutput represents the output of finding a user.
+type FindUserOutput struct {
+	User *domain.User
+}
+
+// FindUserUsecase looks up a user by one of their identifiers.
+type FindUserUsecase struct {
+	userRepo       repository.UserRepository
+	walletVerifier repository.WalletVerifier
+}
+
+// NewFindUserUsecase creates a new FindUserUsecase.
+func NewFindUserUsecase(
+	userRepo repository.UserRepository,
+	walletVerifier repository.WalletVerifier,
+) *FindUserUsecase {
+	return &FindUserUsecase{
+		userRepo:       userRepo,
+		walletVerifier: walletVerifier,
+	}
+}
+
+// Execute finds the user matching the single identifier set in input.
+func (u *FindUserUsecase) Execute(ctx context.Context, input *FindUserInput) (*FindUserOutput, error) {
+	var (
+		user *domain.User
+		err  error
+		key  string
+	)
+	switch {
+	case input.ID != "" && input.LineUserID == "" && input.WalletAddress == "":
+		key = input.ID
+		user, err = u.userRepo.FindByID(ctx, input.ID)
+	case input.LineUserID != "" && input.ID == "" && input.WalletAddress == "":
+		key = input.LineUserID
+		user, err = u.userRepo.FindByLineUserID(ctx, input.LineUserID)
+	case input.WalletAddress != "" && input.ID == "" && input.LineUserID == "":
+		// Linked wallets are stored in EIP-55 form; accept any casing here.
+		key, err = u.walletVerifier.NormalizeAddress(input.WalletAddress)
+		if err != nil {
+			return nil, domain.Errorf(domain.ErrInvalidInput, "invalid wallet address: %w", err)
+		}
+		user, err = u.userRepo.FindByWalletAddress(ctx, key)
+	default:
+		return nil, domain.NewError(domain.ErrInvalidInput, "exactly one of ID, LINE user ID or wallet address must be given")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, key)
+	}
+
+	return &FindUserOutput{User: user}, nil
+}
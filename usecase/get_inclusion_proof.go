@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ErrInclusionProofNotFound is returned when an interaction has not been anchored yet.
+var ErrInclusionProofNotFound = domain.NewError(domain.ErrNotFound, "inclusion proof not found")
+
+// ErrAnchoredRootMismatch is returned when the chain does not hold the root
+// stored for a batch, so no proof against the stored root can be trusted.
+var ErrAnchoredRootMismatch = domain.NewError(domain.ErrConflict, "anchored root does not match the stored batch")
+
+// GetInclusionProofInput represents the input for fetching an inclusion proof.
+type GetInclusionProofInput struct {
+	InteractionID string
+}
+
+// GetInclusionProofOutput proves that an interaction is part of an anchored root.
+type GetInclusionProofOutput struct {
+	InteractionID string
+	Epoch         int64
+	Root          []byte
+	TxRef         string
+	LeafIndex     int
+	Proof         []domain.MerkleStep
+}
+
+// GetInclusionProofUsecase builds the Merkle inclusion proof of an anchored interaction.
+type GetInclusionProofUsecase struct {
+	anchorRepo    repository.AnchorRepository
+	anchorService repository.AnchorService
+}
+
+// NewGetInclusionProofUsecase creates a new GetInclusionProofUsecase.
+func NewGetInclusionProofUsecase(
+	anchorRepo repository.AnchorRepository,
+	anchorService repository.AnchorService,
+) *GetInclusionProofUsecase {
+	return &GetInclusionProofUsecase{
+		anchorRepo:    anchorRepo,
+		anchorService: anchorService,
+	}
+}
+
+// Execute returns the proof, after checking that the root on-chain matches the stored batch.
+// It returns ErrInclusionProofNotFound if the interaction is not anchored yet
+// and ErrAnchoredRootMismatch if the chain disagrees with the stored batch.
+func (u *GetInclusionProofUsecase) Execute(ctx context.Context, input *GetInclusionProofInput) (*GetInclusionProofOutput, error) {
+	batch, err := u.anchorRepo.FindByInteractionID(ctx, input.InteractionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find anchor batch: %w", err)
+	}
+	if batch == nil || !batch.IsAnchored() {
+		return nil, fmt.Errorf("%w: %s", ErrInclusionProofNotFound, input.InteractionID)
+	}
+
+	index, proof, ok := batch.Proof(input.InteractionID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInclusionProofNotFound, input.InteractionID)
+	}
+
+	onChain, err := u.anchorService.FindRoot(ctx, batch.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read anchored root: %w", err)
+	}
+	if !bytes.Equal(onChain, batch.Root) {
+		return nil, fmt.Errorf("%w: epoch %d", ErrAnchoredRootMismatch, batch.Epoch)
+	}
+
+	return &GetInclusionProofOutput{
+		InteractionID: input.InteractionID,
+		Epoch:         batch.Epoch,
+		Root:          batch.Root,
+		TxRef:         batch.TxRef,
+		LeafIndex:     index,
+		Proof:         proof,
+	}, nil
+}
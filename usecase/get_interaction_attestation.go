@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ErrAttestationNotFound is returned when an interaction does not exist or has not been attested.
+var ErrAttestationNotFound = domain.NewError(domain.ErrNotFound, "attestation not found")
+
+// GetInteractionAttestationInput represents the input for fetching an attestation.
+type GetInteractionAttestationInput struct {
+	InteractionID string
+}
+
+// GetInteractionAttestationOutput represents the output of fetching an attestation.
+type GetInteractionAttestationOutput struct {
+	Attestation *domain.Attestation
+}
+
+// GetInteractionAttestationUsecase returns the signed proof of an approved interaction.
+type GetInteractionAttestationUsecase struct {
+	interactionRepo repository.InteractionRepository
+}
+
+// NewGetInteractionAttestationUsecase creates a new GetInteractionAttestationUsecase.
+func NewGetInteractionAttestationUsecase(interactionRepo repository.InteractionRepository) *GetInteractionAttestationUsecase {
+	return &GetInteractionAttestationUsecase{interactionRepo: interactionRepo}
+}
+
+// Execute returns the attestation of the interaction.
+// It returns ErrAttestationNotFound if the interaction is unknown or not approved.
+func (u *GetInteractionAttestationUsecase) Execute(ctx context.Context, input *GetInteractionAttestationInput) (*GetInteractionAttestationOutput, error) {
+	interaction, err := u.interactionRepo.FindByID(ctx, input.InteractionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find interaction: %w", err)
+	}
+	// An attestation stays on record if the interaction is later rejected or
+	// expired by an operator, but it no longer vouches for anything.
+	if interaction == nil || !interaction.IsApproved() || interaction.Attestation == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAttestationNotFound, input.InteractionID)
+	}
+	return &GetInteractionAttestationOutput{Attestation: interaction.Attestation}, nil
+}
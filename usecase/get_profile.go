@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// GetProfileInput represents the input for viewing a user's profile.
+type GetProfileInput struct {
+	Viewer Actor
+	// UserID is the user to show; empty means the viewer themselves.
+	UserID string
+}
+
+// GetProfileOutput represents the output of viewing a user's profile.
+type GetProfileOutput struct {
+	User *domain.User
+	// Self reports whether User is the viewer.
+	Self bool
+}
+
+// GetProfileUsecase shows a user's profile to the people in their reach.
+type GetProfileUsecase struct {
+	userRepo     repository.UserRepository
+	reachability *reachability
+}
+
+// NewGetProfileUsecase creates a new GetProfileUsecase.
+func NewGetProfileUsecase(
+	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
+	blockRepo repository.BlockRepository,
+	policy domain.RelationshipPolicy,
+) *GetProfileUsecase {
+	return &GetProfileUsecase{
+		userRepo:     userRepo,
+		reachability: &reachability{relationshipRepo: relationshipRepo, blockRepo: blockRepo, policy: policy},
+	}
+}
+
+// Execute returns the profile if the viewer may see it: their own, or that of
+// a user within domain.MaxHops. Profiles out of reach look the same as
+// users that do not exist.
+func (u *GetProfileUsecase) Execute(ctx context.Context, input *GetProfileInput) (*GetProfileOutput, error) {
+	// 1. Resolve the viewer
+	viewer, err := resolveActor(ctx, u.userRepo, input.Viewer)
+	if err != nil {
+		return nil, err
+	}
+	if input.UserID == "" || input.UserID == viewer.ID {
+		return &GetProfileOutput{User: viewer, Self: true}, nil
+	}
+
+	// 2. Find the user and check they are in reach
+	user, err := u.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, input.UserID)
+	}
+	reachable, err := u.reachability.withinMaxHops(ctx, viewer.ID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !reachable {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, input.UserID)
+	}
+
+	return &GetProfileOutput{User: user}, nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+var (
+	// ErrInteractionNotFound is returned when an interaction does not exist.
+	ErrInteractionNotFound = domain.NewError(domain.ErrNotFound, "interaction not found")
+	// ErrNotInteractionApprover is returned when someone other than the approver responds to a request.
+	ErrNotInteractionApprover = domain.NewError(domain.ErrForbidden, "only the approver can respond to the interaction")
+	// ErrInteractionNotPending is returned when an interaction has already been responded to.
+	ErrInteractionNotPending = domain.NewError(domain.ErrConflict, "interaction is not pending")
+	// ErrInvalidMeetCommand is returned when a request message is not "meet_" followed by a user ID.
+	ErrInvalidMeetCommand = domain.NewError(domain.ErrInvalidInput, "invalid meet command")
+	// ErrApproverNotFound is returned when a request names a user that does not exist.
+	ErrApproverNotFound = domain.NewError(domain.ErrNotFound, "approver not found")
+	// ErrSelfInteraction is returned when a user requests an interaction with themselves.
+	ErrSelfInteraction = domain.NewError(domain.ErrInvalidInput, "cannot request interaction with yourself")
+)
+
+// Postback actions carried by the interaction request Flex Message buttons.
+const (
+	PostbackActionApproveInteraction = "interaction_approve"
+	PostbackActionRejectInteraction  = "interaction_reject"
+)
+
+// findPendingInteraction loads an interaction the user is expected to respond to.
+func findPendingInteraction(ctx context.Context, interactionRepo repository.InteractionRepository, interactionID, userID string) (*domain.Interaction, error) {
+	interaction, err := interactionRepo.FindByID(ctx, interactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find interaction: %w", err)
+	}
+	if interaction == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInteractionNotFound, interactionID)
+	}
+	if interaction.ApproverID != userID {
+		return nil, ErrNotInteractionApprover
+	}
+	if !interaction.IsPending() {
+		return nil, fmt.Errorf("%w: %s", ErrInteractionNotPending, interaction.Status)
+	}
+	return interaction, nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// JoinCircleInput represents the input for joining a circle.
+type JoinCircleInput struct {
+	Member   Actor
+	CircleID string
+}
+
+// JoinCircleOutput represents the output of joining a circle.
+type JoinCircleOutput struct {
+	Circle *domain.Circle
+	Member *domain.CircleMember
+}
+
+// JoinCircleUsecase adds a user to a circle if they are close enough to its founder.
+type JoinCircleUsecase struct {
+	circleRepo   repository.CircleRepository
+	userRepo     repository.UserRepository
+	reachability *reachability
+}
+
+// NewJoinCircleUsecase creates a new JoinCircleUsecase.
+func NewJoinCircleUsecase(
+	circleRepo repository.CircleRepository,
+	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
+	blockRepo repository.BlockRepository,
+	policy domain.RelationshipPolicy,
+) *JoinCircleUsecase {
+	return &JoinCircleUsecase{
+		circleRepo:   circleRepo,
+		userRepo:     userRepo,
+		reachability: &reachability{relationshipRepo: relationshipRepo, blockRepo: blockRepo, policy: policy},
+	}
+}
+
+// Execute adds the user to the circle.
+// Only users within domain.MaxHops of the founder may join.
+func (u *JoinCircleUsecase) Execute(ctx context.Context, input *JoinCircleInput) (*JoinCircleOutput, error) {
+	user, err := resolveActor(ctx, u.userRepo, input.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	circle, err := findCircle(ctx, u.circleRepo, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.circleRepo.FindMember(ctx, circle.ID, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find membership: %w", err)
+	}
+	if existing != nil {
+		return &JoinCircleOutput{Circle: circle, Member: existing}, nil
+	}
+
+	reachable, err := u.reachability.withinMaxHops(ctx, circle.FounderID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !reachable {
+		return nil, fmt.Errorf("%w: circle %s", ErrCircleOutOfReach, circle.ID)
+	}
+
+	member := domain.NewCircleMember(circle.ID, user.ID, time.Now())
+	if err := u.circleRepo.AddMember(ctx, member); err != nil {
+		return nil, fmt.Errorf("failed to add circle member: %w", err)
+	}
+
+	return &JoinCircleOutput{Circle: circle, Member: member}, nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/repository"
+)
+
+// LeaveCircleInput represents the input for leaving a circle.
+type LeaveCircleInput struct {
+	Member   Actor
+	CircleID string
+}
+
+// LeaveCircleUsecase removes a user from a circle.
+type LeaveCircleUsecase struct {
+	circleRepo repository.CircleRepository
+	userRepo   repository.UserRepository
+}
+
+// NewLeaveCircleUsecase creates a new LeaveCircleUsecase.
+func NewLeaveCircleUsecase(
+	circleRepo repository.CircleRepository,
+	userRepo repository.UserRepository,
+) *LeaveCircleUsecase {
+	return &LeaveCircleUsecase{
+		circleRepo: circleRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Execute removes the user from the circle. The founder cannot leave,
+// because a circle without its centre has nothing to be within 2 hops of.
+func (u *LeaveCircleUsecase) Execute(ctx context.Context, input *LeaveCircleInput) error {
+	user, err := resolveActor(ctx, u.userRepo, input.Member)
+	if err != nil {
+		return err
+	}
+
+	circle, err := findCircle(ctx, u.circleRepo, input.CircleID)
+	if err != nil {
+		return err
+	}
+	if circle.IsFounder(user.ID) {
+		return ErrFounderCannotLeave
+	}
+
+	if err := u.circleRepo.RemoveMember(ctx, circle.ID, user.ID); err != nil {
+		return fmt.Errorf("failed to remove circle member: %w", err)
+	}
+	return nil
+}
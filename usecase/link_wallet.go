@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// walletChallengeTTL is how long a user has to sign a challenge.
+const walletChallengeTTL = 10 * time.Minute
+
+// IssueWalletChallengeInput represents the input for starting a wallet link.
+type IssueWalletChallengeInput struct {
+	UserID        string
+	WalletAddress string
+}
+
+// IssueWalletChallengeOutput represents the challenge the user must sign.
+type IssueWalletChallengeOutput struct {
+	WalletAddress string
+	Message       string
+	ExpiresAt     time.Time
+}
+
+// LinkWalletInput represents the input for completing a wallet link.
+type LinkWalletInput struct {
+	UserID string
+	// Signature is the 0x-prefixed hex personal_sign signature of the challenge message.
+	Signature string
+}
+
+// LinkWalletOutput represents the output of linking a wallet.
+type LinkWalletOutput struct {
+	User          *domain.User
+	WalletAddress string
+}
+
+// LinkWalletUsecase links a wallet to a user once they prove ownership by
+// signing a one-time challenge with the wallet's key.
+type LinkWalletUsecase struct {
+	userRepo       repository.UserRepository
+	challengeRepo  repository.WalletChallengeRepository
+	walletVerifier repository.WalletVerifier
+}
+
+// NewLinkWalletUsecase creates a new LinkWalletUsecase.
+func NewLinkWalletUsecase(
+	userRepo repository.UserRepository,
+	challengeRepo repository.WalletChallengeRepository,
+	walletVerifier repository.WalletVerifier,
+) *LinkWalletUsecase {
+	return &LinkWalletUsecase{
+		userRepo:       userRepo,
+		challengeRepo:  challengeRepo,
+		walletVerifier: walletVerifier,
+	}
+}
+
+// IssueChallenge creates a new challenge for linking the given wallet,
+// replacing any challenge the user had not answered yet.
+func (u *LinkWalletUsecase) IssueChallenge(ctx context.Context, input *IssueWalletChallengeInput) (*IssueWalletChallengeOutput, error) {
+	user, err := u.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, input.UserID)
+	}
+
+	address, err := u.walletVerifier.NormalizeAddress(input.WalletAddress)
+	if err != nil {
+		return nil, domain.Errorf(domain.ErrInvalidInput, "invalid wallet address: %w", err)
+	}
+	if err := u.ensureWalletAvailable(ctx, user.ID, address); err != nil {
+		return nil, err
+	}
+
+	nonce, err := newNonce()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	challenge := domain.NewWalletChallenge(user.ID, address, nonce, now, now.Add(walletChallengeTTL))
+	if err := u.challengeRepo.Save(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("failed to save wallet challenge: %w", err)
+	}
+
+	return &IssueWalletChallengeOutput{
+		WalletAddress: challenge.WalletAddress,
+		Message:       challenge.Message,
+		ExpiresAt:     challenge.ExpiresAt,
+	}, nil
+}
+
+// Execute verifies the signed challenge and links the wallet to the user.
+func (u *LinkWalletUsecase) Execute(ctx context.Context, input *LinkWalletInput) (*LinkWalletOutput, error) {
+	// 1. Load the outstanding challenge
+	challenge, err := u.challengeRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wallet challenge: %w", err)
+	}
+	if challenge == nil {
+		return nil, domain.Errorf(domain.ErrNotFound, "no wallet challenge issued for user: %s", input.UserID)
+	}
+	if challenge.IsExpired(time.Now()) {
+		if err := u.challengeRepo.Delete(ctx, challenge.UserID); err != nil {
+			return nil, fmt.Errorf("failed to delete expired wallet challenge: %w", err)
+		}
+		return nil, domain.Errorf(domain.ErrConflict, "wallet challenge expired at %s", challenge.ExpiresAt.Format(time.RFC3339))
+	}
+
+	// 2. Recover the signer and make sure it is the wallet being linked
+	signer, err := u.walletVerifier.RecoverAddress(challenge.Message, input.Signature)
+	if err != nil {
+		return nil, domain.Errorf(domain.ErrInvalidInput, "invalid wallet signature: %w", err)
+	}
+	if signer != challenge.WalletAddress {
+		return nil, domain.Errorf(domain.ErrInvalidInput, "signature is from %s, not %s", signer, challenge.WalletAddress)
+	}
+
+	// 3. Link the wallet
+	user, err := u.userRepo.FindByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, challenge.UserID)
+	}
+	if err := u.ensureWalletAvailable(ctx, user.ID, signer); err != nil {
+		return nil, err
+	}
+	user.LinkWallet(signer)
+	if err := u.userRepo.Update(ctx, user); err != nil {
+		if errors.Is(err, domain.ErrConflict) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// 4. A challenge can be answered only once
+	if err := u.challengeRepo.Delete(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete wallet challenge: %w", err)
+	}
+
+	return &LinkWalletOutput{User: user, WalletAddress: signer}, nil
+}
+
+// ensureWalletAvailable fails if the wallet is already linked to another user.
+// The unique index on users.wallet_address backs this check against races:
+// the user repository reports a wallet linked meanwhile as ErrConflict.
+func (u *LinkWalletUsecase) ensureWalletAvailable(ctx context.Context, userID, address string) error {
+	owner, err := u.userRepo.FindByWalletAddress(ctx, address)
+	if err != nil {
+		return fmt.Errorf("failed to find wallet owner: %w", err)
+	}
+	if owner != nil && owner.ID != userID {
+		return domain.Errorf(domain.ErrConflict, "wallet address is already linked to another user: %s", address)
+	}
+	return nil
+}
+
+// newNonce returns 16 random bytes as hex, unpredictable so a signature
+// cannot be prepared in advance or replayed from another site.
+func newNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,252 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+	"github.com/dkpcb/pet/usecase"
+)
+
+// memoryUserRepository keeps users in memory, keyed by ID.
+type memoryUserRepository struct {
+	users map[string]*domain.User
+}
+
+func newMemoryUserRepository(users ...*domain.User) *memoryUserRepository {
+	r := &memoryUserRepository{users: map[string]*domain.User{}}
+	for _, u := range users {
+		r.users[u.ID] = u
+	}
+	return r
+}
+
+func (r *memoryUserRepository) Save(ctx context.Context, user *domain.User) error {
+	stored := *user
+	r.users[user.ID] = &stored
+	return nil
+}
+
+func (r *memoryUserRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return nil, nil
+	}
+	found := *u
+	return &found, nil
+}
+
+func (r *memoryUserRepository) FindByLineUserID(ctx context.Context, lineUserID string) (*domain.User, error) {
+	for _, u := range r.users {
+		if u.LineUserID == lineUserID {
+			found := *u
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryUserRepository) FindByWalletAddress(ctx context.Context, walletAddress string) (*domain.User, error) {
+	for _, u := range r.users {
+		if u.WalletAddress != nil && *u.WalletAddress == walletAddress {
+			found := *u
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryUserRepository) List(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *memoryUserRepository) Update(ctx context.Context, user *domain.User) error {
+	return r.Save(ctx, user)
+}
+
+// memoryWalletChallengeRepository keeps one challenge per user in memory.
+type memoryWalletChallengeRepository struct {
+	challenges map[string]*domain.WalletChallenge
+}
+
+func newMemoryWalletChallengeRepository() *memoryWalletChallengeRepository {
+	return &memoryWalletChallengeRepository{challenges: map[string]*domain.WalletChallenge{}}
+}
+
+func (r *memoryWalletChallengeRepository) Save(ctx context.Context, challenge *domain.WalletChallenge) error {
+	stored := *challenge
+	r.challenges[challenge.UserID] = &stored
+	return nil
+}
+
+func (r *memoryWalletChallengeRepository) FindByUserID(ctx context.Context, userID string) (*domain.WalletChallenge, error) {
+	c, ok := r.challenges[userID]
+	if !ok {
+		return nil, nil
+	}
+	found := *c
+	return &found, nil
+}
+
+func (r *memoryWalletChallengeRepository) Delete(ctx context.Context, userID string) error {
+	delete(r.challenges, userID)
+	return nil
+}
+
+const walletTestUserID = "6f1d2c3b-4a59-4e8d-9c7b-0a1b2c3d4e5f"
+
+type walletTest struct {
+	users      *memoryUserRepository
+	challenges *memoryWalletChallengeRepository
+	usecase    *usecase.LinkWalletUsecase
+}
+
+func newWalletTest(users ...*domain.User) *walletTest {
+	users = append(users, domain.NewUser(walletTestUserID, "U0001", "Ada", nil))
+	wt := &walletTest{
+		users:      newMemoryUserRepository(users...),
+		challenges: newMemoryWalletChallengeRepository(),
+	}
+	wt.usecase = usecase.NewLinkWalletUsecase(wt.users, wt.challenges, infrastructure.NewWalletVerifier())
+	return wt
+}
+
+func generateKey(t *testing.T) *secp256k1.PrivateKey {
+	t.Helper()
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// issue starts linking address and returns the message to sign.
+func (wt *walletTest) issue(t *testing.T, address string) string {
+	t.Helper()
+	output, err := wt.usecase.IssueChallenge(context.Background(), &usecase.IssueWalletChallengeInput{
+		UserID:        walletTestUserID,
+		WalletAddress: address,
+	})
+	if err != nil {
+		t.Fatalf("IssueChallenge: %v", err)
+	}
+	return output.Message
+}
+
+// link answers the outstanding challenge with message signed by key.
+func (wt *walletTest) link(key *secp256k1.PrivateKey, message string) (*usecase.LinkWalletOutput, error) {
+	signature := "0x" + hex.EncodeToString(infrastructure.SignPersonalMessage(key, message))
+	return wt.usecase.Execute(context.Background(), &usecase.LinkWalletInput{
+		UserID:    walletTestUserID,
+		Signature: signature,
+	})
+}
+
+func TestLinkWallet(t *testing.T) {
+	wt := newWalletTest()
+	key := generateKey(t)
+	address := infrastructure.AddressOf(key)
+
+	// Wallets hand out lowercase addresses too; the link is stored checksummed.
+	message := wt.issue(t, strings.ToLower(address))
+	if !strings.Contains(message, address) {
+		t.Errorf("challenge message does not name %s:\n%s", address, message)
+	}
+
+	output, err := wt.link(key, message)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if output.WalletAddress != address {
+		t.Errorf("WalletAddress = %s, want %s", output.WalletAddress, address)
+	}
+	user, _ := wt.users.FindByID(context.Background(), walletTestUserID)
+	if user.WalletAddress == nil || *user.WalletAddress != address {
+		t.Errorf("stored wallet = %v, want %s", user.WalletAddress, address)
+	}
+	if _, ok := wt.challenges.challenges[walletTestUserID]; ok {
+		t.Error("challenge was kept after it was answered")
+	}
+}
+
+func TestLinkWalletSignedByOtherWallet(t *testing.T) {
+	wt := newWalletTest()
+	message := wt.issue(t, infrastructure.AddressOf(generateKey(t)))
+
+	_, err := wt.link(generateKey(t), message)
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Fatalf("err = %v, want ErrInvalidInput", err)
+	}
+	user, _ := wt.users.FindByID(context.Background(), walletTestUserID)
+	if user.WalletAddress != nil {
+		t.Errorf("wallet %s linked without its signature", *user.WalletAddress)
+	}
+}
+
+func TestLinkWalletExpiredChallenge(t *testing.T) {
+	wt := newWalletTest()
+	key := generateKey(t)
+	message := wt.issue(t, infrastructure.AddressOf(key))
+	wt.challenges.challenges[walletTestUserID].ExpiresAt = time.Now().Add(-time.Second)
+
+	_, err := wt.link(key, message)
+	if !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("err = %v, want ErrConflict", err)
+	}
+	if _, ok := wt.challenges.challenges[walletTestUserID]; ok {
+		t.Error("expired challenge was kept")
+	}
+}
+
+func TestLinkWalletReusedChallenge(t *testing.T) {
+	wt := newWalletTest()
+	key := generateKey(t)
+	message := wt.issue(t, infrastructure.AddressOf(key))
+	if _, err := wt.link(key, message); err != nil {
+		t.Fatalf("first Execute: %v", err)
+	}
+
+	_, err := wt.link(key, message)
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestLinkWalletBadChecksum(t *testing.T) {
+	wt := newWalletTest()
+	// 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed from EIP-55 with the
+	// second digit's case flipped: still mixed case, checksum broken.
+	bad := "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	_, err := wt.usecase.IssueChallenge(context.Background(), &usecase.IssueWalletChallengeInput{
+		UserID:        walletTestUserID,
+		WalletAddress: bad,
+	})
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Fatalf("err = %v, want ErrInvalidInput", err)
+	}
+	if len(wt.challenges.challenges) != 0 {
+		t.Error("challenge issued for an address with a bad checksum")
+	}
+}
+
+func TestLinkWalletOwnedByOtherUser(t *testing.T) {
+	key := generateKey(t)
+	address := infrastructure.AddressOf(key)
+	owner := domain.NewUser("0b7e3c1a-2d4f-4a6b-8c9d-1e2f3a4b5c6d", "U0002", "Grace", &address)
+	wt := newWalletTest(owner)
+
+	_, err := wt.usecase.IssueChallenge(context.Background(), &usecase.IssueWalletChallengeInput{
+		UserID:        walletTestUserID,
+		WalletAddress: address,
+	})
+	if !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("err = %v, want ErrConflict", err)
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ListBlocksInput represents the input for listing the users a user blocked.
+type ListBlocksInput struct {
+	Blocker Actor
+}
+
+// ListBlocksOutput represents the output of listing the users a user blocked.
+type ListBlocksOutput struct {
+	Blocks []*domain.Block
+}
+
+// ListBlocksUsecase shows a user the blocks they placed, newest first.
+// Blocks placed against the user are not shown.
+type ListBlocksUsecase struct {
+	blockRepo repository.BlockRepository
+	userRepo  repository.UserRepository
+}
+
+// NewListBlocksUsecase creates a new ListBlocksUsecase.
+func NewListBlocksUsecase(blockRepo repository.BlockRepository, userRepo repository.UserRepository) *ListBlocksUsecase {
+	return &ListBlocksUsecase{blockRepo: blockRepo, userRepo: userRepo}
+}
+
+// Execute lists the user's blocks.
+func (u *ListBlocksUsecase) Execute(ctx context.Context, input *ListBlocksInput) (*ListBlocksOutput, error) {
+	blocker, err := resolveActor(ctx, u.userRepo, input.Blocker)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := u.blockRepo.FindByBlockerID(ctx, blocker.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blocks: %w", err)
+	}
+	return &ListBlocksOutput{Blocks: blocks}, nil
+}
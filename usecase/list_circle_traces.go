@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ListCircleTracesInput represents the input for reading a circle's traces.
+type ListCircleTracesInput struct {
+	Viewer   Actor
+	CircleID string
+}
+
+// ListCircleTracesOutput represents the output of reading a circle's traces.
+type ListCircleTracesOutput struct {
+	Circle *domain.Circle
+	Traces []*domain.Trace
+}
+
+// ListCircleTracesUsecase shows the traces scoped to a circle to its members.
+type ListCircleTracesUsecase struct {
+	traceRepo  repository.TraceRepository
+	circleRepo repository.CircleRepository
+	userRepo   repository.UserRepository
+	blockRepo  repository.BlockRepository
+}
+
+// NewListCircleTracesUsecase creates a new ListCircleTracesUsecase.
+func NewListCircleTracesUsecase(
+	traceRepo repository.TraceRepository,
+	circleRepo repository.CircleRepository,
+	userRepo repository.UserRepository,
+	blockRepo repository.BlockRepository,
+) *ListCircleTracesUsecase {
+	return &ListCircleTracesUsecase{
+		traceRepo:  traceRepo,
+		circleRepo: circleRepo,
+		userRepo:   userRepo,
+		blockRepo:  blockRepo,
+	}
+}
+
+// Execute returns the circle's traces, newest first. Non-members are refused,
+// and traces by members the viewer blocked or was blocked by are left out.
+func (u *ListCircleTracesUsecase) Execute(ctx context.Context, input *ListCircleTracesInput) (*ListCircleTracesOutput, error) {
+	viewer, err := resolveActor(ctx, u.userRepo, input.Viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	circle, err := findCircle(ctx, u.circleRepo, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireMember(ctx, u.circleRepo, circle.ID, viewer.ID); err != nil {
+		return nil, err
+	}
+
+	traces, err := u.traceRepo.FindByCircleID(ctx, circle.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find circle traces: %w", err)
+	}
+	blockedIDs, err := u.blockRepo.FindBlockedUserIDs(ctx, viewer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blocked users: %w", err)
+	}
+	if len(blockedIDs) > 0 {
+		blocked := make(map[string]bool, len(blockedIDs))
+		for _, id := range blockedIDs {
+			blocked[id] = true
+		}
+		visible := traces[:0]
+		for _, t := range traces {
+			if !blocked[t.AuthorID] {
+				visible = append(visible, t)
+			}
+		}
+		traces = visible
+	}
+	return &ListCircleTracesOutput{Circle: circle, Traces: traces}, nil
+}
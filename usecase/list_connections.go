@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ListConnectionsInput represents the input for listing a user's connections.
+type ListConnectionsInput struct {
+	User Actor
+}
+
+// ListConnectionsOutput represents the output of listing a user's connections.
+type ListConnectionsOutput struct {
+	UserID        string
+	Relationships []*domain.Relationship
+	// Limit is the maximum number of connections; 0 means no limit.
+	Limit int
+}
+
+// ListConnectionsUsecase shows a user their active connections, strongest first,
+// so they can pick one to release when at the limit.
+type ListConnectionsUsecase struct {
+	relationshipRepo repository.RelationshipRepository
+	userRepo         repository.UserRepository
+	policy           domain.RelationshipPolicy
+}
+
+// NewListConnectionsUsecase creates a new ListConnectionsUsecase.
+func NewListConnectionsUsecase(
+	relationshipRepo repository.RelationshipRepository,
+	userRepo repository.UserRepository,
+	policy domain.RelationshipPolicy,
+) *ListConnectionsUsecase {
+	return &ListConnectionsUsecase{
+		relationshipRepo: relationshipRepo,
+		userRepo:         userRepo,
+		policy:           policy,
+	}
+}
+
+// Execute lists the user's active connections.
+func (u *ListConnectionsUsecase) Execute(ctx context.Context, input *ListConnectionsInput) (*ListConnectionsOutput, error) {
+	user, err := resolveActor(ctx, u.userRepo, input.User)
+	if err != nil {
+		return nil, err
+	}
+
+	rels, err := u.relationshipRepo.FindByUserID(ctx, user.ID, u.policy.MinStrength)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find connections: %w", err)
+	}
+
+	return &ListConnectionsOutput{
+		UserID:        user.ID,
+		Relationships: rels,
+		Limit:         u.policy.MaxDegree,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// maxListLimit caps the page size of keyset-paginated lists.
+const maxListLimit = 100
+
+// ListInteractionsInput represents the input for listing the caller's interactions.
+type ListInteractionsInput struct {
+	User Actor
+	// Role is the side of the interactions to list; it must be set.
+	Role InteractionRole
+	// Status, if set, keeps only interactions in that status.
+	Status *domain.InteractionStatus
+	// After continues from the NextCursor of a previous page.
+	After *domain.InteractionCursor
+	Limit int
+}
+
+// ListInteractionsOutput represents the output of listing the caller's interactions.
+type ListInteractionsOutput struct {
+	Interactions []*domain.Interaction
+	// NextCursor is where the next page starts, or nil on the last page.
+	NextCursor *domain.InteractionCursor
+}
+
+// ListInteractionsUsecase pages through the requests a user sent or received.
+type ListInteractionsUsecase struct {
+	interactionRepo repository.InteractionRepository
+	userRepo        repository.UserRepository
+}
+
+// NewListInteractionsUsecase creates a new ListInteractionsUsecase.
+func NewListInteractionsUsecase(
+	interactionRepo repository.InteractionRepository,
+	userRepo repository.UserRepository,
+) *ListInteractionsUsecase {
+	return &ListInteractionsUsecase{
+		interactionRepo: interactionRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// Execute returns one page of the user's interactions, newest first.
+func (u *ListInteractionsUsecase) Execute(ctx context.Context, input *ListInteractionsInput) (*ListInteractionsOutput, error) {
+	// 1. Validate the query
+	if input.Role != InteractionRoleRequester && input.Role != InteractionRoleApprover {
+		return nil, domain.Errorf(domain.ErrInvalidInput, "role must be %q or %q", InteractionRoleRequester, InteractionRoleApprover)
+	}
+	if input.Status != nil && !input.Status.IsValid() {
+		return nil, domain.Errorf(domain.ErrInvalidInput, "unknown interaction status %q", *input.Status)
+	}
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	user, err := resolveActor(ctx, u.userRepo, input.User)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Fetch one extra row to learn whether another page follows
+	query := domain.InteractionQuery{Status: input.Status, After: input.After, Limit: limit + 1}
+	var interactions []*domain.Interaction
+	if input.Role == InteractionRoleRequester {
+		interactions, err = u.interactionRepo.FindByRequesterID(ctx, user.ID, query)
+	} else {
+		interactions, err = u.interactionRepo.FindByApproverID(ctx, user.ID, query)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find interactions: %w", err)
+	}
+
+	output := &ListInteractionsOutput{Interactions: interactions}
+	if len(interactions) > limit {
+		output.Interactions = interactions[:limit]
+		next := interactions[limit-1].Cursor()
+		output.NextCursor = &next
+	}
+	return output, nil
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ListLineWebhookRecordsOutput represents the output of listing recorded webhooks.
+type ListLineWebhookRecordsOutput struct {
+	Records []*domain.LineWebhookRecord
+}
+
+// ListLineWebhookRecordsUsecase reads back recorded LINE webhook requests
+// for replaying.
+type ListLineWebhookRecordsUsecase struct {
+	recordRepo repository.LineWebhookRecordRepository
+}
+
+// NewListLineWebhookRecordsUsecase creates a new ListLineWebhookRecordsUsecase.
+func NewListLineWebhookRecordsUsecase(recordRepo repository.LineWebhookRecordRepository) *ListLineWebhookRecordsUsecase {
+	return &ListLineWebhookRecordsUsecase{recordRepo: recordRepo}
+}
+
+// Execute returns every record in the order it was received.
+func (u *ListLineWebhookRecordsUsecase) Execute(ctx context.Context) (*ListLineWebhookRecordsOutput, error) {
+	records, err := u.recordRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook records: %w", err)
+	}
+	return &ListLineWebhookRecordsOutput{Records: records}, nil
+}
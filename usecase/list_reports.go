@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ListReportsInput represents the input for reading the moderation queue.
+type ListReportsInput struct {
+	// Status selects the reports to list; empty means open reports.
+	Status domain.ReportStatus
+	Limit  int
+	Offset int
+}
+
+// ListReportsOutput represents the output of reading the moderation queue.
+type ListReportsOutput struct {
+	Reports []*domain.Report
+}
+
+// ListReportsUsecase lets moderators work through reports, oldest first.
+type ListReportsUsecase struct {
+	reportRepo repository.ReportRepository
+}
+
+// NewListReportsUsecase creates a new ListReportsUsecase.
+func NewListReportsUsecase(reportRepo repository.ReportRepository) *ListReportsUsecase {
+	return &ListReportsUsecase{reportRepo: reportRepo}
+}
+
+// Execute returns one page of reports with the requested status.
+func (u *ListReportsUsecase) Execute(ctx context.Context, input *ListReportsInput) (*ListReportsOutput, error) {
+	status := input.Status
+	if status == "" {
+		status = domain.ReportStatusOpen
+	}
+	switch status {
+	case domain.ReportStatusOpen, domain.ReportStatusResolved, domain.ReportStatusDismissed:
+	default:
+		return nil, domain.Errorf(domain.ErrInvalidInput, "unknown report status %q", status)
+	}
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if input.Offset < 0 {
+		return nil, domain.Errorf(domain.ErrInvalidInput, "offset must not be negative: %d", input.Offset)
+	}
+
+	reports, err := u.reportRepo.FindByStatus(ctx, status, limit, input.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reports: %w", err)
+	}
+	return &ListReportsOutput{Reports: reports}, nil
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// InteractionRole selects which side of an interaction a user is on.
+type InteractionRole string
+
+const (
+	InteractionRoleAny       InteractionRole = ""
+	InteractionRoleRequester InteractionRole = "requester"
+	InteractionRoleApprover  InteractionRole = "approver"
+)
+
+// ListUserInteractionsInput represents the input for listing a user's interactions.
+type ListUserInteractionsInput struct {
+	UserID string
+	Role   InteractionRole
+}
+
+// ListUserInteractionsOutput represents the output of listing a user's interactions.
+type ListUserInteractionsOutput struct {
+	Interactions []*domain.Interaction
+}
+
+// ListUserInteractionsUsecase lists the interactions a user takes part in.
+type ListUserInteractionsUsecase struct {
+	interactionRepo repository.InteractionRepository
+	userRepo        repository.UserRepository
+}
+
+// NewListUserInteractionsUsecase creates a new ListUserInteractionsUsecase.
+func NewListUserInteractionsUsecase(
+	interactionRepo repository.InteractionRepository,
+	userRepo repository.UserRepository,
+) *ListUserInteractionsUsecase {
+	return &ListUserInteractionsUsecase{
+		interactionRepo: interactionRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// Execute returns the user's interactions for the given role, newest first.
+func (u *ListUserInteractionsUsecase) Execute(ctx context.Context, input *ListUserInteractionsInput) (*ListUserInteractionsOutput, error) {
+	switch input.Role {
+	case InteractionRoleAny, InteractionRoleRequester, InteractionRoleApprover:
+	default:
+		return nil, domain.Errorf(domain.ErrInvalidInput, "unknown interaction role: %q", input.Role)
+	}
+
+	user, err := u.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, input.UserID)
+	}
+
+	var interactions []*domain.Interaction
+	if input.Role == InteractionRoleAny || input.Role == InteractionRoleRequester {
+		requested, err := u.interactionRepo.FindByRequesterID(ctx, user.ID, domain.InteractionQuery{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find requested interactions: %w", err)
+		}
+		interactions = append(interactions, requested...)
+	}
+	if input.Role == InteractionRoleAny || input.Role == InteractionRoleApprover {
+		received, err := u.interactionRepo.FindByApproverID(ctx, user.ID, domain.InteractionQuery{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find received interactions: %w", err)
+		}
+		interactions = append(interactions, received...)
+	}
+	sort.Slice(interactions, func(i, j int) bool {
+		return interactions[i].CreatedAt.After(interactions[j].CreatedAt)
+	})
+	return &ListUserInteractionsOutput{Interactions: interactions}, nil
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// defaultListLimit is used when a list input does not specify a limit.
+const defaultListLimit = 50
+
+// ListUsersInput represents the input for listing users.
+type ListUsersInput struct {
+	Limit  int
+	Offset int
+}
+
+// ListUsersOutput represents the output of listing users.
+type ListUsersOutput struct {
+	Users []*domain.User
+}
+
+// ListUsersUsecase lists registered users page by page.
+type ListUsersUsecase struct {
+	userRepo repository.UserRepository
+}
+
+// NewListUsersUsecase creates a new ListUsersUsecase.
+func NewListUsersUsecase(userRepo repository.UserRepository) *ListUsersUsecase {
+	return &ListUsersUsecase{userRepo: userRepo}
+}
+
+// Execute returns one page of users ordered by registration time.
+func (u *ListUsersUsecase) Execute(ctx context.Context, input *ListUsersInput) (*ListUsersOutput, error) {
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if input.Offset < 0 {
+		return nil, domain.Errorf(domain.ErrInvalidInput, "offset must not be negative: %d", input.Offset)
+	}
+
+	users, err := u.userRepo.List(ctx, limit, input.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	return &ListUsersOutput{Users: users}, nil
+}
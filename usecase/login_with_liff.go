@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ErrInvalidLineAccessToken is returned when LINE does not vouch for a LIFF access token.
+var ErrInvalidLineAccessToken = domain.NewError(domain.ErrUnauthenticated, "invalid LINE access token")
+
+// LoginWithLiffInput represents the input for signing in from a LIFF app.
+type LoginWithLiffInput struct {
+	// AccessToken is the token liff.getAccessToken() returns.
+	AccessToken string
+}
+
+// LoginWithLiffOutput represents the output of signing in from a LIFF app.
+type LoginWithLiffOutput struct {
+	User    *domain.User
+	Session *Session
+}
+
+// LoginWithLiffUsecase signs users in from the LIFF app running inside LINE,
+// which holds a LINE access token instead of an ID token.
+type LoginWithLiffUsecase struct {
+	verifier repository.LineLoginVerifier
+	userRepo repository.UserRepository
+	sessions sessionIssuer
+}
+
+// NewLoginWithLiffUsecase creates a new LoginWithLiffUsecase.
+func NewLoginWithLiffUsecase(
+	verifier repository.LineLoginVerifier,
+	userRepo repository.UserRepository,
+	signer repository.SessionTokenSigner,
+	refreshRepo repository.RefreshTokenRepository,
+	policy SessionPolicy,
+) *LoginWithLiffUsecase {
+	return &LoginWithLiffUsecase{
+		verifier: verifier,
+		userRepo: userRepo,
+		sessions: sessionIssuer{signer: signer, refreshRepo: refreshRepo, policy: policy},
+	}
+}
+
+// Execute verifies the access token with LINE and starts a session for its user.
+func (u *LoginWithLiffUsecase) Execute(ctx context.Context, input *LoginWithLiffInput) (*LoginWithLiffOutput, error) {
+	// 1. Verify the access token
+	identity, err := u.verifier.VerifyAccessToken(ctx, input.AccessToken)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthenticated) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidLineAccessToken, err)
+		}
+		return nil, fmt.Errorf("failed to verify access token: %w", err)
+	}
+
+	// 2. Find the user behind the LINE account
+	user, err := u.userRepo.FindByLineUserID(ctx, identity.LineUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownActor, identity.LineUserID)
+	}
+
+	// 3. Issue the session
+	session, err := u.sessions.issue(ctx, user.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginWithLiffOutput{User: user, Session: session}, nil
+}
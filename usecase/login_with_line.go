@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// LoginWithLineInput represents the input for signing in with LINE Login.
+type LoginWithLineInput struct {
+	IDToken string
+	// Nonce is the nonce the client sent with the authorization request.
+	// If set, the ID token must carry the same value.
+	Nonce string
+}
+
+// LoginWithLineOutput represents the output of signing in with LINE Login.
+type LoginWithLineOutput struct {
+	User    *domain.User
+	Session *Session
+}
+
+// LoginWithLineUsecase signs users in to the REST API with a LINE Login ID
+// token. Users register by adding the LINE bot, which SyncLineProfileUsecase
+// handles, so only LINE users who have added it can sign in.
+type LoginWithLineUsecase struct {
+	verifier repository.LineLoginVerifier
+	userRepo repository.UserRepository
+	sessions sessionIssuer
+}
+
+// NewLoginWithLineUsecase creates a new LoginWithLineUsecase.
+func NewLoginWithLineUsecase(
+	verifier repository.LineLoginVerifier,
+	userRepo repository.UserRepository,
+	signer repository.SessionTokenSigner,
+	refreshRepo repository.RefreshTokenRepository,
+	policy SessionPolicy,
+) *LoginWithLineUsecase {
+	return &LoginWithLineUsecase{
+		verifier: verifier,
+		userRepo: userRepo,
+		sessions: sessionIssuer{signer: signer, refreshRepo: refreshRepo, policy: policy},
+	}
+}
+
+// Execute verifies the ID token and starts a session for its user.
+func (u *LoginWithLineUsecase) Execute(ctx context.Context, input *LoginWithLineInput) (*LoginWithLineOutput, error) {
+	// 1. Verify the ID token
+	identity, err := u.verifier.VerifyIDToken(ctx, input.IDToken)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthenticated) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+		}
+		return nil, fmt.Errorf("failed to verify ID token: %w", err)
+	}
+	if input.Nonce != "" && identity.Nonce != input.Nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	// 2. Find the user behind the LINE account
+	user, err := u.userRepo.FindByLineUserID(ctx, identity.LineUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownActor, identity.LineUserID)
+	}
+
+	// 3. Issue the session
+	session, err := u.sessions.issue(ctx, user.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginWithLineOutput{User: user, Session: session}, nil
+}
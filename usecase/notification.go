@@ -0,0 +1,297 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/url"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// Every text below is rendered in the locale of the user it is sent to.
+
+// interactionRequestMessage is the text sent to an approver when someone
+// requests an interaction with them.
+func interactionRequestMessage(locale domain.Locale, requester *domain.User) string {
+	return localize(locale, msgInteractionRequestReceived, messageArgs{"name": requester.DisplayName})
+}
+
+// interactionRequestSentMessage is the reply confirming to a requester that
+// their request was sent.
+func interactionRequestSentMessage(locale domain.Locale, approver *domain.User) string {
+	return localize(locale, msgInteractionRequestSent, messageArgs{"name": approver.DisplayName})
+}
+
+// interactionRequestFlexMessage builds the request notification with buttons
+// for the approver to approve or reject the interaction, or to block the
+// requester outright.
+func interactionRequestFlexMessage(locale domain.Locale, requester *domain.User, interactionID string) (string, error) {
+	text := interactionRequestMessage(locale, requester)
+	message := map[string]interface{}{
+		"type":    "flex",
+		"altText": text,
+		"contents": map[string]interface{}{
+			"type": "bubble",
+			"body": map[string]interface{}{
+				"type":     "box",
+				"layout":   "vertical",
+				"contents": []interface{}{flexText(text, "md", true)},
+			},
+			"footer": map[string]interface{}{
+				"type":    "box",
+				"layout":  "vertical",
+				"spacing": "sm",
+				"contents": []interface{}{
+					map[string]interface{}{
+						"type":    "box",
+						"layout":  "horizontal",
+						"spacing": "sm",
+						"contents": []interface{}{
+							postbackButton(localize(locale, msgInteractionApproveButton, nil), "primary", interactionPostbackData(PostbackActionApproveInteraction, interactionID)),
+							postbackButton(localize(locale, msgInteractionRejectButton, nil), "secondary", interactionPostbackData(PostbackActionRejectInteraction, interactionID)),
+						},
+					},
+					postbackButton(localize(locale, msgBlockButton, nil), "link", blockPostbackData(requester.ID)),
+				},
+			},
+		},
+	}
+	b, err := json.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// interactionApprovedMessage is the text sent to a requester when the
+// approver accepts their request.
+func interactionApprovedMessage(locale domain.Locale, approver *domain.User) string {
+	return localize(locale, msgInteractionApproved, messageArgs{"name": approver.DisplayName})
+}
+
+// connectionLimitReachedMessage is the text sent to a user who cannot approve
+// a request because they already have the maximum number of connections.
+func connectionLimitReachedMessage(locale domain.Locale, limit int) string {
+	return localize(locale, msgConnectionLimitReached, messageArgs{"count": limit})
+}
+
+// partnerConnectionLimitReachedMessage is the text sent to an approver when
+// the requester is the one at the connection limit.
+func partnerConnectionLimitReachedMessage(locale domain.Locale, requester *domain.User) string {
+	return localize(locale, msgPartnerConnectionLimitReached, messageArgs{"name": requester.DisplayName})
+}
+
+// exchangeWithoutConnectionMessage is the text sent to a recipient whose
+// exchange completed without connecting them, because of the connection limit.
+func exchangeWithoutConnectionMessage(locale domain.Locale) string {
+	return localize(locale, msgExchangeWithoutConnection, nil)
+}
+
+// exchangeCompletedMessage is the text sent to an offerer when the recipient
+// answers their trace with one of their own.
+func exchangeCompletedMessage(locale domain.Locale, recipient *domain.User) string {
+	return localize(locale, msgExchangeCompleted, messageArgs{"name": recipient.DisplayName})
+}
+
+// maxFlexTextRunes keeps trace bodies short enough to read in a bubble.
+const maxFlexTextRunes = 200
+
+// failureMessage is the reply explaining err to the LINE user whose message
+// caused it, or "" if err is not something they can act on. Errors without a
+// message of their own fall back to one for their domain error kind.
+func failureMessage(locale domain.Locale, err error) string {
+	var rateLimited *RateLimitError
+	var connectionLimit *ConnectionLimitError
+	switch {
+	case errors.As(err, &rateLimited):
+		return rateLimitedMessage(locale, rateLimited)
+	case errors.As(err, &connectionLimit):
+		if connectionLimit.Partner != nil {
+			return partnerConnectionLimitReachedMessage(locale, connectionLimit.Partner)
+		}
+		return connectionLimitReachedMessage(locale, connectionLimit.Limit)
+	case errors.Is(err, ErrInvalidMeetCommand):
+		return localize(locale, msgFailureInvalidMeetCommand, nil)
+	case errors.Is(err, ErrApproverNotFound):
+		return localize(locale, msgFailureApproverNotFound, nil)
+	case errors.Is(err, ErrSelfInteraction):
+		return localize(locale, msgFailureSelfInteraction, nil)
+	case errors.Is(err, ErrUnknownActor):
+		return localize(locale, msgFailureUnknownActor, nil)
+	case errors.Is(err, ErrCircleNotFound):
+		return localize(locale, msgFailureCircleNotFound, nil)
+	case errors.Is(err, ErrCircleOutOfReach):
+		return localize(locale, msgFailureCircleOutOfReach, messageArgs{"count": domain.MaxHops})
+	case errors.Is(err, ErrFounderCannotLeave):
+		return localize(locale, msgFailureFounderCannotLeave, nil)
+	case errors.Is(err, ErrInteractionNotPending), errors.Is(err, ErrExchangeNotPending):
+		return localize(locale, msgFailureAlreadyHandled, nil)
+	case errors.Is(err, domain.ErrNotFound):
+		return localize(locale, msgFailureNotFound, nil)
+	case errors.Is(err, domain.ErrForbidden):
+		return localize(locale, msgFailureForbidden, nil)
+	case errors.Is(err, domain.ErrConflict):
+		return localize(locale, msgFailureConflict, nil)
+	case errors.Is(err, domain.ErrInvalidInput):
+		return localize(locale, msgFailureInvalidInput, nil)
+	}
+	return ""
+}
+
+// rateLimitedMessage is the text sent to a user whose request a rate limit
+// refused. Throttled webhook traffic gets no answer, so answering cannot
+// itself be used to flood anyone.
+func rateLimitedMessage(locale domain.Locale, err *RateLimitError) string {
+	switch err.Scope {
+	case RateLimitScopeRequester:
+		if minutes := int(math.Ceil(err.RetryAfter.Minutes())); minutes > 0 {
+			return localize(locale, msgRateLimitedRequesterRetryIn, messageArgs{"count": minutes})
+		}
+		return localize(locale, msgRateLimitedRequester, nil)
+	case RateLimitScopeApprover:
+		return localize(locale, msgRateLimitedApprover, nil)
+	}
+	return ""
+}
+
+// exchangeOfferFlexMessage builds the Flex Message carousel sent to the
+// recipient of an exchange: the offered trace with a decline button, followed
+// by one bubble per trace the recipient can give back.
+func exchangeOfferFlexMessage(locale domain.Locale, offerer *domain.User, exchange *domain.Exchange, offered *domain.Trace, options []*domain.Trace) (string, error) {
+	title := localize(locale, msgExchangeOfferReceived, messageArgs{"name": offerer.DisplayName})
+	bubbles := []interface{}{
+		traceBubble(
+			title,
+			offered,
+			postbackButton(localize(locale, msgExchangeDeclineButton, nil), "secondary", exchangePostbackData(PostbackActionDeclineExchange, exchange.ID, "")),
+		),
+	}
+	for _, t := range options {
+		bubbles = append(bubbles, traceBubble(
+			localize(locale, msgExchangeOfferOwnTrace, nil),
+			t,
+			postbackButton(localize(locale, msgExchangeCompleteButton, nil), "primary", exchangePostbackData(PostbackActionCompleteExchange, exchange.ID, t.ID)),
+		))
+	}
+	if len(options) == 0 {
+		bubbles = append(bubbles, map[string]interface{}{
+			"type": "bubble",
+			"body": map[string]interface{}{
+				"type":   "box",
+				"layout": "vertical",
+				"contents": []interface{}{
+					flexText(localize(locale, msgExchangeOfferNoTraces, nil), "sm", true),
+				},
+			},
+		})
+	}
+
+	message := map[string]interface{}{
+		"type":    "flex",
+		"altText": title,
+		"contents": map[string]interface{}{
+			"type":     "carousel",
+			"contents": bubbles,
+		},
+	}
+	b, err := json.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// traceBubble renders a trace as a Flex bubble with a single footer button.
+func traceBubble(title string, trace *domain.Trace, button map[string]interface{}) map[string]interface{} {
+	bubble := map[string]interface{}{
+		"type": "bubble",
+		"body": map[string]interface{}{
+			"type":    "box",
+			"layout":  "vertical",
+			"spacing": "md",
+			"contents": []interface{}{
+				flexText(title, "sm", false),
+				flexText(truncateRunes(trace.Body, maxFlexTextRunes), "md", true),
+			},
+		},
+		"footer": map[string]interface{}{
+			"type":     "box",
+			"layout":   "vertical",
+			"contents": []interface{}{button},
+		},
+	}
+	if trace.MediaURL != nil {
+		bubble["hero"] = map[string]interface{}{
+			"type":        "image",
+			"url":         *trace.MediaURL,
+			"size":        "full",
+			"aspectMode":  "cover",
+			"aspectRatio": "1:1",
+		}
+	}
+	return bubble
+}
+
+func flexText(text, size string, wrap bool) map[string]interface{} {
+	if text == "" {
+		// LINE rejects empty text components
+		text = " "
+	}
+	return map[string]interface{}{
+		"type": "text",
+		"text": text,
+		"size": size,
+		"wrap": wrap,
+	}
+}
+
+func postbackButton(label, style, data string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":  "button",
+		"style": style,
+		"action": map[string]interface{}{
+			"type":        "postback",
+			"label":       label,
+			"data":        data,
+			"displayText": label,
+		},
+	}
+}
+
+// exchangePostbackData encodes an exchange button's postback payload.
+// traceID is omitted when empty.
+func exchangePostbackData(action, exchangeID, traceID string) string {
+	v := url.Values{}
+	v.Set("action", action)
+	v.Set("exchangeId", exchangeID)
+	if traceID != "" {
+		v.Set("traceId", traceID)
+	}
+	return v.Encode()
+}
+
+// interactionPostbackData encodes an interaction button's postback payload.
+func interactionPostbackData(action, interactionID string) string {
+	v := url.Values{}
+	v.Set("action", action)
+	v.Set("interactionId", interactionID)
+	return v.Encode()
+}
+
+// blockPostbackData encodes a block button's postback payload.
+func blockPostbackData(userID string) string {
+	v := url.Values{}
+	v.Set("action", PostbackActionBlockUser)
+	v.Set("userId", userID)
+	return v.Encode()
+}
+
+// truncateRunes shortens s to at most n runes, marking the cut with an ellipsis.
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
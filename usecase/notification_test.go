@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+func TestFailureMessageConnectionLimit(t *testing.T) {
+	requester := domain.NewUser("11111111-1111-4111-8111-111111111111", "U0001", "Ada", nil)
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "approver at the limit",
+			err:  fmt.Errorf("approve: %w", &ConnectionLimitError{UserID: "22222222-2222-4222-8222-222222222222", Limit: 3}),
+			want: connectionLimitReachedMessage(domain.LocaleEnglish, 3),
+		},
+		{
+			name: "requester at the limit",
+			err:  &ConnectionLimitError{UserID: requester.ID, Limit: 3, Partner: requester},
+			want: partnerConnectionLimitReachedMessage(domain.LocaleEnglish, requester),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := failureMessage(domain.LocaleEnglish, tt.err)
+			if got != tt.want {
+				t.Errorf("failureMessage = %q, want %q", got, tt.want)
+			}
+			if got == failureMessage(domain.LocaleEnglish, domain.ErrConflict) {
+				t.Error("connection limit explained as a generic conflict")
+			}
+		})
+	}
+}
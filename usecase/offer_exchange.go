@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// OfferExchangeInput represents the input for offering a trace to another user.
+type OfferExchangeInput struct {
+	Offerer     Actor
+	RecipientID string
+	TraceID     string
+}
+
+// OfferExchangeOutput represents the output of offering a trace.
+type OfferExchangeOutput struct {
+	Exchange *domain.Exchange
+}
+
+// OfferExchangeUsecase starts an exchange by offering one of the caller's traces.
+type OfferExchangeUsecase struct {
+	exchangeRepo repository.ExchangeRepository
+	traceRepo    repository.TraceRepository
+	userRepo     repository.UserRepository
+	blockRepo    repository.BlockRepository
+	lineService  repository.LineService
+	logger       *slog.Logger
+}
+
+// NewOfferExchangeUsecase creates a new OfferExchangeUsecase.
+func NewOfferExchangeUsecase(
+	exchangeRepo repository.ExchangeRepository,
+	traceRepo repository.TraceRepository,
+	userRepo repository.UserRepository,
+	blockRepo repository.BlockRepository,
+	lineService repository.LineService,
+	logger *slog.Logger,
+) *OfferExchangeUsecase {
+	return &OfferExchangeUsecase{
+		exchangeRepo: exchangeRepo,
+		traceRepo:    traceRepo,
+		userRepo:     userRepo,
+		blockRepo:    blockRepo,
+		lineService:  lineService,
+		logger:       logger,
+	}
+}
+
+// Execute offers the trace and sends the recipient a Flex Message to answer with.
+func (u *OfferExchangeUsecase) Execute(ctx context.Context, input *OfferExchangeInput) (*OfferExchangeOutput, error) {
+	// 1. Resolve the offerer and the offered trace
+	offerer, err := resolveActor(ctx, u.userRepo, input.Offerer)
+	if err != nil {
+		return nil, err
+	}
+	trace, err := findExchangeTrace(ctx, u.traceRepo, input.TraceID, offerer.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Validate the recipient
+	recipient, err := u.userRepo.FindByID(ctx, input.RecipientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recipient: %w", err)
+	}
+	blocked, err := isBlocked(ctx, u.blockRepo, offerer.ID, input.RecipientID)
+	if err != nil {
+		return nil, err
+	}
+	// A blocked recipient looks the same as a missing one
+	if recipient == nil || blocked {
+		return nil, fmt.Errorf("%w: %s", ErrRecipientNotFound, input.RecipientID)
+	}
+	if recipient.ID == offerer.ID {
+		return nil, domain.NewError(domain.ErrInvalidInput, "cannot exchange with yourself")
+	}
+
+	// 3. Save the pending exchange
+	exchange := domain.NewExchange(uuid.New().String(), offerer.ID, recipient.ID, trace.ID, time.Now())
+	if err := u.exchangeRepo.Save(ctx, exchange); err != nil {
+		return nil, fmt.Errorf("failed to save exchange: %w", err)
+	}
+
+	// 4. Send the offer with the recipient's own traces as replies
+	options, err := u.traceRepo.FindByAuthorID(ctx, recipient.ID, maxExchangeOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recipient traces: %w", err)
+	}
+	message, err := exchangeOfferFlexMessage(recipient.Locale, offerer, exchange, trace, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build exchange offer: %w", err)
+	}
+	if err := u.lineService.SendFlexMessage(ctx, recipient.LineUserID, message); err != nil {
+		// The exchange is saved and can still be answered over the API
+		u.logger.WarnContext(ctx, "failed to send LINE exchange offer", "recipient_id", recipient.ID, "exchange_id", exchange.ID, "error", err)
+	}
+
+	return &OfferExchangeOutput{Exchange: exchange}, nil
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// OverrideInteractionStatusInput represents the input for forcing an interaction status.
+type OverrideInteractionStatusInput struct {
+	InteractionID string
+	Status        domain.InteractionStatus
+}
+
+// OverrideInteractionStatusOutput represents the output of forcing an interaction status.
+type OverrideInteractionStatusOutput struct {
+	Interaction    *domain.Interaction
+	PreviousStatus domain.InteractionStatus
+}
+
+// OverrideInteractionStatusUsecase lets operators approve, reject or expire an
+// interaction regardless of what the participants did.
+type OverrideInteractionStatusUsecase struct {
+	interactionRepo repository.InteractionRepository
+	attester        *interactionAttester
+	relationships   *relationshipRecorder
+}
+
+// NewOverrideInteractionStatusUsecase creates a new OverrideInteractionStatusUsecase.
+func NewOverrideInteractionStatusUsecase(
+	interactionRepo repository.InteractionRepository,
+	userRepo repository.UserRepository,
+	attestationSigner repository.AttestationSigner,
+	relationshipRepo repository.RelationshipRepository,
+	policy domain.RelationshipPolicy,
+) *OverrideInteractionStatusUsecase {
+	return &OverrideInteractionStatusUsecase{
+		interactionRepo: interactionRepo,
+		attester:        &interactionAttester{userRepo: userRepo, signer: attestationSigner},
+		relationships:   &relationshipRecorder{relationshipRepo: relationshipRepo, policy: policy},
+	}
+}
+
+// Execute moves the interaction to the requested status.
+func (u *OverrideInteractionStatusUsecase) Execute(ctx context.Context, input *OverrideInteractionStatusInput) (*OverrideInteractionStatusOutput, error) {
+	interaction, err := u.interactionRepo.FindByID(ctx, input.InteractionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find interaction: %w", err)
+	}
+	if interaction == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInteractionNotFound, input.InteractionID)
+	}
+
+	previous := interaction.Status
+	switch input.Status {
+	case domain.InteractionStatusApproved:
+		interaction.Approve()
+		if interaction.Attestation == nil {
+			if err := u.attester.attest(ctx, interaction); err != nil {
+				return nil, err
+			}
+		}
+	case domain.InteractionStatusRejected:
+		interaction.Reject()
+	case domain.InteractionStatusExpired:
+		interaction.Expire()
+	default:
+		return nil, domain.Errorf(domain.ErrInvalidInput, "cannot override interaction status to %q", input.Status)
+	}
+
+	// Save the status unless it changed since it was read. A newly approved
+	// meeting strengthens the relationship between the two users in the
+	// same transaction; the connection limit applies to operators too. An
+	// approval taken back takes back its meeting the same way.
+	var ok bool
+	now := time.Now()
+	switch {
+	case interaction.IsApproved() && previous != domain.InteractionStatusApproved:
+		var full string
+		full, ok, err = u.interactionRepo.TransitionAndConnect(ctx, interaction, previous,
+			u.relationships.policy, u.relationships.meeting(now))
+		if err != nil {
+			return nil, fmt.Errorf("failed to update interaction: %w", err)
+		}
+		if full != "" {
+			return nil, &ConnectionLimitError{UserID: full, Limit: u.relationships.policy.MaxDegree}
+		}
+	case !interaction.IsApproved() && previous == domain.InteractionStatusApproved:
+		// The meeting was recorded when the approval was attested
+		approvedAt := now
+		if interaction.Attestation != nil {
+			approvedAt = interaction.Attestation.IssuedAt
+		}
+		ok, err = u.interactionRepo.TransitionAndModify(ctx, interaction, previous,
+			u.relationships.retractMeeting(approvedAt, now))
+		if err != nil {
+			return nil, fmt.Errorf("failed to update interaction: %w", err)
+		}
+	default:
+		ok, err = u.interactionRepo.Transition(ctx, interaction, previous)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update interaction: %w", err)
+		}
+	}
+	if !ok {
+		return nil, domain.Errorf(domain.ErrConflict, "interaction %s is no longer %s", interaction.ID, previous)
+	}
+
+	return &OverrideInteractionStatusOutput{
+		Interaction:    interaction,
+		PreviousStatus: previous,
+	}, nil
+}
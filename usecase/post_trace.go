@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// PostTraceInput represents the input for posting a trace.
+type PostTraceInput struct {
+	Author Actor
+	// CircleID, if set, scopes the trace to the circle's members.
+	CircleID *string
+	Body     string
+	MediaURL *string
+}
+
+// PostTraceOutput represents the output of posting a trace.
+type PostTraceOutput struct {
+	Trace *domain.Trace
+}
+
+// PostTraceUsecase lets a user post an expression.
+type PostTraceUsecase struct {
+	traceRepo  repository.TraceRepository
+	circleRepo repository.CircleRepository
+	userRepo   repository.UserRepository
+}
+
+// NewPostTraceUsecase creates a new PostTraceUsecase.
+func NewPostTraceUsecase(
+	traceRepo repository.TraceRepository,
+	circleRepo repository.CircleRepository,
+	userRepo repository.UserRepository,
+) *PostTraceUsecase {
+	return &PostTraceUsecase{
+		traceRepo:  traceRepo,
+		circleRepo: circleRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Execute posts the trace. Posting to a circle requires membership.
+func (u *PostTraceUsecase) Execute(ctx context.Context, input *PostTraceInput) (*PostTraceOutput, error) {
+	author, err := resolveActor(ctx, u.userRepo, input.Author)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(input.Body)
+	if body == "" && input.MediaURL == nil {
+		return nil, domain.NewError(domain.ErrInvalidInput, "trace must have a body or media")
+	}
+
+	if input.CircleID != nil {
+		circle, err := findCircle(ctx, u.circleRepo, *input.CircleID)
+		if err != nil {
+			return nil, err
+		}
+		if err := requireMember(ctx, u.circleRepo, circle.ID, author.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	trace := domain.NewTrace(uuid.New().String(), author.ID, input.CircleID, body, input.MediaURL, time.Now())
+	if err := u.traceRepo.Save(ctx, trace); err != nil {
+		return nil, fmt.Errorf("failed to save trace: %w", err)
+	}
+
+	return &PostTraceOutput{Trace: trace}, nil
+}
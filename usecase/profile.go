@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"net/url"
+
+	"github.com/dkpcb/pet/domain"
+)
+
+// ErrInvalidProfile is returned when a profile update breaks a profile rule.
+var ErrInvalidProfile = domain.NewError(domain.ErrInvalidInput, "invalid profile")
+
+// Profile limits, in characters.
+const (
+	maxDisplayNameRunes = 100
+	maxBioRunes         = 500
+)
+
+// isMediaURL reports whether s is an absolute http(s) URL clients can load.
+func isMediaURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
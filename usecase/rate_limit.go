@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// Rate limit scopes, naming which limit refused an action.
+const (
+	// RateLimitScopeRequester limits how often one user sends interaction requests.
+	RateLimitScopeRequester = "requester"
+	// RateLimitScopeApprover caps the pending requests waiting on one approver.
+	RateLimitScopeApprover = "approver"
+	// RateLimitScopeLineEvent limits how many webhook events one LINE user triggers.
+	RateLimitScopeLineEvent = "line_event"
+)
+
+// RateLimitError is returned when a rate limit refuses an action.
+// errors.Is(err, domain.ErrRateLimited) reports true for it.
+type RateLimitError struct {
+	Scope string
+	// RetryAfter is how long until the action may succeed; zero if unknown.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limited (%s), retry after %s", e.Scope, e.RetryAfter.Round(time.Second))
+	}
+	return fmt.Sprintf("rate limited (%s)", e.Scope)
+}
+
+// Is makes RateLimitError match domain.ErrRateLimited.
+func (e *RateLimitError) Is(target error) bool {
+	return target == domain.ErrRateLimited
+}
+
+// RequestLimits bounds how many interaction requests are made and received.
+type RequestLimits struct {
+	// PerRequester is the token bucket each requester draws from.
+	PerRequester domain.RateLimit
+	// MaxPendingPerApprover caps the requests waiting on one approver; 0 means no cap.
+	MaxPendingPerApprover int
+}
+
+// takeToken draws from the bucket for key, returning a *RateLimitError
+// tagged with scope if it is empty.
+func takeToken(ctx context.Context, limiter repository.RateLimiter, scope, key string, limit domain.RateLimit) error {
+	if limit.IsUnlimited() {
+		return nil
+	}
+	allowed, retryAfter, err := limiter.Take(ctx, scope+":"+key, limit)
+	if err != nil {
+		return fmt.Errorf("failed to check rate limit: %w", err)
+	}
+	if !allowed {
+		return &RateLimitError{Scope: scope, RetryAfter: retryAfter}
+	}
+	return nil
+}
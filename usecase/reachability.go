@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// reachability answers social-distance questions over active relationships.
+// Every visibility rule that depends on the 2-hop limit goes through it.
+type reachability struct {
+	relationshipRepo repository.RelationshipRepository
+	blockRepo        repository.BlockRepository
+	policy           domain.RelationshipPolicy
+}
+
+// connections returns the users directly and actively connected to userID.
+func (r *reachability) connections(ctx context.Context, userID string) ([]string, error) {
+	ids, err := r.relationshipRepo.FindConnectedUserIDs(ctx, userID, r.policy.MinStrength)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find connections: %w", err)
+	}
+	return ids, nil
+}
+
+// blocked returns the users userID has blocked or been blocked by.
+func (r *reachability) blocked(ctx context.Context, userID string) (map[string]bool, error) {
+	ids, err := r.blockRepo.FindBlockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blocked users: %w", err)
+	}
+	blocked := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		blocked[id] = true
+	}
+	return blocked, nil
+}
+
+// withinMaxHops reports whether to is at most domain.MaxHops away from from.
+// Blocks cut every path: users who blocked each other are never in reach,
+// and nobody either of them blocked counts as a hop between them.
+func (r *reachability) withinMaxHops(ctx context.Context, from, to string) (bool, error) {
+	if from == to {
+		return true, nil
+	}
+	fromBlocked, err := r.blocked(ctx, from)
+	if err != nil {
+		return false, err
+	}
+	if fromBlocked[to] {
+		return false, nil
+	}
+	toBlocked, err := r.blocked(ctx, to)
+	if err != nil {
+		return false, err
+	}
+
+	fromConnections, err := r.connections(ctx, from)
+	if err != nil {
+		return false, err
+	}
+	toConnections, err := r.connections(ctx, to)
+	if err != nil {
+		return false, err
+	}
+	excluded := func(id string) bool { return fromBlocked[id] || toBlocked[id] }
+	return domain.WithinMaxHops(from, to, without(fromConnections, excluded), without(toConnections, excluded)), nil
+}
+
+// without returns ids minus those for which excluded returns true.
+func without(ids []string, excluded func(string) bool) []string {
+	kept := ids[:0:0]
+	for _, id := range ids {
+		if !excluded(id) {
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
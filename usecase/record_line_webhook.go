@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// redactedText replaces message text and tokens in recorded webhooks.
+const redactedText = "[REDACTED]"
+
+// WebhookRedaction picks what is removed from LINE webhook bodies before
+// they are recorded.
+type WebhookRedaction struct {
+	// UserIDs replaces user, group and room IDs with pseudonyms. The same
+	// ID always gets the same pseudonym, so who did what in which order
+	// survives.
+	UserIDs bool
+	// Text replaces the text of messages.
+	Text bool
+	// ReplyTokens replaces reply tokens, which can answer the event for a
+	// short while after it was sent.
+	ReplyTokens bool
+}
+
+// RecordLineWebhookInput represents the input for recording a webhook request.
+type RecordLineWebhookInput struct {
+	// Body is the raw request body, already known to be valid JSON.
+	Body      []byte
+	Signature string
+}
+
+// RecordLineWebhookUsecase keeps LINE webhook requests, redacted as
+// configured, so the event sequence behind a bug report can be replayed.
+type RecordLineWebhookUsecase struct {
+	recordRepo repository.LineWebhookRecordRepository
+	redaction  WebhookRedaction
+}
+
+// NewRecordLineWebhookUsecase creates a new RecordLineWebhookUsecase.
+func NewRecordLineWebhookUsecase(recordRepo repository.LineWebhookRecordRepository, redaction WebhookRedaction) *RecordLineWebhookUsecase {
+	return &RecordLineWebhookUsecase{recordRepo: recordRepo, redaction: redaction}
+}
+
+// Execute records one webhook request.
+func (u *RecordLineWebhookUsecase) Execute(ctx context.Context, input *RecordLineWebhookInput) error {
+	// 1. Redact the body; recordings are one line each, so it is compacted too
+	body, err := u.redact(input.Body)
+	if err != nil {
+		return domain.Errorf(domain.ErrInvalidInput, "invalid webhook body: %w", err)
+	}
+
+	// 2. The signature only stays valid if the body did not change
+	signature := input.Signature
+	if !bytes.Equal(body, input.Body) {
+		signature = ""
+	}
+
+	// 3. Save the record
+	record := &domain.LineWebhookRecord{
+		ReceivedAt: time.Now(),
+		Signature:  signature,
+		Body:       body,
+	}
+	if err := u.recordRepo.Save(ctx, record); err != nil {
+		return fmt.Errorf("failed to record webhook: %w", err)
+	}
+	return nil
+}
+
+func (u *RecordLineWebhookUsecase) redact(body []byte) ([]byte, error) {
+	if u.redaction == (WebhookRedaction{}) {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, body); err != nil {
+			return nil, err
+		}
+		return compact.Bytes(), nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	doc = u.redactValue(doc)
+
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(out.Bytes(), []byte("\n")), nil
+}
+
+// redactValue walks a decoded webhook body. Field names are those of the
+// LINE Messaging API, wherever they appear.
+func (u *RecordLineWebhookUsecase) redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			s, isString := field.(string)
+			switch {
+			case isString && u.redaction.UserIDs && (key == "userId" || key == "groupId" || key == "roomId"):
+				v[key] = pseudonymizeLineID(s)
+			case isString && u.redaction.Text && key == "text":
+				v[key] = redactedText
+			case isString && u.redaction.ReplyTokens && key == "replyToken":
+				v[key] = redactedText
+			default:
+				v[key] = u.redactValue(field)
+			}
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = u.redactValue(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// pseudonymizeLineID maps a LINE ID to another of the same shape: its type
+// prefix (U, C or R) followed by 32 hex digits derived from the ID.
+func pseudonymizeLineID(id string) string {
+	if id == "" {
+		return id
+	}
+	sum := sha256.Sum256([]byte("line-id:" + id))
+	return id[:1] + hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/repository"
+)
+
+// RefreshSessionInput represents the input for refreshing a session.
+type RefreshSessionInput struct {
+	RefreshToken string
+}
+
+// RefreshSessionOutput represents the output of refreshing a session.
+type RefreshSessionOutput struct {
+	Session *Session
+}
+
+// RefreshSessionUsecase trades a refresh token for a new session. Refresh
+// tokens are used once: each refresh replaces the token, and presenting a
+// used token again ends every session of the user, since one of the two
+// holders must have stolen it.
+type RefreshSessionUsecase struct {
+	refreshRepo repository.RefreshTokenRepository
+	userRepo    repository.UserRepository
+	sessions    sessionIssuer
+}
+
+// NewRefreshSessionUsecase creates a new RefreshSessionUsecase.
+func NewRefreshSessionUsecase(
+	refreshRepo repository.RefreshTokenRepository,
+	userRepo repository.UserRepository,
+	signer repository.SessionTokenSigner,
+	policy SessionPolicy,
+) *RefreshSessionUsecase {
+	return &RefreshSessionUsecase{
+		refreshRepo: refreshRepo,
+		userRepo:    userRepo,
+		sessions:    sessionIssuer{signer: signer, refreshRepo: refreshRepo, policy: policy},
+	}
+}
+
+// Execute revokes the refresh token and issues a new session in its place.
+func (u *RefreshSessionUsecase) Execute(ctx context.Context, input *RefreshSessionInput) (*RefreshSessionOutput, error) {
+	now := time.Now()
+
+	// 1. Find the stored token
+	token, err := u.refreshRepo.FindByHash(ctx, hashRefreshToken(input.RefreshToken))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find refresh token: %w", err)
+	}
+	if token == nil {
+		return nil, ErrInvalidRefreshToken
+	}
+	if token.IsExpired(now) {
+		return nil, fmt.Errorf("%w: expired", ErrInvalidRefreshToken)
+	}
+
+	// 2. Use it up; a token that was already used means it leaked
+	revoked := false
+	if !token.IsRevoked() {
+		revoked, err = u.refreshRepo.Revoke(ctx, token.ID, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to revoke refresh token: %w", err)
+		}
+	}
+	if !revoked {
+		if err := u.refreshRepo.RevokeByUserID(ctx, token.UserID, now); err != nil {
+			return nil, fmt.Errorf("failed to end sessions after refresh token reuse: %w", err)
+		}
+		return nil, fmt.Errorf("%w: already used", ErrInvalidRefreshToken)
+	}
+
+	// 3. Make sure the user still exists
+	user, err := u.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownActor, token.UserID)
+	}
+
+	// 4. Issue the new session
+	session, err := u.sessions.issue(ctx, user.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefreshSessionOutput{Session: session}, nil
+}
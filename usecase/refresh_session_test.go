@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+	"github.com/dkpcb/pet/usecase"
+)
+
+// trustingLineLoginVerifier takes every ID token to be the LINE user it names.
+type trustingLineLoginVerifier struct{}
+
+func (trustingLineLoginVerifier) VerifyIDToken(ctx context.Context, idToken string) (*domain.LineIdentity, error) {
+	return &domain.LineIdentity{LineUserID: idToken}, nil
+}
+
+func (trustingLineLoginVerifier) VerifyAccessToken(ctx context.Context, accessToken string) (*domain.LineIdentity, error) {
+	return nil, errors.New("not implemented")
+}
+
+const sessionTestUserID = "5c2a9d1e-7b3f-4e6a-8d0c-9f1e2a3b4c5d"
+
+type sessionTest struct {
+	login        *usecase.LoginWithLineUsecase
+	refresh      *usecase.RefreshSessionUsecase
+	authenticate *usecase.AuthenticateSessionUsecase
+}
+
+// newSessionTest stores refresh tokens in SQLite, so reuse detection runs
+// against the same conditional update as in production.
+func newSessionTest(t *testing.T, policy usecase.SessionPolicy) *sessionTest {
+	t.Helper()
+	db, err := infrastructure.OpenDatabase(infrastructure.DialectSQLite, ":memory:", slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	if err := infrastructure.Migrate(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+	users := infrastructure.NewUserRepository(db)
+	if err := users.Save(context.Background(), domain.NewUser(sessionTestUserID, "U0001", "Ada", nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	refreshRepo := infrastructure.NewRefreshTokenRepository(db)
+	signer := infrastructure.NewSessionTokenSigner([]byte(strings.Repeat("s", infrastructure.MinSessionSecretLength)))
+	return &sessionTest{
+		login:        usecase.NewLoginWithLineUsecase(trustingLineLoginVerifier{}, users, signer, refreshRepo, policy),
+		refresh:      usecase.NewRefreshSessionUsecase(refreshRepo, users, signer, policy),
+		authenticate: usecase.NewAuthenticateSessionUsecase(signer),
+	}
+}
+
+func (st *sessionTest) signIn(t *testing.T) *usecase.Session {
+	t.Helper()
+	output, err := st.login.Execute(context.Background(), &usecase.LoginWithLineInput{IDToken: "U0001"})
+	if err != nil {
+		t.Fatalf("sign in: %v", err)
+	}
+	return output.Session
+}
+
+func (st *sessionTest) refreshWith(token string) (*usecase.Session, error) {
+	output, err := st.refresh.Execute(context.Background(), &usecase.RefreshSessionInput{RefreshToken: token})
+	if err != nil {
+		return nil, err
+	}
+	return output.Session, nil
+}
+
+var defaultSessionPolicy = usecase.SessionPolicy{AccessTokenTTL: 15 * time.Minute, RefreshTokenTTL: 24 * time.Hour}
+
+func TestRefreshSessionRotatesToken(t *testing.T) {
+	st := newSessionTest(t, defaultSessionPolicy)
+	first := st.signIn(t)
+
+	second, err := st.refreshWith(first.RefreshToken)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Error("refresh returned the same refresh token")
+	}
+	output, err := st.authenticate.Execute(context.Background(), &usecase.AuthenticateSessionInput{AccessToken: second.AccessToken})
+	if err != nil {
+		t.Fatalf("authenticate with refreshed access token: %v", err)
+	}
+	if output.Actor.UserID != sessionTestUserID {
+		t.Errorf("actor = %s, want %s", output.Actor.UserID, sessionTestUserID)
+	}
+
+	// The replacement can be used in turn.
+	if _, err := st.refreshWith(second.RefreshToken); err != nil {
+		t.Fatalf("refresh with the replacement: %v", err)
+	}
+}
+
+func TestRefreshSessionReuseEndsEverySession(t *testing.T) {
+	st := newSessionTest(t, defaultSessionPolicy)
+	stolen := st.signIn(t)
+	otherDevice := st.signIn(t)
+
+	// The legitimate holder refreshes first; the thief then replays the
+	// used token.
+	current, err := st.refreshWith(stolen.RefreshToken)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if _, err := st.refreshWith(stolen.RefreshToken); !errors.Is(err, usecase.ErrInvalidRefreshToken) {
+		t.Fatalf("reused token err = %v, want ErrInvalidRefreshToken", err)
+	}
+
+	// Neither holder can tell which of them is the thief, so both sign in again,
+	// and so does every other session of the user.
+	for name, token := range map[string]string{"rotated": current.RefreshToken, "other device": otherDevice.RefreshToken} {
+		if _, err := st.refreshWith(token); !errors.Is(err, usecase.ErrInvalidRefreshToken) {
+			t.Errorf("%s token err = %v after reuse, want ErrInvalidRefreshToken", name, err)
+		}
+	}
+}
+
+func TestRefreshSessionRejectsExpiredAndUnknownTokens(t *testing.T) {
+	st := newSessionTest(t, usecase.SessionPolicy{AccessTokenTTL: time.Minute, RefreshTokenTTL: -time.Second})
+	expired := st.signIn(t)
+
+	for name, token := range map[string]string{"expired": expired.RefreshToken, "unknown": "bm90LWlzc3VlZA"} {
+		if _, err := st.refreshWith(token); !errors.Is(err, usecase.ErrInvalidRefreshToken) {
+			t.Errorf("%s token err = %v, want ErrInvalidRefreshToken", name, err)
+		}
+	}
+}
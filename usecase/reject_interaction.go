@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// RejectInteractionInput represents the input for rejecting an interaction.
+type RejectInteractionInput struct {
+	Approver      Actor
+	InteractionID string
+}
+
+// RejectInteractionOutput represents the output of rejecting an interaction.
+type RejectInteractionOutput struct {
+	Interaction *domain.Interaction
+}
+
+// RejectInteractionUsecase lets the approver turn down a meeting request.
+// The requester is not notified.
+type RejectInteractionUsecase struct {
+	interactionRepo repository.InteractionRepository
+	userRepo        repository.UserRepository
+}
+
+// NewRejectInteractionUsecase creates a new RejectInteractionUsecase.
+func NewRejectInteractionUsecase(
+	interactionRepo repository.InteractionRepository,
+	userRepo repository.UserRepository,
+) *RejectInteractionUsecase {
+	return &RejectInteractionUsecase{
+		interactionRepo: interactionRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// Execute rejects the interaction.
+func (u *RejectInteractionUsecase) Execute(ctx context.Context, input *RejectInteractionInput) (*RejectInteractionOutput, error) {
+	approver, err := resolveActor(ctx, u.userRepo, input.Approver)
+	if err != nil {
+		return nil, err
+	}
+	interaction, err := findPendingInteraction(ctx, u.interactionRepo, input.InteractionID, approver.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction.Reject()
+	ok, err := u.interactionRepo.Transition(ctx, interaction, domain.InteractionStatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update interaction: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInteractionNotPending, interaction.ID)
+	}
+
+	return &RejectInteractionOutput{Interaction: interaction}, nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ErrConnectionLimitReached is returned when a user has no room for another connection.
+var ErrConnectionLimitReached = domain.NewError(domain.ErrConflict, "connection limit reached")
+
+// ConnectionLimitError is returned when connecting two users is refused
+// because one of them is at the connection limit. It matches
+// ErrConnectionLimitReached.
+type ConnectionLimitError struct {
+	// UserID is the user at the limit.
+	UserID string
+	// Limit is the most connections a user may have.
+	Limit int
+	// Partner is the user at the limit when it is not the one who acted,
+	// and nil otherwise.
+	Partner *domain.User
+}
+
+func (e *ConnectionLimitError) Error() string {
+	return fmt.Sprintf("%s: user %s", ErrConnectionLimitReached, e.UserID)
+}
+
+// Unwrap returns ErrConnectionLimitReached.
+func (e *ConnectionLimitError) Unwrap() error {
+	return ErrConnectionLimitReached
+}
+
+// relationshipRecorder strengthens relationships for shared activity. Each
+// record first decays the stored strength to now, so activity always adds to
+// an up-to-date value regardless of when the decay job last ran.
+type relationshipRecorder struct {
+	relationshipRepo repository.RelationshipRepository
+	policy           domain.RelationshipPolicy
+}
+
+// meeting strengthens a relationship for an approved meeting at now. The
+// interaction repository applies it when it saves the approval, so the
+// meeting is recorded together with it or not at all.
+func (r *relationshipRecorder) meeting(now time.Time) func(*domain.Relationship) {
+	return func(rel *domain.Relationship) {
+		r.policy.Decay(rel, now)
+		rel.RecordMeeting()
+	}
+}
+
+// retractMeeting takes back a meeting recorded at recordedAt, for an approval
+// that is overridden.
+func (r *relationshipRecorder) retractMeeting(recordedAt, now time.Time) func(*domain.Relationship) {
+	return func(rel *domain.Relationship) {
+		r.policy.RetractMeeting(rel, recordedAt, now)
+	}
+}
+
+// exchange strengthens a relationship for an exchange completed at at. The
+// exchange repository applies it when it saves the completion, so the
+// exchange is recorded together with it or not at all.
+func (r *relationshipRecorder) exchange(at time.Time) func(*domain.Relationship) {
+	return func(rel *domain.Relationship) {
+		r.policy.Decay(rel, at)
+		rel.RecordExchange(at)
+	}
+}
+
+// recordView counts viewerID viewing a trace by authorID. Views only
+// strengthen existing relationships; they never create one.
+func (r *relationshipRecorder) recordView(ctx context.Context, viewerID, authorID string) error {
+	if viewerID == authorID {
+		return nil
+	}
+
+	now := time.Now()
+	_, err := r.relationshipRepo.Modify(ctx, viewerID, authorID, func(rel *domain.Relationship) {
+		r.policy.Decay(rel, now)
+		rel.RecordView(viewerID)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record view: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ErrConnectionNotFound is returned when two users are not connected.
+var ErrConnectionNotFound = domain.NewError(domain.ErrNotFound, "connection not found")
+
+// ReleaseConnectionInput represents the input for releasing a connection.
+type ReleaseConnectionInput struct {
+	User    Actor
+	OtherID string
+}
+
+// ReleaseConnectionUsecase lets a user drop a connection to make room for a new one.
+// Past interactions and exchanges stay on record; only the edge is removed.
+type ReleaseConnectionUsecase struct {
+	relationshipRepo repository.RelationshipRepository
+	userRepo         repository.UserRepository
+	policy           domain.RelationshipPolicy
+}
+
+// NewReleaseConnectionUsecase creates a new ReleaseConnectionUsecase.
+func NewReleaseConnectionUsecase(
+	relationshipRepo repository.RelationshipRepository,
+	userRepo repository.UserRepository,
+	policy domain.RelationshipPolicy,
+) *ReleaseConnectionUsecase {
+	return &ReleaseConnectionUsecase{
+		relationshipRepo: relationshipRepo,
+		userRepo:         userRepo,
+		policy:           policy,
+	}
+}
+
+// Execute removes the relationship between the user and OtherID.
+func (u *ReleaseConnectionUsecase) Execute(ctx context.Context, input *ReleaseConnectionInput) error {
+	user, err := resolveActor(ctx, u.userRepo, input.User)
+	if err != nil {
+		return err
+	}
+
+	rel, err := u.relationshipRepo.FindBetween(ctx, user.ID, input.OtherID)
+	if err != nil {
+		return fmt.Errorf("failed to find relationship: %w", err)
+	}
+	if rel == nil || !u.policy.IsActive(rel) || user.ID == input.OtherID {
+		return fmt.Errorf("%w: %s", ErrConnectionNotFound, input.OtherID)
+	}
+
+	if err := u.relationshipRepo.Delete(ctx, user.ID, input.OtherID); err != nil {
+		return fmt.Errorf("failed to release connection: %w", err)
+	}
+	return nil
+}
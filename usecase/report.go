@@ -0,0 +1,15 @@
+package usecase
+
+import "github.com/dkpcb/pet/domain"
+
+var (
+	// ErrInvalidReport is returned when a report has an unknown reason or is otherwise malformed.
+	ErrInvalidReport = domain.NewError(domain.ErrInvalidInput, "invalid report")
+	// ErrReportNotFound is returned when a report does not exist.
+	ErrReportNotFound = domain.NewError(domain.ErrNotFound, "report not found")
+	// ErrReportNotOpen is returned when a moderator acts on a report that was already closed.
+	ErrReportNotOpen = domain.NewError(domain.ErrConflict, "report is not open")
+)
+
+// maxReportDetailsRunes bounds the free-form part of a report.
+const maxReportDetailsRunes = 2000
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ReportUserInput represents the input for reporting a user.
+type ReportUserInput struct {
+	Reporter   Actor
+	ReportedID string
+	Reason     domain.ReportReason
+	Details    string
+}
+
+// ReportUserOutput represents the output of reporting a user.
+type ReportUserOutput struct {
+	Report *domain.Report
+}
+
+// ReportUserUsecase puts a user in front of the moderators.
+// Reporting does not block; users who want both do both.
+type ReportUserUsecase struct {
+	reportRepo repository.ReportRepository
+	userRepo   repository.UserRepository
+}
+
+// NewReportUserUsecase creates a new ReportUserUsecase.
+func NewReportUserUsecase(reportRepo repository.ReportRepository, userRepo repository.UserRepository) *ReportUserUsecase {
+	return &ReportUserUsecase{reportRepo: reportRepo, userRepo: userRepo}
+}
+
+// Execute files an open report in the moderation queue.
+func (u *ReportUserUsecase) Execute(ctx context.Context, input *ReportUserInput) (*ReportUserOutput, error) {
+	// 1. Validate the report itself
+	if !input.Reason.IsValid() {
+		return nil, fmt.Errorf("%w: unknown reason %q", ErrInvalidReport, input.Reason)
+	}
+	details := strings.TrimSpace(input.Details)
+	if utf8.RuneCountInString(details) > maxReportDetailsRunes {
+		return nil, fmt.Errorf("%w: details must be at most %d characters", ErrInvalidReport, maxReportDetailsRunes)
+	}
+
+	// 2. Resolve both users
+	reporter, err := resolveActor(ctx, u.userRepo, input.Reporter)
+	if err != nil {
+		return nil, err
+	}
+	reported, err := u.userRepo.FindByID(ctx, input.ReportedID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reported user: %w", err)
+	}
+	if reported == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, input.ReportedID)
+	}
+	if reported.ID == reporter.ID {
+		return nil, fmt.Errorf("%w: cannot report yourself", ErrInvalidReport)
+	}
+
+	// 3. Save the report
+	report := domain.NewReport(uuid.New().String(), reporter.ID, reported.ID, input.Reason, details, time.Now())
+	if err := u.reportRepo.Save(ctx, report); err != nil {
+		return nil, fmt.Errorf("failed to save report: %w", err)
+	}
+	return &ReportUserOutput{Report: report}, nil
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
@@ -14,53 +15,77 @@ import (
 
 // RequestInteractionInput represents the input for requesting an interaction.
 type RequestInteractionInput struct {
-	RequesterLineUserID string
-	MessageText         string
+	Requester Actor
+	// ApproverID is the user asked to meet. LINE requests leave it empty and
+	// pass the "meet_" command they received as MessageText instead.
+	ApproverID  string
+	MessageText string
+	// ReplyToken, if set, is used to confirm the request to the requester.
+	ReplyToken string
 }
 
 // RequestInteractionOutput represents the output of requesting an interaction.
 type RequestInteractionOutput struct {
 	InteractionID string
 	ApproverID    string
+	// Dropped is true if the request was silently discarded because one of
+	// the users blocked the other. No interaction exists then.
+	Dropped bool
 }
 
 // RequestInteractionUsecase handles the business logic for creating interaction requests.
 type RequestInteractionUsecase struct {
 	interactionRepo repository.InteractionRepository
 	userRepo        repository.UserRepository
+	blockRepo       repository.BlockRepository
 	lineService     repository.LineService
+	rateLimiter     repository.RateLimiter
+	limits          RequestLimits
+	logger          *slog.Logger
 }
 
 // NewRequestInteractionUsecase creates a new RequestInteractionUsecase.
 func NewRequestInteractionUsecase(
 	interactionRepo repository.InteractionRepository,
 	userRepo repository.UserRepository,
+	blockRepo repository.BlockRepository,
 	lineService repository.LineService,
+	rateLimiter repository.RateLimiter,
+	limits RequestLimits,
+	logger *slog.Logger,
 ) *RequestInteractionUsecase {
 	return &RequestInteractionUsecase{
 		interactionRepo: interactionRepo,
 		userRepo:        userRepo,
+		blockRepo:       blockRepo,
 		lineService:     lineService,
+		rateLimiter:     rateLimiter,
+		limits:          limits,
+		logger:          logger,
 	}
 }
 
-// Execute processes an interaction request from a LINE message.
-// It parses the message text (expected format: "meet_{UUID}"),
-// validates the request, creates the interaction, and sends a notification.
+// Execute processes an interaction request from the API or a LINE message.
+// For LINE it parses the message text (expected format: "meet_{UUID}"),
+// then validates the request, creates the interaction, and sends a notification.
+// It returns a *RateLimitError if the requester is sending too many requests
+// or the approver already has too many waiting. On success the requester is
+// told the request was sent, including when it was dropped because of a block.
 func (u *RequestInteractionUsecase) Execute(ctx context.Context, input *RequestInteractionInput) (*RequestInteractionOutput, error) {
 	// 1. Parse the message text to extract approver UUID
-	approverUUID, err := u.parseMessageText(input.MessageText)
-	if err != nil {
-		return nil, fmt.Errorf("invalid message format: %w", err)
+	approverUUID := input.ApproverID
+	if approverUUID == "" {
+		parsed, err := u.parseMessageText(input.MessageText)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidMeetCommand, err)
+		}
+		approverUUID = parsed
 	}
 
-	// 2. Get or create the requester user
-	requester, err := u.userRepo.FindByLineUserID(ctx, input.RequesterLineUserID)
+	// 2. Resolve the requester
+	requester, err := resolveActor(ctx, u.userRepo, input.Requester)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find requester: %w", err)
-	}
-	if requester == nil {
-		return nil, fmt.Errorf("requester user not found: %s", input.RequesterLineUserID)
+		return nil, err
 	}
 
 	// 3. Validate the approver exists
@@ -69,15 +94,43 @@ func (u *RequestInteractionUsecase) Execute(ctx context.Context, input *RequestI
 		return nil, fmt.Errorf("failed to find approver: %w", err)
 	}
 	if approver == nil {
-		return nil, fmt.Errorf("approver user not found: %s", approverUUID)
+		return nil, fmt.Errorf("%w: %s", ErrApproverNotFound, approverUUID)
 	}
 
 	// 4. Validate not requesting to themselves
 	if requester.ID == approver.ID {
-		return nil, fmt.Errorf("cannot request interaction with yourself")
+		return nil, ErrSelfInteraction
+	}
+
+	// 5. Spend one of the requester's tokens. Dropped requests cost one too,
+	// so the limit behaves the same whether or not the requester is blocked.
+	if err := takeToken(ctx, u.rateLimiter, RateLimitScopeRequester, requester.ID, u.limits.PerRequester); err != nil {
+		return nil, err
+	}
+
+	// 6. Drop requests between users who blocked each other. The requester
+	// sees the same outcome as a delivered request, so a block is not revealed.
+	blocked, err := isBlocked(ctx, u.blockRepo, requester.ID, approver.ID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		u.confirm(ctx, input.ReplyToken, requester, approver)
+		return &RequestInteractionOutput{ApproverID: approver.ID, Dropped: true}, nil
+	}
+
+	// 7. Refuse once the approver has too many requests waiting
+	if u.limits.MaxPendingPerApprover > 0 {
+		pending, err := u.interactionRepo.CountPendingByApproverID(ctx, approver.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count pending interactions: %w", err)
+		}
+		if pending >= u.limits.MaxPendingPerApprover {
+			return nil, &RateLimitError{Scope: RateLimitScopeApprover}
+		}
 	}
 
-	// 5. Create the interaction domain model
+	// 8. Create the interaction domain model
 	interactionID := uuid.New().String()
 	interaction := domain.NewInteraction(
 		interactionID,
@@ -88,28 +141,42 @@ func (u *RequestInteractionUsecase) Execute(ctx context.Context, input *RequestI
 		time.Now(),
 	)
 
-	// 6. Save the interaction
+	// 9. Save the interaction
 	if err := u.interactionRepo.Save(ctx, interaction); err != nil {
 		return nil, fmt.Errorf("failed to save interaction: %w", err)
 	}
 
-	// 7. Send notification to the approver via LINE
-	notificationMessage := fmt.Sprintf(
-		"%s さんから交流申請が届きました。",
-		requester.DisplayName,
-	)
-	if err := u.lineService.SendMessage(ctx, approver.LineUserID, notificationMessage); err != nil {
+	// 10. Send notification to the approver via LINE
+	notificationMessage, err := interactionRequestFlexMessage(approver.Locale, requester, interactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build notification: %w", err)
+	}
+	if err := u.lineService.SendFlexMessage(ctx, approver.LineUserID, notificationMessage); err != nil {
 		// Log the error but don't fail the entire operation
 		// The interaction is already saved
-		fmt.Printf("Warning: failed to send LINE notification: %v\n", err)
+		u.logger.WarnContext(ctx, "failed to send LINE notification", "recipient_id", approver.ID, "interaction_id", interactionID, "error", err)
 	}
 
+	// 11. Confirm to the requester
+	u.confirm(ctx, input.ReplyToken, requester, approver)
+
 	return &RequestInteractionOutput{
 		InteractionID: interactionID,
 		ApproverID:    approver.ID,
 	}, nil
 }
 
+// confirm replies to the requester that their request reached approver.
+func (u *RequestInteractionUsecase) confirm(ctx context.Context, replyToken string, requester, approver *domain.User) {
+	if replyToken == "" {
+		return
+	}
+	if err := u.lineService.ReplyMessage(ctx, replyToken, interactionRequestSentMessage(requester.Locale, approver)); err != nil {
+		// The request went through; only the confirmation is lost
+		u.logger.WarnContext(ctx, "failed to send LINE confirmation", "recipient_id", requester.ID, "error", err)
+	}
+}
+
 // parseMessageText extracts the UUID from the message text.
 // Expected format: "meet_{UUID}"
 func (u *RequestInteractionUsecase) parseMessageText(text string) (string, error) {
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/repository"
+)
+
+// ResendInteractionNotificationInput represents the input for re-sending a request notification.
+type ResendInteractionNotificationInput struct {
+	InteractionID string
+}
+
+// ResendInteractionNotificationUsecase re-sends the LINE notification for a
+// pending interaction, e.g. after the original push failed.
+type ResendInteractionNotificationUsecase struct {
+	interactionRepo repository.InteractionRepository
+	userRepo        repository.UserRepository
+	lineService     repository.LineService
+}
+
+// NewResendInteractionNotificationUsecase creates a new ResendInteractionNotificationUsecase.
+func NewResendInteractionNotificationUsecase(
+	interactionRepo repository.InteractionRepository,
+	userRepo repository.UserRepository,
+	lineService repository.LineService,
+) *ResendInteractionNotificationUsecase {
+	return &ResendInteractionNotificationUsecase{
+		interactionRepo: interactionRepo,
+		userRepo:        userRepo,
+		lineService:     lineService,
+	}
+}
+
+// Execute sends the request notification to the approver again.
+// Unlike the original request, a delivery failure is returned to the caller.
+func (u *ResendInteractionNotificationUsecase) Execute(ctx context.Context, input *ResendInteractionNotificationInput) error {
+	interaction, err := u.interactionRepo.FindByID(ctx, input.InteractionID)
+	if err != nil {
+		return fmt.Errorf("failed to find interaction: %w", err)
+	}
+	if interaction == nil {
+		return fmt.Errorf("%w: %s", ErrInteractionNotFound, input.InteractionID)
+	}
+	if !interaction.IsPending() {
+		return fmt.Errorf("%w: %s", ErrInteractionNotPending, interaction.Status)
+	}
+
+	requester, err := u.userRepo.FindByID(ctx, interaction.RequesterID)
+	if err != nil {
+		return fmt.Errorf("failed to find requester: %w", err)
+	}
+	if requester == nil {
+		return fmt.Errorf("requester user not found: %s", interaction.RequesterID)
+	}
+	approver, err := u.userRepo.FindByID(ctx, interaction.ApproverID)
+	if err != nil {
+		return fmt.Errorf("failed to find approver: %w", err)
+	}
+	if approver == nil {
+		return fmt.Errorf("approver user not found: %s", interaction.ApproverID)
+	}
+
+	message, err := interactionRequestFlexMessage(approver.Locale, requester, interaction.ID)
+	if err != nil {
+		return fmt.Errorf("failed to build notification: %w", err)
+	}
+	if err := u.lineService.SendFlexMessage(ctx, approver.LineUserID, message); err != nil {
+		return fmt.Errorf("failed to send LINE notification: %w", err)
+	}
+	return nil
+}
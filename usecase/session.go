@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// Session errors. They all mean the client has to sign in again.
+var (
+	ErrInvalidIDToken      = domain.NewError(domain.ErrUnauthenticated, "invalid LINE ID token")
+	ErrInvalidRefreshToken = domain.NewError(domain.ErrUnauthenticated, "invalid refresh token")
+	ErrInvalidAccessToken  = domain.NewError(domain.ErrUnauthenticated, "invalid access token")
+)
+
+// SessionPolicy says how long the tokens of a session last.
+type SessionPolicy struct {
+	// AccessTokenTTL is how long an access token is accepted. Access tokens
+	// cannot be revoked, so keep it short.
+	AccessTokenTTL time.Duration
+	// RefreshTokenTTL is how long a session lasts without being refreshed.
+	RefreshTokenTTL time.Duration
+}
+
+// Session is the pair of tokens a client holds while signed in.
+type Session struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// sessionIssuer creates sessions; sign-in and refresh share it.
+type sessionIssuer struct {
+	signer      repository.SessionTokenSigner
+	refreshRepo repository.RefreshTokenRepository
+	policy      SessionPolicy
+}
+
+// issue signs an access token for the user and stores a new refresh token.
+func (i sessionIssuer) issue(ctx context.Context, userID string, now time.Time) (*Session, error) {
+	accessExpiresAt := now.Add(i.policy.AccessTokenTTL)
+	accessToken, err := i.signer.Sign(&domain.SessionClaims{
+		UserID:    userID,
+		IssuedAt:  now,
+		ExpiresAt: accessExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	refreshToken := base64.RawURLEncoding.EncodeToString(secret)
+	refreshExpiresAt := now.Add(i.policy.RefreshTokenTTL)
+	stored := domain.NewRefreshToken(uuid.New().String(), userID, hashRefreshToken(refreshToken), now, refreshExpiresAt)
+	if err := i.refreshRepo.Save(ctx, stored); err != nil {
+		return nil, fmt.Errorf("failed to save refresh token: %w", err)
+	}
+
+	return &Session{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshExpiresAt,
+	}, nil
+}
+
+// hashRefreshToken is how refresh tokens are stored, so a leaked table
+// cannot be used to sign in. The tokens are random, so no salt is needed.
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// SyncLineProfileInput represents the input for registering or refreshing a user from their LINE profile.
+type SyncLineProfileInput struct {
+	LineUserID string
+}
+
+// SyncLineProfileOutput represents the output of registering or refreshing a user from their LINE profile.
+type SyncLineProfileOutput struct {
+	User *domain.User
+	// Registered is true if the LINE user was seen for the first time.
+	Registered bool
+}
+
+// SyncLineProfileUsecase registers LINE users when they add the bot, which is
+// the only way to sign up, and keeps what they share through LINE, currently
+// their language, up to date.
+type SyncLineProfileUsecase struct {
+	userRepo    repository.UserRepository
+	lineService repository.LineService
+}
+
+// NewSyncLineProfileUsecase creates a new SyncLineProfileUsecase.
+func NewSyncLineProfileUsecase(userRepo repository.UserRepository, lineService repository.LineService) *SyncLineProfileUsecase {
+	return &SyncLineProfileUsecase{userRepo: userRepo, lineService: lineService}
+}
+
+// Execute registers the LINE user with the name and language of their LINE
+// profile, or sets a known user's locale from the language. Users who do not
+// share their language keep the locale they have.
+func (u *SyncLineProfileUsecase) Execute(ctx context.Context, input *SyncLineProfileInput) (*SyncLineProfileOutput, error) {
+	// 1. Find the user
+	user, err := u.userRepo.FindByLineUserID(ctx, input.LineUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// 2. Read the profile
+	profile, err := u.lineService.GetProfile(ctx, input.LineUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LINE profile: %w", err)
+	}
+
+	// 3. Register a LINE user seen for the first time
+	if user == nil {
+		return u.register(ctx, input.LineUserID, profile)
+	}
+
+	// 4. Save the locale if it changed
+	if profile.Language == "" {
+		return &SyncLineProfileOutput{User: user}, nil
+	}
+	locale := domain.ParseLocale(profile.Language)
+	if locale == user.Locale {
+		return &SyncLineProfileOutput{User: user}, nil
+	}
+	user.Locale = locale
+	if err := u.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+	return &SyncLineProfileOutput{User: user}, nil
+}
+
+func (u *SyncLineProfileUsecase) register(ctx context.Context, lineUserID string, profile *domain.LineProfile) (*SyncLineProfileOutput, error) {
+	user := domain.NewUser(uuid.New().String(), lineUserID, profile.DisplayName, nil)
+	if profile.Language != "" {
+		user.Locale = domain.ParseLocale(profile.Language)
+	}
+	if err := u.userRepo.Save(ctx, user); err != nil {
+		// LINE may deliver the follow event twice at once; the other delivery
+		// registered the user first.
+		existing, findErr := u.userRepo.FindByLineUserID(ctx, lineUserID)
+		if findErr != nil || existing == nil {
+			return nil, fmt.Errorf("failed to save user: %w", err)
+		}
+		return &SyncLineProfileOutput{User: existing}, nil
+	}
+	return &SyncLineProfileOutput{User: user, Registered: true}, nil
+}
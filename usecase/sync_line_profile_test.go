@@ -0,0 +1,93 @@
+package usecase_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/infrastructure"
+	"github.com/dkpcb/pet/usecase"
+)
+
+func TestSyncLineProfileSetsLocale(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"userId":"U0001","displayName":"Ada","language":"en"}`)
+	}))
+	defer server.Close()
+	lineService := infrastructure.NewLineService("channel-token", server.URL, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	user := domain.NewUser(walletTestUserID, "U0001", "Ada", nil)
+	user.Locale = domain.LocaleJapanese
+	users := newMemoryUserRepository(user)
+
+	output, err := usecase.NewSyncLineProfileUsecase(users, lineService).Execute(context.Background(), &usecase.SyncLineProfileInput{LineUserID: "U0001"})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if output.User.Locale != domain.LocaleEnglish {
+		t.Errorf("locale = %s, want en", output.User.Locale)
+	}
+	stored, _ := users.FindByID(context.Background(), walletTestUserID)
+	if stored.Locale != domain.LocaleEnglish {
+		t.Errorf("stored locale = %s, want en", stored.Locale)
+	}
+}
+
+func TestSyncLineProfileRegistersNewUser(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"userId":"U0002","displayName":"Grace","language":"en"}`)
+	}))
+	defer server.Close()
+	lineService := infrastructure.NewLineService("channel-token", server.URL, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	db, err := infrastructure.OpenDatabase(infrastructure.DialectSQLite, ":memory:", slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	if err := infrastructure.Migrate(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+	users := infrastructure.NewUserRepository(db)
+	sync := usecase.NewSyncLineProfileUsecase(users, lineService)
+
+	// Adding the bot registers the LINE user.
+	output, err := sync.Execute(ctx, &usecase.SyncLineProfileInput{LineUserID: "U0002"})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if !output.Registered || output.User.DisplayName != "Grace" || output.User.Locale != domain.LocaleEnglish {
+		t.Fatalf("output = %+v, user %+v; want Grace registered in English", output, output.User)
+	}
+
+	// Adding it again after blocking it does not register them twice.
+	again, err := sync.Execute(ctx, &usecase.SyncLineProfileInput{LineUserID: "U0002"})
+	if err != nil {
+		t.Fatalf("second Execute: %v", err)
+	}
+	if again.Registered || again.User.ID != output.User.ID {
+		t.Errorf("second follow registered %+v, want the user %s", again.User, output.User.ID)
+	}
+
+	// The new user can sign in.
+	login := usecase.NewLoginWithLineUsecase(trustingLineLoginVerifier{}, users,
+		infrastructure.NewSessionTokenSigner([]byte(strings.Repeat("s", infrastructure.MinSessionSecretLength))),
+		infrastructure.NewRefreshTokenRepository(db), defaultSessionPolicy)
+	signedIn, err := login.Execute(ctx, &usecase.LoginWithLineInput{IDToken: "U0002"})
+	if err != nil {
+		t.Fatalf("login after follow: %v", err)
+	}
+	if signedIn.User.ID != output.User.ID {
+		t.Errorf("signed in as %s, want %s", signedIn.User.ID, output.User.ID)
+	}
+}
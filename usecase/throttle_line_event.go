@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ThrottleLineEventInput represents the input for admitting a webhook event.
+type ThrottleLineEventInput struct {
+	LineUserID string
+}
+
+// ThrottleLineEventUsecase limits how much webhook traffic one LINE user can
+// cause, whatever the events are. It is checked before an event is handled.
+type ThrottleLineEventUsecase struct {
+	rateLimiter repository.RateLimiter
+	limit       domain.RateLimit
+}
+
+// NewThrottleLineEventUsecase creates a new ThrottleLineEventUsecase.
+func NewThrottleLineEventUsecase(rateLimiter repository.RateLimiter, limit domain.RateLimit) *ThrottleLineEventUsecase {
+	return &ThrottleLineEventUsecase{rateLimiter: rateLimiter, limit: limit}
+}
+
+// Execute returns a *RateLimitError if the sender has used up their events.
+// Events without a sender are not limited.
+func (u *ThrottleLineEventUsecase) Execute(ctx context.Context, input *ThrottleLineEventInput) error {
+	if input.LineUserID == "" {
+		return nil
+	}
+	return takeToken(ctx, u.rateLimiter, RateLimitScopeLineEvent, input.LineUserID, u.limit)
+}
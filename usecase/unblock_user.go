@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dkpcb/pet/repository"
+)
+
+// UnblockUserInput represents the input for lifting a block.
+type UnblockUserInput struct {
+	Blocker   Actor
+	BlockedID string
+}
+
+// UnblockUserUsecase lifts a block the user placed. The relationship removed
+// by the block is not restored; the two have to meet again.
+type UnblockUserUsecase struct {
+	blockRepo repository.BlockRepository
+	userRepo  repository.UserRepository
+}
+
+// NewUnblockUserUsecase creates a new UnblockUserUsecase.
+func NewUnblockUserUsecase(blockRepo repository.BlockRepository, userRepo repository.UserRepository) *UnblockUserUsecase {
+	return &UnblockUserUsecase{blockRepo: blockRepo, userRepo: userRepo}
+}
+
+// Execute removes the block. Unblocking someone not blocked is not an error,
+// and a block the other user placed stays in force.
+func (u *UnblockUserUsecase) Execute(ctx context.Context, input *UnblockUserInput) error {
+	blocker, err := resolveActor(ctx, u.userRepo, input.Blocker)
+	if err != nil {
+		return err
+	}
+	if err := u.blockRepo.Delete(ctx, blocker.ID, input.BlockedID); err != nil {
+		return fmt.Errorf("failed to remove block: %w", err)
+	}
+	return nil
+}
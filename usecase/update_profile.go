@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// UpdateProfileInput represents the input for editing the caller's profile.
+// Nil fields are left as they are.
+type UpdateProfileInput struct {
+	User        Actor
+	DisplayName *string
+	Bio         *string
+	// AvatarURL replaces the profile image; an empty string removes it.
+	AvatarURL *string
+}
+
+// UpdateProfileOutput represents the output of editing the caller's profile.
+type UpdateProfileOutput struct {
+	User *domain.User
+}
+
+// UpdateProfileUsecase lets a user edit how others see them.
+type UpdateProfileUsecase struct {
+	userRepo repository.UserRepository
+}
+
+// NewUpdateProfileUsecase creates a new UpdateProfileUsecase.
+func NewUpdateProfileUsecase(userRepo repository.UserRepository) *UpdateProfileUsecase {
+	return &UpdateProfileUsecase{userRepo: userRepo}
+}
+
+// Execute validates and saves the changed fields.
+func (u *UpdateProfileUsecase) Execute(ctx context.Context, input *UpdateProfileInput) (*UpdateProfileOutput, error) {
+	// 1. Resolve the user
+	user, err := resolveActor(ctx, u.userRepo, input.User)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Apply and validate the changes
+	if input.DisplayName != nil {
+		name := strings.TrimSpace(*input.DisplayName)
+		if name == "" {
+			return nil, fmt.Errorf("%w: display name must not be empty", ErrInvalidProfile)
+		}
+		if utf8.RuneCountInString(name) > maxDisplayNameRunes {
+			return nil, fmt.Errorf("%w: display name must be at most %d characters", ErrInvalidProfile, maxDisplayNameRunes)
+		}
+		user.DisplayName = name
+	}
+	if input.Bio != nil {
+		bio := strings.TrimSpace(*input.Bio)
+		if utf8.RuneCountInString(bio) > maxBioRunes {
+			return nil, fmt.Errorf("%w: bio must be at most %d characters", ErrInvalidProfile, maxBioRunes)
+		}
+		user.Bio = bio
+	}
+	if input.AvatarURL != nil {
+		switch avatarURL := strings.TrimSpace(*input.AvatarURL); {
+		case avatarURL == "":
+			user.AvatarURL = nil
+		case !isMediaURL(avatarURL):
+			return nil, fmt.Errorf("%w: avatar must be an http or https URL", ErrInvalidProfile)
+		default:
+			user.AvatarURL = &avatarURL
+		}
+	}
+
+	// 3. Save
+	if err := u.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+	return &UpdateProfileOutput{User: user}, nil
+}
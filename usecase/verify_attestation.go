@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// VerifyAttestationInput represents the input for verifying an attestation.
+type VerifyAttestationInput struct {
+	Attestation *domain.Attestation
+	// TrustedSigner is the only signer address accepted. An attestation is
+	// only as trustworthy as its signer, so it is required.
+	TrustedSigner string
+}
+
+// VerifyAttestationOutput represents the output of verifying an attestation.
+type VerifyAttestationOutput struct {
+	Signer string
+}
+
+// VerifyAttestationUsecase checks an exported attestation without any database access.
+type VerifyAttestationUsecase struct {
+	verifier       repository.AttestationVerifier
+	walletVerifier repository.WalletVerifier
+}
+
+// NewVerifyAttestationUsecase creates a new VerifyAttestationUsecase.
+func NewVerifyAttestationUsecase(
+	verifier repository.AttestationVerifier,
+	walletVerifier repository.WalletVerifier,
+) *VerifyAttestationUsecase {
+	return &VerifyAttestationUsecase{
+		verifier:       verifier,
+		walletVerifier: walletVerifier,
+	}
+}
+
+// Execute verifies the signature and that it was made by the trusted signer.
+func (u *VerifyAttestationUsecase) Execute(ctx context.Context, input *VerifyAttestationInput) (*VerifyAttestationOutput, error) {
+	if input.TrustedSigner == "" {
+		return nil, domain.NewError(domain.ErrInvalidInput, "a trusted signer is required")
+	}
+	trusted, err := u.walletVerifier.NormalizeAddress(input.TrustedSigner)
+	if err != nil {
+		return nil, domain.Errorf(domain.ErrInvalidInput, "invalid trusted signer address: %w", err)
+	}
+
+	if err := u.verifier.Verify(input.Attestation); err != nil {
+		return nil, domain.Errorf(domain.ErrInvalidInput, "invalid attestation: %w", err)
+	}
+
+	signer, err := u.walletVerifier.NormalizeAddress(input.Attestation.Signer)
+	if err != nil {
+		return nil, domain.Errorf(domain.ErrInvalidInput, "invalid signer address: %w", err)
+	}
+	if signer != trusted {
+		return nil, domain.Errorf(domain.ErrForbidden, "attestation is signed by %s, not the trusted signer %s", signer, trusted)
+	}
+
+	return &VerifyAttestationOutput{Signer: signer}, nil
+}
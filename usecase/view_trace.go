@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/dkpcb/pet/domain"
+	"github.com/dkpcb/pet/repository"
+)
+
+// ErrTraceNotFound is returned when a trace does not exist or the viewer may not see it.
+var ErrTraceNotFound = domain.NewError(domain.ErrNotFound, "trace not found")
+
+// ViewTraceInput represents the input for viewing a trace.
+type ViewTraceInput struct {
+	Viewer  Actor
+	TraceID string
+}
+
+// ViewTraceOutput represents the output of viewing a trace.
+type ViewTraceOutput struct {
+	Trace *domain.Trace
+}
+
+// ViewTraceUsecase shows a single trace and counts the view toward the
+// relationship between viewer and author.
+type ViewTraceUsecase struct {
+	traceRepo     repository.TraceRepository
+	circleRepo    repository.CircleRepository
+	userRepo      repository.UserRepository
+	blockRepo     repository.BlockRepository
+	reachability  *reachability
+	relationships *relationshipRecorder
+	logger        *slog.Logger
+}
+
+// NewViewTraceUsecase creates a new ViewTraceUsecase.
+func NewViewTraceUsecase(
+	traceRepo repository.TraceRepository,
+	circleRepo repository.CircleRepository,
+	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
+	blockRepo repository.BlockRepository,
+	policy domain.RelationshipPolicy,
+	logger *slog.Logger,
+) *ViewTraceUsecase {
+	return &ViewTraceUsecase{
+		traceRepo:     traceRepo,
+		circleRepo:    circleRepo,
+		userRepo:      userRepo,
+		blockRepo:     blockRepo,
+		reachability:  &reachability{relationshipRepo: relationshipRepo, blockRepo: blockRepo, policy: policy},
+		relationships: &relationshipRecorder{relationshipRepo: relationshipRepo, policy: policy},
+		logger:        logger,
+	}
+}
+
+// Execute returns the trace if the viewer may see it. Circle traces are
+// visible to members, other traces to users within domain.MaxHops of the author.
+// Traces are never shown between users who blocked each other.
+func (u *ViewTraceUsecase) Execute(ctx context.Context, input *ViewTraceInput) (*ViewTraceOutput, error) {
+	// 1. Resolve the viewer and the trace
+	viewer, err := resolveActor(ctx, u.userRepo, input.Viewer)
+	if err != nil {
+		return nil, err
+	}
+	trace, err := u.traceRepo.FindByID(ctx, input.TraceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find trace: %w", err)
+	}
+	if trace == nil {
+		return nil, fmt.Errorf("%w: %s", ErrTraceNotFound, input.TraceID)
+	}
+
+	// 2. Check visibility; hidden traces look the same as missing ones
+	visible, err := u.visible(ctx, viewer.ID, trace)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, fmt.Errorf("%w: %s", ErrTraceNotFound, input.TraceID)
+	}
+
+	// 3. Count the view toward the relationship with the author
+	if err := u.relationships.recordView(ctx, viewer.ID, trace.AuthorID); err != nil {
+		// Viewing must not fail because the bookkeeping did
+		u.logger.WarnContext(ctx, "failed to record trace view", "trace_id", trace.ID, "error", err)
+	}
+
+	return &ViewTraceOutput{Trace: trace}, nil
+}
+
+// visible reports whether viewerID may see the trace.
+func (u *ViewTraceUsecase) visible(ctx context.Context, viewerID string, trace *domain.Trace) (bool, error) {
+	blocked, err := isBlocked(ctx, u.blockRepo, viewerID, trace.AuthorID)
+	if err != nil || blocked {
+		return false, err
+	}
+	if trace.IsCircleScoped() {
+		member, err := u.circleRepo.FindMember(ctx, *trace.CircleID, viewerID)
+		if err != nil {
+			return false, fmt.Errorf("failed to find membership: %w", err)
+		}
+		return member != nil, nil
+	}
+	return u.reachability.withinMaxHops(ctx, trace.AuthorID, viewerID)
+}